@@ -18,7 +18,10 @@ package runner
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"os"
 
@@ -37,8 +40,87 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/internal/runnable"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/adaptivetimeout"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/anthropicadapter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/audiometadata"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/authgate"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/azuremodel"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/b3propagation"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/badwords"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/basemodelextractor"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/batchinline"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/batchsizelimit"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/behavioralfingerprint"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bestof"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bodyfieldtoheader"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/budgetenforcer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/celtransform"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/codeexecution"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/contentnegotiation"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/conversationbudget"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/conversationsummarizer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/costestimator"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/datasetcollection"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/dynamicmetadata"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/errornormalizer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/etag"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/fanout"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/functioncallmigration"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/gatewaymetadata"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/geoselector"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/gpuquota"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/healthcheck"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/htmlsanitizer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/idempotency"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/imageurlvalidator"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/jwksvalidator"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/languagemismatch"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/latencybreaker"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/logitbias"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/loraselector"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/messagealternation"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/messagelimit"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/mlflowadapter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/modelpinner"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/modelscheduler"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/modelwhitelist"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/parallelcompletions"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/penaltyvalidator"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/piddisclosure"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/piiredactor"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/piisanitizer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/promptextraction"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/promptflooding"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/promptnormalizer"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/quantizationselector"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/rag"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/ratelimiter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/reasoningbudget"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/requestcoalescing"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/requestdedup"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/responsecache"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/responseformat"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/responselatency"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/responsemetadata"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/secretredaction"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/seedvalidator"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/semanticselector"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/sessioncoherence"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/shadowmirror"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/streamingfilter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/syntheticload"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/systemmessage"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/tgiadapter"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/tokenbudget"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/tokenestimator"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/tokenusage"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/toolcalldedup"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/toolcallratelimit"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/toolchoice"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/ttft"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/useragent"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/utf8validator"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/whispertranscription"
 	runserver "sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/server"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/profiling"
@@ -52,10 +134,12 @@ var setupLog = ctrl.Log.WithName("setup")
 
 func NewRunner() *Runner {
 	return &Runner{
-		bbrExecutableName: "BBR",
-		requestPlugins:    []framework.RequestProcessor{},
-		responsePlugins:   []framework.ResponseProcessor{},
-		customCollectors:  []prometheus.Collector{},
+		bbrExecutableName:       "BBR",
+		requestPlugins:          []framework.RequestProcessor{},
+		requestPluginOptions:    map[string]framework.PluginOptions{},
+		requestPluginParameters: map[string]json.RawMessage{},
+		responsePlugins:         []framework.ResponseProcessor{},
+		customCollectors:        []prometheus.Collector{},
 	}
 }
 
@@ -65,6 +149,10 @@ type Runner struct {
 	// The slice of BBR plugin instances executed by the request handler,
 	// in the same order the plugin flags are provided.
 	requestPlugins []framework.RequestProcessor
+	// The execution options of the request plugins, keyed by the plugin instance names.
+	requestPluginOptions map[string]framework.PluginOptions
+	// The parameters the request plugins were created with, keyed by the plugin instance names.
+	requestPluginParameters map[string]json.RawMessage
 	// The slice of BBR plugin instances executed by the response handler,
 	// in the same order the plugin flags are provided.
 	responsePlugins []framework.ResponseProcessor
@@ -171,19 +259,15 @@ func (r *Runner) Run(ctx context.Context) error {
 	bbrHandle := framework.NewBbrHandle(ctx, mgr)
 
 	// Register factories for all known in-tree BBR plugins
-	r.registerInTreePlugins()
+	if err := r.registerInTreePlugins(); err != nil {
+		setupLog.Error(err, "Failed to register in-tree plugins")
+		return err
+	}
 
 	// Construct BBR plugin instances for the in-tree plugins that are (1) registered and (2) requested via the --plugin flags
 	if len(opts.PluginSpecs) == 0 {
 		setupLog.Info("No BBR plugins are specified. Running BBR with the default behavior.")
 
-		modelToHeaderPlugin, err := bodyfieldtoheader.NewBodyFieldToHeaderPlugin(modelField, bodyfieldtoheader.ModelHeader)
-		if err != nil {
-			setupLog.Error(err, "Failed to create plugin", "pluginType", bodyfieldtoheader.BodyFieldToHeaderPluginType)
-			return err
-		}
-		r.requestPlugins = append(r.requestPlugins, modelToHeaderPlugin)
-
 		// Create BaseModelToHeaderPlugin instance for extracting the "model" field into X-Gateway-Base-Model-Name
 		baseModelToHeaderPlugin, err := basemodelextractor.NewBaseModelToHeaderPlugin(func() *builder.Builder {
 			return ctrl.NewControllerManagedBy(mgr)
@@ -193,13 +277,18 @@ func (r *Runner) Run(ctx context.Context) error {
 			return err
 		}
 
-		r.requestPlugins = append(r.requestPlugins, baseModelToHeaderPlugin)
+		defaultPlugins, defaultParameters, err := defaultRequestPlugins(baseModelToHeaderPlugin)
+		if err != nil {
+			return err
+		}
+		r.requestPlugins = append(r.requestPlugins, defaultPlugins...)
+		maps.Copy(r.requestPluginParameters, defaultParameters)
 	} else {
 		setupLog.Info("BBR plugins are specified. Running BBR with the specified plugins.")
 
 		for _, s := range opts.PluginSpecs {
-			factory, ok := framework.Registry[s.Type]
-			if !ok {
+			factory, err := framework.GetFactory(s.Type)
+			if err != nil {
 				setupLog.Error(err, fmt.Sprintf("unknown plugin type %q (no factory registered)\n", s.Type))
 				return err
 			}
@@ -210,20 +299,33 @@ func (r *Runner) Run(ctx context.Context) error {
 			}
 			if requestProcessor, ok := instance.(framework.RequestProcessor); ok {
 				r.requestPlugins = append(r.requestPlugins, requestProcessor)
+				if s.Options.Timeout > 0 {
+					r.requestPluginOptions[s.Name] = s.Options
+				}
+				if len(s.JSON) > 0 {
+					r.requestPluginParameters[s.Name] = s.JSON
+				}
 			}
 			if responseProcessor, ok := instance.(framework.ResponseProcessor); ok {
 				r.responsePlugins = append(r.responsePlugins, responseProcessor)
 			}
+			if injector, ok := instance.(*gatewaymetadata.ResponseMetadataInjectorPlugin); ok {
+				injector.SetEnabled(opts.InjectGatewayMetadata)
+			}
 		}
 	}
 
 	// Setup ExtProc Server Runner.
 	serverRunner := &runserver.ExtProcServerRunner{
-		GrpcPort:        opts.GRPCPort,
-		SecureServing:   opts.SecureServing,
-		Streaming:       opts.Streaming,
-		RequestPlugins:  r.requestPlugins,
-		ResponsePlugins: r.responsePlugins,
+		GrpcPort:                opts.GRPCPort,
+		SecureServing:           opts.SecureServing,
+		Streaming:               opts.Streaming,
+		RequestPlugins:          r.requestPlugins,
+		ResponsePlugins:         r.responsePlugins,
+		RequestPluginOptions:    r.requestPluginOptions,
+		RequestPluginParameters: r.requestPluginParameters,
+		AdminPort:               opts.AdminPort,
+		AdminBindAll:            opts.AdminBindAll,
 	}
 
 	// Register health server.
@@ -237,6 +339,14 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Register admin server.
+	if opts.EnableAdminServer {
+		if err := mgr.Add(serverRunner.AdminAsRunnable(bbrHandle)); err != nil {
+			setupLog.Error(err, "Failed to register admin HTTP server")
+			return err
+		}
+	}
+
 	// Start the manager. This blocks until a signal is received.
 	setupLog.Info("Manager starting")
 	if err := mgr.Start(ctx); err != nil {
@@ -247,10 +357,124 @@ func (r *Runner) Run(ctx context.Context) error {
 	return nil
 }
 
+// defaultRequestPlugins returns the request plugins run when no plugins are specified, in execution order,
+// ending with the given plugin extracting the base model, which requires the manager, and the parameters of
+// the plugins created with parameters, keyed by the plugin instance names. The authentication gate is
+// not a default plugin, since it would reject the requests of deployments without credentials; it runs only when
+// listed in the specified plugins.
+func defaultRequestPlugins(baseModelToHeaderPlugin *basemodelextractor.BaseModelToHeaderPlugin) ([]framework.RequestProcessor, map[string]json.RawMessage, error) {
+	modelToHeaderPlugin, err := bodyfieldtoheader.NewBodyFieldToHeaderPlugin(modelField, bodyfieldtoheader.ModelHeader)
+	if err != nil {
+		setupLog.Error(err, "Failed to create plugin", "pluginType", bodyfieldtoheader.BodyFieldToHeaderPluginType)
+		return nil, nil, err
+	}
+	modelToHeaderParameters, err := json.Marshal(bodyfieldtoheader.BodyFieldToHeaderConfig{FieldName: modelField, HeaderName: bodyfieldtoheader.ModelHeader})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return []framework.RequestProcessor{
+		// Forward health check probes unchanged before running any other plugin
+		healthcheck.NewHealthCheckBypassPlugin(nil),
+		// Reject request bodies that are not valid UTF-8 before any other plugin inspects them
+		utf8validator.NewUTF8ValidatorPlugin(),
+		// Reject streamed requests with best_of before any plugin mutates the body
+		bestof.NewStreamBestOfConflictPlugin(),
+		modelToHeaderPlugin,
+		baseModelToHeaderPlugin,
+	}, map[string]json.RawMessage{modelToHeaderPlugin.TypedName().Name: modelToHeaderParameters}, nil
+}
+
 // registerInTreePlugins registers the factory functions of all known BBR plugins
-func (r *Runner) registerInTreePlugins() {
-	framework.Register(bodyfieldtoheader.BodyFieldToHeaderPluginType, bodyfieldtoheader.BodyFieldToHeaderPluginFactory)
-	framework.Register(basemodelextractor.BaseModelToHeaderPluginType, basemodelextractor.BaseModelToHeaderPluginFactory)
+func (r *Runner) registerInTreePlugins() error {
+	return errors.Join(
+		framework.Register(bodyfieldtoheader.BodyFieldToHeaderPluginType, bodyfieldtoheader.BodyFieldToHeaderPluginFactory),
+		framework.Register(basemodelextractor.BaseModelToHeaderPluginType, basemodelextractor.BaseModelToHeaderPluginFactory),
+		framework.Register(requestdedup.RequestDeduplicationPluginType, requestdedup.RequestDeduplicationPluginFactory),
+		framework.Register(jwksvalidator.JWKSValidationPluginType, jwksvalidator.JWKSValidationPluginFactory),
+		framework.Register(quantizationselector.QuantizationSelectorPluginType, quantizationselector.QuantizationSelectorPluginFactory),
+		framework.Register(toolcallratelimit.ToolCallRateLimitPluginType, toolcallratelimit.ToolCallRateLimitPluginFactory),
+		framework.Register(etag.ETagPluginType, etag.ETagPluginFactory),
+		framework.Register(latencybreaker.LatencyCircuitBreakerPluginType, latencybreaker.LatencyCircuitBreakerPluginFactory),
+		framework.Register(mlflowadapter.MLflowBodyAdapterPluginType, mlflowadapter.MLflowBodyAdapterPluginFactory),
+		framework.Register(utf8validator.UTF8ValidatorPluginType, utf8validator.UTF8ValidatorPluginFactory),
+		framework.Register(modelpinner.ModelVersionPinnerPluginType, modelpinner.ModelVersionPinnerPluginFactory),
+		framework.Register(systemmessage.SystemMessageRequiredPluginType, systemmessage.SystemMessageRequiredPluginFactory),
+		framework.Register(datasetcollection.DatasetCollectionPluginType, datasetcollection.DatasetCollectionPluginFactory),
+		framework.Register(geoselector.GeoAwareModelSelectorPluginType, geoselector.GeoAwareModelSelectorPluginFactory),
+		framework.Register(errornormalizer.ErrorNormalizerPluginType, errornormalizer.ErrorNormalizerPluginFactory),
+		framework.Register(modelwhitelist.ModelWhitelistPluginType, modelwhitelist.ModelWhitelistPluginFactory),
+		framework.Register(responsemetadata.ResponseMetadataHeaderPluginType, responsemetadata.ResponseMetadataHeaderPluginFactory),
+		framework.Register(requestcoalescing.RequestCoalescingPluginType, requestcoalescing.RequestCoalescingPluginFactory),
+		framework.Register(htmlsanitizer.HTMLSanitizerPluginType, htmlsanitizer.HTMLSanitizerPluginFactory),
+		framework.Register(tokenusage.TokenUsageMetricsPluginType, tokenusage.TokenUsageMetricsPluginFactory),
+		framework.Register(functioncallmigration.FunctionCallMigrationPluginType, functioncallmigration.FunctionCallMigrationPluginFactory),
+		framework.Register(shadowmirror.ShadowMirrorPluginType, shadowmirror.ShadowMirrorPluginFactory),
+		framework.Register(conversationbudget.ConversationBudgetPluginType, conversationbudget.ConversationBudgetPluginFactory),
+		framework.Register(promptnormalizer.PromptNormalizerPluginType, promptnormalizer.PromptNormalizerPluginFactory),
+		framework.Register(responselatency.ResponseLatencyPluginType, responselatency.ResponseLatencyPluginFactory),
+		framework.Register(reasoningbudget.ReasoningBudgetPluginType, reasoningbudget.ReasoningBudgetPluginFactory),
+		framework.Register(imageurlvalidator.ImageURLValidatorPluginType, imageurlvalidator.ImageURLValidatorPluginFactory),
+		framework.Register(batchsizelimit.BatchSizeLimitPluginType, batchsizelimit.BatchSizeLimitPluginFactory),
+		framework.Register(secretredaction.SecretRedactionPluginType, secretredaction.SecretRedactionPluginFactory),
+		framework.Register(celtransform.CELTransformPluginType, celtransform.CELTransformPluginFactory),
+		framework.Register(logitbias.LogitBiasValidatorPluginType, logitbias.LogitBiasValidatorPluginFactory),
+		framework.Register(ttft.TTFTMeasurementPluginType, ttft.TTFTMeasurementPluginFactory),
+		framework.Register(dynamicmetadata.DynamicMetadataPluginType, dynamicmetadata.DynamicMetadataPluginFactory),
+		framework.Register(idempotency.IdempotencyPluginType, idempotency.IdempotencyPluginFactory),
+		framework.Register(tgiadapter.TGIFormatAdapterPluginType, tgiadapter.TGIFormatAdapterPluginFactory),
+		framework.Register(seedvalidator.SeedValidatorPluginType, seedvalidator.SeedValidatorPluginFactory),
+		framework.Register(gatewaymetadata.ResponseMetadataInjectorPluginType, gatewaymetadata.ResponseMetadataInjectorPluginFactory),
+		framework.Register(messagealternation.MessageAlternationPluginType, messagealternation.MessageAlternationPluginFactory),
+		framework.Register(promptflooding.PromptFloodingDetectorPluginType, promptflooding.PromptFloodingDetectorPluginFactory),
+		framework.Register(responseformat.ResponseFormatValidatorPluginType, responseformat.ResponseFormatValidatorPluginFactory),
+		framework.Register(b3propagation.B3TracePropagationPluginType, b3propagation.B3TracePropagationPluginFactory),
+		framework.Register(contentnegotiation.ContentTypeNegotiationPluginType, contentnegotiation.ContentTypeNegotiationPluginFactory),
+		framework.Register(gpuquota.GPUQuotaGuardPluginType, gpuquota.GPUQuotaGuardPluginFactory),
+		framework.Register(codeexecution.CodeExecutionDetectorPluginType, codeexecution.CodeExecutionDetectorPluginFactory),
+		framework.Register(codeexecution.CodeExecutionRateLimitPluginType, codeexecution.CodeExecutionRateLimitPluginFactory),
+		framework.Register(piisanitizer.PIISanitizerPluginType, piisanitizer.PIISanitizerPluginFactory),
+		framework.Register(adaptivetimeout.AdaptiveTimeoutPluginType, adaptivetimeout.AdaptiveTimeoutPluginFactory),
+		framework.Register(toolchoice.ToolChoiceConsistencyPluginType, toolchoice.ToolChoiceConsistencyPluginFactory),
+		framework.Register(conversationsummarizer.ConversationSummarizerPluginType, conversationsummarizer.ConversationSummarizerPluginFactory),
+		framework.Register(authgate.AuthenticationGatePluginType, authgate.AuthenticationGatePluginFactory),
+		framework.Register(tokenbudget.PersistentTokenBudgetPluginType, tokenbudget.PersistentTokenBudgetPluginFactory),
+		framework.Register(fanout.FanoutPluginType, fanout.FanoutPluginFactory),
+		framework.Register(bestof.BestOfInjectorPluginType, bestof.BestOfInjectorPluginFactory),
+		framework.Register(bestof.StreamBestOfConflictPluginType, bestof.StreamBestOfConflictPluginFactory),
+		framework.Register(behavioralfingerprint.BehavioralFingerprintPluginType, behavioralfingerprint.BehavioralFingerprintPluginFactory),
+		framework.Register(modelscheduler.TimeBasedModelSchedulerPluginType, modelscheduler.TimeBasedModelSchedulerPluginFactory),
+		framework.Register(promptextraction.SystemPromptExtractionBlockerPluginType, promptextraction.SystemPromptExtractionBlockerPluginFactory),
+		framework.Register(toolcalldedup.ToolCallIDDeduplicationPluginType, toolcalldedup.ToolCallIDDeduplicationPluginFactory),
+		framework.Register(rag.VectorDBRAGPluginType, rag.VectorDBRAGPluginFactory),
+		framework.Register(responseformat.JSONSchemaResponseFormatPluginType, responseformat.JSONSchemaResponseFormatPluginFactory),
+		framework.Register(anthropicadapter.AnthropicToOpenAIPluginType, anthropicadapter.AnthropicToOpenAIPluginFactory),
+		framework.Register(adaptivetimeout.ModelSizeTimeoutPluginType, adaptivetimeout.ModelSizeTimeoutPluginFactory),
+		framework.Register(penaltyvalidator.PenaltyValidatorPluginType, penaltyvalidator.PenaltyValidatorPluginFactory),
+		framework.Register(audiometadata.AudioMetadataExtractorPluginType, audiometadata.AudioMetadataExtractorPluginFactory),
+		framework.Register(syntheticload.SyntheticLoadGeneratorPluginType, syntheticload.SyntheticLoadGeneratorPluginFactory),
+		framework.Register(languagemismatch.LanguageMismatchDetectorPluginType, languagemismatch.LanguageMismatchDetectorPluginFactory),
+		framework.Register(streamingfilter.StreamingContentFilterPluginType, streamingfilter.StreamingContentFilterPluginFactory),
+		framework.Register(batchinline.BatchInlinePluginType, batchinline.BatchInlinePluginFactory),
+		framework.Register(parallelcompletions.ParallelCompletionsLimitPluginType, parallelcompletions.ParallelCompletionsLimitPluginFactory),
+		framework.Register(sessioncoherence.SessionCoherencePluginType, sessioncoherence.SessionCoherencePluginFactory),
+		framework.Register(useragent.UserAgentNormalizerPluginType, useragent.UserAgentNormalizerPluginFactory),
+		framework.Register(messagelimit.MessageArrayLengthLimitPluginType, messagelimit.MessageArrayLengthLimitPluginFactory),
+		framework.Register(healthcheck.HealthCheckBypassPluginType, healthcheck.HealthCheckBypassPluginFactory),
+		framework.Register(whispertranscription.LocalWhisperTranscriptionPluginType, whispertranscription.LocalWhisperTranscriptionPluginFactory),
+		framework.Register(badwords.BadWordsBlockerPluginType, badwords.BadWordsBlockerPluginFactory),
+		framework.Register(piddisclosure.PIDDisclosureBlockerPluginType, piddisclosure.PIDDisclosureBlockerPluginFactory),
+		framework.Register(semanticselector.SemanticModelSelectorPluginType, semanticselector.SemanticModelSelectorPluginFactory),
+		framework.Register(loraselector.LoRAModelSelectorPluginType, loraselector.LoRAModelSelectorPluginFactory),
+		framework.Register(ratelimiter.TokenBucketLimiterPluginType, ratelimiter.TokenBucketLimiterPluginFactory),
+		framework.Register(tokenestimator.TiktokenEstimatorPluginType, tokenestimator.TiktokenEstimatorPluginFactory),
+		framework.Register(piiredactor.PIIRedactorPluginType, piiredactor.PIIRedactorPluginFactory),
+		framework.Register(azuremodel.AzureModelExtractorPluginType, azuremodel.AzureModelExtractorPluginFactory),
+		framework.Register(requestdedup.DeduplicatorPluginType, requestdedup.DeduplicatorPluginFactory),
+		framework.Register(responsecache.ResponseCachePluginType, responsecache.ResponseCachePluginFactory),
+		framework.Register(costestimator.CostEstimatorPluginType, costestimator.CostEstimatorPluginFactory),
+		framework.Register(budgetenforcer.BudgetEnforcerPluginType, budgetenforcer.BudgetEnforcerPluginFactory),
+	)
 }
 
 // registerHealthServer adds the Health gRPC server as a Runnable to the given manager.
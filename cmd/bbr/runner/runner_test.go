@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	crconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/basemodelextractor"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bodyfieldtoheader"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+func TestDryRunDefaultRequestPlugins(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	baseModelToHeaderPlugin := &basemodelextractor.BaseModelToHeaderPlugin{AdaptersStore: basemodelextractor.NewAdaptersStore()}
+	plugins, _, err := defaultRequestPlugins(baseModelToHeaderPlugin)
+	if err != nil {
+		t.Fatalf("defaultRequestPlugins() returned unexpected error: %v", err)
+	}
+	server := handlers.NewServer(false, plugins, []framework.ResponseProcessor{})
+
+	tests := []struct {
+		name string
+		body string
+		// wantFires are whether the health-check-bypass, utf8-validator, stream-best-of-conflict,
+		// body-field-to-header and base-model-to-header plugins fire
+		wantFires []bool
+	}{
+		{
+			name:      "completion request",
+			body:      `{"model":"foo","prompt":"test"}`,
+			wantFires: []bool{false, false, false, true, true},
+		},
+		{
+			name:      "streamed request with best_of",
+			body:      `{"model":"foo","prompt":"test","stream":true,"best_of":2}`,
+			wantFires: []bool{false, false, true, true, true},
+		},
+		{
+			name:      "request with invalid UTF-8",
+			body:      "{\"model\":\"foo\",\"prompt\":\"\xff\"}",
+			wantFires: []bool{false, true, false, true, true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := server.DryRun(ctx, []byte(tt.body))
+			if err != nil {
+				t.Fatalf("DryRun() returned unexpected error: %v", err)
+			}
+			if len(plan.Steps) != len(tt.wantFires) {
+				t.Fatalf("got %d steps, want %d", len(plan.Steps), len(tt.wantFires))
+			}
+			for i, step := range plan.Steps {
+				if !step.Planned {
+					t.Errorf("plugin %d (%s) of the default chain is not planned", i, step.Plugin)
+				}
+				if step.Fires != tt.wantFires[i] {
+					t.Errorf("plugin %d (%s) fires = %v, want %v", i, step.Plugin, step.Fires, tt.wantFires[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultRequestPluginsJSON(t *testing.T) {
+	if err := NewRunner().registerInTreePlugins(); err != nil {
+		t.Fatalf("registerInTreePlugins() returned unexpected error: %v", err)
+	}
+	skipValidation := true
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "http://dummy:0"}, ctrl.Options{
+		Metrics:    metricsserver.Options{BindAddress: "0"},
+		Controller: crconfig.Controller{SkipNameValidation: &skipValidation},
+	})
+	if err != nil {
+		t.Fatalf("failed to create test manager: %v", err)
+	}
+	handle := framework.NewBbrHandle(context.Background(), mgr)
+
+	baseModelToHeaderPlugin, err := basemodelextractor.NewBaseModelToHeaderPlugin(handle.ReconcilerBuilder, handle.ClientReader())
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	plugins, parameters, err := defaultRequestPlugins(baseModelToHeaderPlugin)
+	if err != nil {
+		t.Fatalf("defaultRequestPlugins() returned unexpected error: %v", err)
+	}
+	data, err := json.Marshal(handlers.NewPluginsChainWithParameters(plugins, nil, parameters))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	restored, err := handlers.NewPluginsChainFromJSON(data, handle)
+	if err != nil {
+		t.Fatalf("failed to restore the default chain from %s: %v", data, err)
+	}
+	restoredData, err := json.Marshal(restored)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(string(data), string(restoredData)); diff != "" {
+		t.Errorf("unexpected JSON of the restored chain (-want +got):\n%s", diff)
+	}
+
+	request := framework.NewInferenceRequest()
+	request.Body = map[string]any{"model": "foo", "prompt": "test"}
+	if err := restored.ExecuteRequestPlugins(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := request.GetHeader(bodyfieldtoheader.ModelHeader); got != "foo" {
+		t.Errorf("header %s = %q, want %q", bodyfieldtoheader.ModelHeader, got, "foo")
+	}
+}
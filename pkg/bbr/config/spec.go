@@ -19,15 +19,28 @@ package config
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+const (
+	timeoutOption   = "timeout"
+	onTimeoutOption = "on_timeout"
+	onTimeoutAbort  = "abort"
+	onTimeoutSkip   = "skip"
 )
 
 // BBRPluginSpec implements flag.Value interface and defines a repeatable configuration block specified in CLI: --plugin <type>:<name>:<json>
+// The type may be followed by comma-separated execution options: <type>,timeout=<duration>,on_timeout=abort|skip
 type BBRPluginSpec struct {
-	Type string
-	Name string
-	JSON json.RawMessage // raw JSON representing parameters for the plugin factory
-	Raw  string          // original parameters string (for error messages)
+	Type    string
+	Options framework.PluginOptions // execution options of the plugin instance, specified alongside the type
+	Name    string
+	JSON    json.RawMessage // raw JSON representing parameters for the plugin factory
+	Raw     string          // original parameters string (for error messages)
 }
 
 // BBRPluginSpecs Slice (because the plugin flag is repeatable)
@@ -43,12 +56,18 @@ func (p *BBRPluginSpecs) Set(s string) error {
 	if len(segments) < 2 {
 		return errors.New(`usage: --plugin <type>:<name>:<json>`)
 	}
-	spec.Type = strings.TrimSpace(segments[0])
+	typeSegment := strings.Split(segments[0], ",")
+	spec.Type = strings.TrimSpace(typeSegment[0])
 	spec.Name = strings.TrimSpace(segments[1])
 
 	if spec.Type == "" {
 		return errors.New("bbr plugin type cannot be empty")
 	}
+	options, err := parsePluginOptions(typeSegment[1:])
+	if err != nil {
+		return err
+	}
+	spec.Options = options
 	if spec.Name == "" {
 		return errors.New("bbr plugin name cannot be empty")
 	}
@@ -69,6 +88,38 @@ func (p *BBRPluginSpecs) Set(s string) error {
 	return nil
 }
 
+// parsePluginOptions parses the key=value execution options following the plugin type.
+func parsePluginOptions(options []string) (framework.PluginOptions, error) {
+	var pluginOptions framework.PluginOptions
+	onTimeout := ""
+	for _, option := range options {
+		key, value, found := strings.Cut(strings.TrimSpace(option), "=")
+		if !found {
+			return pluginOptions, fmt.Errorf("bbr plugin option %q must be of the form key=value", option)
+		}
+		switch strings.TrimSpace(key) {
+		case timeoutOption:
+			timeout, err := time.ParseDuration(strings.TrimSpace(value))
+			if err != nil || timeout <= 0 {
+				return pluginOptions, fmt.Errorf("bbr plugin timeout %q must be a positive duration", value)
+			}
+			pluginOptions.Timeout = timeout
+		case onTimeoutOption:
+			onTimeout = strings.TrimSpace(value)
+			if onTimeout != onTimeoutAbort && onTimeout != onTimeoutSkip {
+				return pluginOptions, fmt.Errorf("bbr plugin on_timeout %q must be %q or %q", value, onTimeoutAbort, onTimeoutSkip)
+			}
+			pluginOptions.SkipOnTimeout = onTimeout == onTimeoutSkip
+		default:
+			return pluginOptions, fmt.Errorf("unknown bbr plugin option %q", key)
+		}
+	}
+	if onTimeout != "" && pluginOptions.Timeout == 0 {
+		return pluginOptions, errors.New("bbr plugin on_timeout requires a timeout")
+	}
+	return pluginOptions, nil
+}
+
 // Type returns the flag type name for the pflag.Value interface.
 func (p *BBRPluginSpecs) Type() string { return "plugin" }
 
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestBBRPluginSpecsSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    string
+		want    BBRPluginSpec
+		wantErr bool
+	}{
+		{
+			name: "type and name",
+			flag: "body-field-to-header:model",
+			want: BBRPluginSpec{Type: "body-field-to-header", Name: "model"},
+		},
+		{
+			name: "parameters",
+			flag: `body-field-to-header:model:{"field_name":"model"}`,
+			want: BBRPluginSpec{Type: "body-field-to-header", Name: "model", JSON: []byte(`{"field_name":"model"}`)},
+		},
+		{
+			name: "timeout",
+			flag: "regex-guard,timeout=50ms:guard",
+			want: BBRPluginSpec{Type: "regex-guard", Name: "guard", Options: framework.PluginOptions{Timeout: 50 * time.Millisecond}},
+		},
+		{
+			name: "timeout skipped",
+			flag: "regex-guard, timeout=2s, on_timeout=skip:guard:{}",
+			want: BBRPluginSpec{Type: "regex-guard", Name: "guard", JSON: []byte(`{}`), Options: framework.PluginOptions{Timeout: 2 * time.Second, SkipOnTimeout: true}},
+		},
+		{name: "missing name", flag: "regex-guard", wantErr: true},
+		{name: "empty type", flag: ":guard", wantErr: true},
+		{name: "invalid json", flag: "regex-guard:guard:{invalid", wantErr: true},
+		{name: "invalid timeout", flag: "regex-guard,timeout=soon:guard", wantErr: true},
+		{name: "non-positive timeout", flag: "regex-guard,timeout=0s:guard", wantErr: true},
+		{name: "invalid on_timeout", flag: "regex-guard,timeout=1s,on_timeout=retry:guard", wantErr: true},
+		{name: "on_timeout without timeout", flag: "regex-guard,on_timeout=skip:guard", wantErr: true},
+		{name: "unknown option", flag: "regex-guard,retries=3:guard", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var specs BBRPluginSpecs
+			err := specs.Set(tt.flag)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.want.Raw = tt.flag
+			if diff := cmp.Diff(BBRPluginSpecs{tt.want}, specs); diff != "" {
+				t.Errorf("unexpected specs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// AnthropicVersionHeader is the header carrying the version of the Anthropic Messages API.
+	AnthropicVersionHeader = "anthropic-version"
+	// anthropicVersionField is the body field carrying the API version when the Anthropic Messages API is
+	// served by a cloud provider, e.g. "bedrock-2023-05-31".
+	anthropicVersionField = "anthropic_version"
+)
+
+// AnthropicMessageParams are the parameters of an Anthropic Messages API request.
+type AnthropicMessageParams struct {
+	Model    string             `json:"model"`
+	Messages []AnthropicMessage `json:"messages"`
+	// MaxTokens is required by the Anthropic Messages API, but not by e.g. its count_tokens endpoint.
+	MaxTokens int `json:"max_tokens"`
+	// System is the system prompt. The text blocks of a system prompt given as content blocks are joined.
+	System string `json:"-"`
+	Stream bool   `json:"stream"`
+}
+
+// AnthropicMessage is a message of an Anthropic Messages API request.
+type AnthropicMessage struct {
+	Role string `json:"role"`
+	// Content is either a string, or a list of content blocks.
+	Content any `json:"content"`
+}
+
+// IsAnthropicRequest returns whether the request uses the Anthropic Messages API format, as marked by the
+// anthropic-version header, or by the anthropic_version body field.
+func (r *InferenceRequest) IsAnthropicRequest() bool {
+	if r.GetHeader(AnthropicVersionHeader) != "" {
+		return true
+	}
+	_, ok := r.Body[anthropicVersionField]
+	return ok
+}
+
+// ParseAnthropicMessage parses the body of an Anthropic Messages API request. The parsing is lenient: fields
+// missing from some variants of the API, e.g. the model of Bedrock and Vertex AI bodies, which is in the URL,
+// or the max_tokens of count_tokens requests, are left empty rather than rejected.
+func ParseAnthropicMessage(data []byte) (AnthropicMessageParams, error) {
+	var params struct {
+		AnthropicMessageParams
+		System json.RawMessage `json:"system"`
+	}
+	if err := json.Unmarshal(data, &params); err != nil {
+		return AnthropicMessageParams{}, err
+	}
+	system, err := parseAnthropicSystem(params.System)
+	if err != nil {
+		return AnthropicMessageParams{}, fmt.Errorf("invalid field 'system' - %w", err)
+	}
+	params.AnthropicMessageParams.System = system
+	return params.AnthropicMessageParams, nil
+}
+
+// parseAnthropicSystem returns the text of a system prompt, which is either a string or a list of text blocks.
+func parseAnthropicSystem(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+	var system string
+	if err := json.Unmarshal(raw, &system); err == nil {
+		return system, nil
+	}
+	var blocks []struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(raw, &blocks); err != nil {
+		return "", errors.New("must be a string or a list of text blocks")
+	}
+	texts := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		texts = append(texts, block.Text)
+	}
+	return strings.Join(texts, "\n"), nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsAnthropicRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		body    map[string]any
+		want    bool
+	}{
+		{name: "anthropic-version header", headers: map[string]string{"anthropic-version": "2023-06-01"}, want: true},
+		{name: "anthropic_version field", body: map[string]any{"anthropic_version": "bedrock-2023-05-31"}, want: true},
+		{name: "OpenAI request", body: map[string]any{"model": "m", "messages": []any{}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewInferenceRequest()
+			for k, v := range tt.headers {
+				r.Headers[k] = v
+			}
+			for k, v := range tt.body {
+				r.Body[k] = v
+			}
+			if got := r.IsAnthropicRequest(); got != tt.want {
+				t.Errorf("IsAnthropicRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAnthropicMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    AnthropicMessageParams
+		wantErr bool
+	}{
+		{
+			name: "string system prompt",
+			data: `{"model":"claude-3","max_tokens":64,"system":"be brief","stream":true,"messages":[{"role":"user","content":"hi"}]}`,
+			want: AnthropicMessageParams{
+				Model:     "claude-3",
+				Messages:  []AnthropicMessage{{Role: "user", Content: "hi"}},
+				MaxTokens: 64,
+				System:    "be brief",
+				Stream:    true,
+			},
+		},
+		{
+			name: "system prompt blocks",
+			data: `{"model":"claude-3","max_tokens":64,"system":[{"type":"text","text":"be brief"},{"type":"text","text":"be kind"}],"messages":[{"role":"user","content":[{"type":"text","text":"hi"}]}]}`,
+			want: AnthropicMessageParams{
+				Model:     "claude-3",
+				Messages:  []AnthropicMessage{{Role: "user", Content: []any{map[string]any{"type": "text", "text": "hi"}}}},
+				MaxTokens: 64,
+				System:    "be brief\nbe kind",
+			},
+		},
+		{
+			name: "bedrock body without model",
+			data: `{"anthropic_version":"bedrock-2023-05-31","max_tokens":64,"messages":[{"role":"user","content":"hi"}]}`,
+			want: AnthropicMessageParams{
+				Messages:  []AnthropicMessage{{Role: "user", Content: "hi"}},
+				MaxTokens: 64,
+			},
+		},
+		{
+			name: "count tokens body without max tokens",
+			data: `{"model":"claude-3","messages":[{"role":"user","content":"hi"}]}`,
+			want: AnthropicMessageParams{
+				Model:    "claude-3",
+				Messages: []AnthropicMessage{{Role: "user", Content: "hi"}},
+			},
+		},
+		{
+			name:    "invalid system prompt",
+			data:    `{"model":"claude-3","max_tokens":64,"system":42,"messages":[{"role":"user","content":"hi"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			data:    `{"model":`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAnthropicMessage([]byte(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected params (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,6 +27,28 @@ var (
 	ErrNotFound = errors.New("not found")
 )
 
+// RequestBodyBytesKey is the CycleState key of the raw request body bytes, as received from the client.
+// It is written before the request plugins are executed, for plugins that need to inspect the body
+// before it was parsed (e.g., parsing replaces invalid UTF-8 sequences in strings).
+const RequestBodyBytesKey = "bbr.request-body-bytes"
+
+// AnthropicMessageParamsKey is the CycleState key of the AnthropicMessageParams of requests in the
+// Anthropic Messages API format. It is written before the request plugins are executed, if the body parses.
+const AnthropicMessageParamsKey = "bbr.anthropic-message-params"
+
+// AuditBodyKey is the CycleState key of a redacted copy of the parsed request body, written by plugins
+// that remove secrets from the body. Plugins that log or persist the request body should prefer it over
+// the body that is forwarded upstream.
+const AuditBodyKey = "bbr.audit-body"
+
+// ResponseBodyBytesKey is the CycleState key of the raw body bytes of event stream responses, which are
+// not parsed since the stream is not a single JSON object.
+const ResponseBodyBytesKey = "bbr.response-body-bytes"
+
+// FirstResponseChunkTimeKey is the CycleState key of the time.Time the first non-empty response body
+// chunk was received, e.g. for measuring the time to first token of streamed responses.
+const FirstResponseChunkTimeKey = "bbr.first-response-chunk-time"
+
 // NewCycleState initializes a new CycleState and returns its pointer.
 func NewCycleState() *CycleState {
 	return &CycleState{}
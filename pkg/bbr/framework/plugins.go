@@ -18,6 +18,7 @@ package framework
 
 import (
 	"context"
+	"errors"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 )
@@ -26,6 +27,10 @@ import (
 // This interface should be embedded in all plugins across bbr code.
 type BBRPlugin plugin.Plugin // alias
 
+// ErrSkipRemainingPlugins is returned by a RequestProcessor to forward the request without running the
+// remaining request plugins, e.g. for health check probes. It is not a failure.
+var ErrSkipRemainingPlugins = errors.New("skip the remaining request plugins")
+
 type RequestProcessor interface {
 	BBRPlugin
 	// ProcessRequest runs the RequestProcessor plugin.
@@ -39,3 +44,66 @@ type ResponseProcessor interface {
 	// ResponseProcessor can mutate the headers and/or the body of the response.
 	ProcessResponse(ctx context.Context, cycleState *CycleState, response *InferenceResponse) error
 }
+
+// ModelSelector is implemented by request plugins selecting the model that serves a request among
+// candidate models.
+type ModelSelector interface {
+	RequestProcessor
+	// SelectModel returns the model selected for the request, or "" if the plugin does not select the model
+	// of the request.
+	SelectModel(ctx context.Context, cycleState *CycleState, request *InferenceRequest) (string, error)
+}
+
+// RateLimiter is implemented by request plugins limiting the rate of the requests of each client, which reject
+// the requests exceeding it. In a PluginsDAG, a rate limiter runs before all the plugins it does not depend on,
+// so that rejected requests are not processed by them.
+type RateLimiter interface {
+	RequestProcessor
+	// Allow consumes a request of the client identified by the key. If the request is not allowed, it returns
+	// the number of seconds after which it would be.
+	Allow(ctx context.Context, key string) (allowed bool, retryAfterSecs int, err error)
+}
+
+// RequestPlanner is implemented by request plugins that can predict how they would process a request without
+// processing it, so that a plugin chain can be dry-run against sample requests.
+type RequestPlanner interface {
+	// PlanRequest returns how the plugin would process the request. It must neither mutate the request nor
+	// have side effects, e.g. calling external services.
+	PlanRequest(ctx context.Context, request *InferenceRequest) RequestPlan
+}
+
+// RequestPlan is the predicted processing of a request by a plugin.
+type RequestPlan struct {
+	// Fires is whether the plugin would act on the request.
+	Fires bool
+	// RequiresBody is whether the plugin reads the parsed request body, as opposed to the headers only.
+	RequiresBody bool
+	// Headers are the keys of the headers the plugin would set.
+	Headers []string
+	// BodyMutated is whether the plugin would mutate the request body.
+	BodyMutated bool
+}
+
+// CacheEvictor is implemented by plugins holding a cache, so that its entries can be evicted at runtime, e.g.
+// through the admin API.
+type CacheEvictor interface {
+	// Evict removes the cache entry with the given key, or all the entries if the key is empty, and returns
+	// the number of removed entries.
+	Evict(key string) int
+}
+
+// Closer is implemented by plugins running background work, e.g. goroutines started by their factory, so that
+// the work can be stopped once the plugin is removed at runtime, e.g. through the admin API.
+type Closer interface {
+	// Close stops the background work of the plugin. Requests in flight may still call the plugin afterwards.
+	Close() error
+}
+
+// RequestCompleter is implemented by request plugins holding state for a request in flight, so that the state
+// can be released once the processing of the request ended, whether its response was processed or not, e.g.
+// when the upstream failed or the client disconnected.
+type RequestCompleter interface {
+	// CompleteRequest is called once per request processed by the plugin, after the ext_proc stream of the
+	// request ended.
+	CompleteRequest(ctx context.Context, cycleState *CycleState)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// PluginsDAG is a directed acyclic graph of request plugins, keyed by the plugin instance names.
+// An edge from one plugin to another declares that the second plugin depends on the data written by the
+// first one, so it must run after it. Plugins without a path between them have no data dependency, and
+// can run concurrently. A RateLimiter runs before all the other plugins without a path to it, as if the
+// graph had an edge from it to each of them, except for the other rate limiters.
+type PluginsDAG interface {
+	// AddPlugin adds a plugin to the graph. It returns an error if a plugin with the same name was added.
+	AddPlugin(plugin RequestProcessor) error
+	// AddEdge declares that the plugin named to runs after the plugin named from. It returns an error if
+	// either plugin was not added, or if the edge would create a circular dependency.
+	AddEdge(from, to string) error
+	// TopologicalOrder returns the plugin names in layers. All the dependencies of the plugins in a layer
+	// are in the previous layers, and the plugins of a layer are in the order they were added.
+	TopologicalOrder() ([][]string, error)
+	// Plugin returns the plugin with the given name, or nil if there is none.
+	Plugin(name string) RequestProcessor
+}
+
+// NewPluginsDAG returns an empty PluginsDAG.
+func NewPluginsDAG() PluginsDAG {
+	return &pluginsDAG{
+		plugins: map[string]RequestProcessor{},
+		edges:   map[string]sets.Set[string]{},
+	}
+}
+
+type pluginsDAG struct {
+	plugins map[string]RequestProcessor
+	// names keeps the order in which the plugins were added, so that the topological order is deterministic.
+	names []string
+	// edges maps a plugin name to the names of the plugins that depend on it.
+	edges map[string]sets.Set[string]
+}
+
+func (d *pluginsDAG) AddPlugin(plugin RequestProcessor) error {
+	name := plugin.TypedName().Name
+	if _, ok := d.plugins[name]; ok {
+		return fmt.Errorf("plugin '%s' is already in the graph", name)
+	}
+	d.plugins[name] = plugin
+	d.names = append(d.names, name)
+	d.edges[name] = sets.New[string]()
+	return nil
+}
+
+func (d *pluginsDAG) AddEdge(from, to string) error {
+	for _, name := range []string{from, to} {
+		if _, ok := d.plugins[name]; !ok {
+			return fmt.Errorf("plugin '%s' is not in the graph", name)
+		}
+	}
+	if from == to || d.reachable(to, from) {
+		return fmt.Errorf("edge from '%s' to '%s' creates a circular dependency", from, to)
+	}
+	d.edges[from].Insert(to)
+	return nil
+}
+
+// reachable returns whether there is a path from the plugin named from to the plugin named to.
+func (d *pluginsDAG) reachable(from, to string) bool {
+	visited := sets.New[string]()
+	stack := []string{from}
+	for len(stack) > 0 {
+		name := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if name == to {
+			return true
+		}
+		if visited.Has(name) {
+			continue
+		}
+		visited.Insert(name)
+		stack = append(stack, d.edges[name].UnsortedList()...)
+	}
+	return false
+}
+
+// rateLimiterEdges returns the edges of the graph, with an edge from each RateLimiter to each plugin without a
+// path to it, except for the other rate limiters.
+func (d *pluginsDAG) rateLimiterEdges() map[string]sets.Set[string] {
+	var limiters []string
+	for _, name := range d.names {
+		if _, ok := d.plugins[name].(RateLimiter); ok {
+			limiters = append(limiters, name)
+		}
+	}
+	if len(limiters) == 0 {
+		return d.edges
+	}
+
+	edges := make(map[string]sets.Set[string], len(d.edges))
+	for name, dependents := range d.edges {
+		edges[name] = dependents.Clone()
+	}
+	for _, limiter := range limiters {
+		for _, name := range d.names {
+			if _, ok := d.plugins[name].(RateLimiter); !ok && !d.reachable(name, limiter) {
+				edges[limiter].Insert(name)
+			}
+		}
+	}
+	return edges
+}
+
+func (d *pluginsDAG) TopologicalOrder() ([][]string, error) {
+	edges := d.rateLimiterEdges()
+	inDegree := make(map[string]int, len(d.names))
+	for _, dependents := range edges {
+		for name := range dependents {
+			inDegree[name]++
+		}
+	}
+
+	var layers [][]string
+	done := 0
+	for done < len(d.names) {
+		var layer []string
+		for _, name := range d.names {
+			if degree, ok := inDegree[name]; !ok || degree == 0 {
+				layer = append(layer, name)
+			}
+		}
+		if len(layer) == 0 {
+			return nil, fmt.Errorf("the plugins graph has a circular dependency")
+		}
+		for _, name := range layer {
+			inDegree[name] = -1 // scheduled
+			for dependent := range edges[name] {
+				inDegree[dependent]--
+			}
+		}
+		layers = append(layers, layer)
+		done += len(layer)
+	}
+	return layers, nil
+}
+
+func (d *pluginsDAG) Plugin(name string) RequestProcessor {
+	return d.plugins[name]
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+type noopRequestPlugin struct {
+	name string
+}
+
+func (p *noopRequestPlugin) TypedName() plugin.TypedName {
+	return plugin.TypedName{Type: "noop", Name: p.name}
+}
+
+func (p *noopRequestPlugin) ProcessRequest(_ context.Context, _ *CycleState, _ *InferenceRequest) error {
+	return nil
+}
+
+type noopRateLimiter struct {
+	noopRequestPlugin
+}
+
+func (p *noopRateLimiter) Allow(_ context.Context, _ string) (bool, int, error) {
+	return true, 0, nil
+}
+
+func newTestDAG(t *testing.T, names ...string) PluginsDAG {
+	t.Helper()
+	dag := NewPluginsDAG()
+	for _, name := range names {
+		if err := dag.AddPlugin(&noopRequestPlugin{name: name}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	return dag
+}
+
+func TestPluginsDAGTopologicalOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges [][2]string
+		want  [][]string
+	}{
+		{
+			name: "no edges",
+			want: [][]string{{"a", "b", "c", "d"}},
+		},
+		{
+			name:  "chain",
+			edges: [][2]string{{"c", "b"}, {"b", "a"}, {"a", "d"}},
+			want:  [][]string{{"c"}, {"b"}, {"a"}, {"d"}},
+		},
+		{
+			name:  "diamond",
+			edges: [][2]string{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}},
+			want:  [][]string{{"a"}, {"b", "c"}, {"d"}},
+		},
+		{
+			name:  "independent branches",
+			edges: [][2]string{{"a", "c"}, {"b", "d"}, {"c", "d"}},
+			want:  [][]string{{"a", "b"}, {"c"}, {"d"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dag := newTestDAG(t, "a", "b", "c", "d")
+			for _, edge := range tt.edges {
+				if err := dag.AddEdge(edge[0], edge[1]); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			got, err := dag.TopologicalOrder()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected topological order (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPluginsDAGTopologicalOrderRateLimiters(t *testing.T) {
+	tests := []struct {
+		name     string
+		limiters []string
+		edges    [][2]string
+		want     [][]string
+	}{
+		{
+			name:     "rate limiter runs before independent plugins",
+			limiters: []string{"limiter"},
+			want:     [][]string{{"limiter"}, {"a", "b", "c"}},
+		},
+		{
+			name:     "rate limiter runs after its dependencies",
+			limiters: []string{"limiter"},
+			edges:    [][2]string{{"a", "limiter"}},
+			want:     [][]string{{"a"}, {"limiter"}, {"b", "c"}},
+		},
+		{
+			name:     "rate limiter runs before the dependents of its dependencies",
+			limiters: []string{"limiter"},
+			edges:    [][2]string{{"a", "limiter"}, {"a", "b"}},
+			want:     [][]string{{"a"}, {"limiter"}, {"b", "c"}},
+		},
+		{
+			name:     "rate limiters run concurrently",
+			limiters: []string{"limiter", "other-limiter"},
+			want:     [][]string{{"limiter", "other-limiter"}, {"a", "b", "c"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dag := newTestDAG(t, "a", "b", "c")
+			for _, name := range tt.limiters {
+				if err := dag.AddPlugin(&noopRateLimiter{noopRequestPlugin{name: name}}); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			for _, edge := range tt.edges {
+				if err := dag.AddEdge(edge[0], edge[1]); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			got, err := dag.TopologicalOrder()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("unexpected topological order (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPluginsDAGErrors(t *testing.T) {
+	dag := newTestDAG(t, "a", "b", "c")
+	if err := dag.AddPlugin(&noopRequestPlugin{name: "a"}); err == nil {
+		t.Error("expected an error when adding a duplicate plugin")
+	}
+	if err := dag.AddEdge("a", "missing"); err == nil {
+		t.Error("expected an error when adding an edge to a missing plugin")
+	}
+	if err := dag.AddEdge("a", "a"); err == nil {
+		t.Error("expected an error when adding a self edge")
+	}
+	if err := dag.AddEdge("a", "b"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := dag.AddEdge("b", "c"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := dag.AddEdge("c", "a"); err == nil {
+		t.Error("expected an error when adding an edge that creates a cycle")
+	}
+	if _, err := dag.TopologicalOrder(); err != nil {
+		t.Errorf("unexpected error after a rejected edge: %v", err)
+	}
+	if dag.Plugin("b") == nil || dag.Plugin("missing") != nil {
+		t.Error("Plugin returned an unexpected plugin")
+	}
+}
@@ -18,16 +18,98 @@ package framework
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
 )
 
+// RegistryErrorCode identifies the kind of a RegistryError.
+type RegistryErrorCode int
+
+const (
+	// ErrUnsupportedType is returned when no factory is registered for a plugin type.
+	ErrUnsupportedType RegistryErrorCode = iota
+	// ErrDuplicateFactory is returned when a factory is already registered for a plugin type.
+	ErrDuplicateFactory
+	// ErrInvalidFactory is returned when registering a factory with an empty plugin type or a nil factory function.
+	ErrInvalidFactory
+)
+
+// String returns the name of the registry error code.
+func (c RegistryErrorCode) String() string {
+	switch c {
+	case ErrUnsupportedType:
+		return "UnsupportedType"
+	case ErrDuplicateFactory:
+		return "DuplicateFactory"
+	case ErrInvalidFactory:
+		return "InvalidFactory"
+	default:
+		return fmt.Sprintf("RegistryErrorCode(%d)", int(c))
+	}
+}
+
+// RegistryError is the error returned by the plugin registry functions. Callers can use errors.As
+// to retrieve it and switch on its Code, instead of parsing the error message.
+type RegistryError struct {
+	Code    RegistryErrorCode
+	TypeKey string
+	Message string
+}
+
+func (e *RegistryError) Error() string {
+	return fmt.Sprintf("plugin registry error: %s - %s (plugin type '%s')", e.Code, e.Message, e.TypeKey)
+}
+
 // Factory is the definition of the factory functions that are used to instantiate plugins
 // specified in a configuration.
 type FactoryFunc func(name string, parameters json.RawMessage, handle Handle) (BBRPlugin, error)
 
 // Register is a static function that can be called to register plugin factory functions.
-func Register(pluginType string, factory FactoryFunc) {
+// It returns a RegistryError if the plugin type or the factory is invalid, or if a factory
+// is already registered for the plugin type.
+func Register(pluginType string, factory FactoryFunc) error {
+	if pluginType == "" || factory == nil {
+		return &RegistryError{Code: ErrInvalidFactory, TypeKey: pluginType, Message: "plugin type and factory function are required"}
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := Registry[pluginType]; ok {
+		return &RegistryError{Code: ErrDuplicateFactory, TypeKey: pluginType, Message: "a factory is already registered for this plugin type"}
+	}
 	Registry[pluginType] = factory
+	return nil
+}
+
+// GetFactory returns the factory function registered for the given plugin type, or a RegistryError
+// with the ErrUnsupportedType code if there is none.
+func GetFactory(pluginType string) (FactoryFunc, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	factory, ok := Registry[pluginType]
+	if !ok {
+		return nil, &RegistryError{Code: ErrUnsupportedType, TypeKey: pluginType, Message: "no factory is registered for this plugin type"}
+	}
+	return factory, nil
 }
 
-// Registry is a mapping from plugin name to Factory function
+// RegisteredTypes returns the sorted plugin types that have a registered factory, e.g. to validate or audit
+// a plugin configuration.
+func RegisteredTypes() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	types := make([]string, 0, len(Registry))
+	for pluginType := range Registry {
+		types = append(types, pluginType)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// Registry is a mapping from plugin name to Factory function.
+// It is guarded by registryMu, so it should be accessed through Register and GetFactory,
+// which are safe for concurrent use.
 var Registry map[string]FactoryFunc = map[string]FactoryFunc{}
+
+// registryMu guards Registry: writes take the full lock and reads take the read lock.
+var registryMu sync.RWMutex
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	factory := func(string, json.RawMessage, Handle) (BBRPlugin, error) { return nil, nil }
+	const pluginType = "registry-test-plugin"
+	t.Cleanup(func() { delete(Registry, pluginType) })
+
+	if _, err := GetFactory(pluginType); registryErrorCode(t, err) != ErrUnsupportedType {
+		t.Errorf("GetFactory() error code = %s, want %s", registryErrorCode(t, err), ErrUnsupportedType)
+	}
+	if err := Register(pluginType, factory); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := GetFactory(pluginType); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !slices.Contains(RegisteredTypes(), pluginType) {
+		t.Errorf("RegisteredTypes() = %v, want it to contain %s", RegisteredTypes(), pluginType)
+	}
+	if err := Register(pluginType, factory); registryErrorCode(t, err) != ErrDuplicateFactory {
+		t.Errorf("Register() error code = %s, want %s", registryErrorCode(t, err), ErrDuplicateFactory)
+	}
+	if err := Register("", factory); registryErrorCode(t, err) != ErrInvalidFactory {
+		t.Errorf("Register() error code = %s, want %s", registryErrorCode(t, err), ErrInvalidFactory)
+	}
+	if err := Register("other-plugin", nil); registryErrorCode(t, err) != ErrInvalidFactory {
+		t.Errorf("Register() error code = %s, want %s", registryErrorCode(t, err), ErrInvalidFactory)
+	}
+}
+
+// TestRegistryConcurrentAccess registers and looks up factories from concurrent goroutines.
+// Run with -race to detect unsynchronized access to the Registry.
+func TestRegistryConcurrentAccess(t *testing.T) {
+	factory := func(string, json.RawMessage, Handle) (BBRPlugin, error) { return nil, nil }
+	const goroutines = 50
+	pluginTypes := make([]string, goroutines)
+	for i := range pluginTypes {
+		pluginTypes[i] = fmt.Sprintf("registry-concurrency-test-plugin-%d", i)
+	}
+	t.Cleanup(func() {
+		for _, pluginType := range pluginTypes {
+			delete(Registry, pluginType)
+		}
+	})
+
+	var wg sync.WaitGroup
+	for i := range goroutines {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if err := Register(pluginTypes[i], factory); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			// the factory may or may not be registered yet, only concurrent access is under test
+			_, _ = GetFactory(pluginTypes[(i+1)%goroutines])
+		}()
+	}
+	wg.Wait()
+
+	for _, pluginType := range pluginTypes {
+		if _, err := GetFactory(pluginType); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+}
+
+// registryErrorCode returns the code of a RegistryError, and fails the test if err is not one.
+func registryErrorCode(t *testing.T, err error) RegistryErrorCode {
+	t.Helper()
+	var registryErr *RegistryError
+	if !errors.As(err, &registryErr) {
+		t.Fatalf("expected a RegistryError, got %v", err)
+	}
+	if registryErr.TypeKey == "" && registryErr.Code != ErrInvalidFactory {
+		t.Errorf("expected the RegistryError to have a TypeKey")
+	}
+	return registryErr.Code
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// ErrPluginTimeout is returned by a plugin decorated with WithTimeout when it did not complete before its
+// deadline.
+var ErrPluginTimeout = errors.New("plugin timed out")
+
+// PluginOptions are the execution options of a plugin instance, configured next to its type.
+type PluginOptions struct {
+	// Timeout bounds the execution time of the plugin. Zero means no timeout.
+	Timeout time.Duration
+	// SkipOnTimeout continues with the next plugin when the plugin times out, instead of failing the request.
+	SkipOnTimeout bool
+}
+
+// WithTimeout decorates the plugin so that its context is canceled when the timeout expires, in which case
+// ErrPluginTimeout is returned. The decorated plugin implements the same processor interfaces as the plugin.
+// Plugins are expected to return promptly once their context is canceled; the decorator waits for the plugin
+// to return, so that it never mutates the request or the response concurrently with the next plugins.
+func WithTimeout(bbrPlugin BBRPlugin, timeout time.Duration) BBRPlugin {
+	base := timeoutPlugin{BBRPlugin: bbrPlugin, timeout: timeout}
+	requestProcessor, isRequestProcessor := bbrPlugin.(RequestProcessor)
+	responseProcessor, isResponseProcessor := bbrPlugin.(ResponseProcessor)
+	switch {
+	case isRequestProcessor && isResponseProcessor:
+		return &timeoutProcessor{
+			timeoutRequestProcessor:  timeoutRequestProcessor{timeoutPlugin: base, processor: requestProcessor},
+			timeoutResponseProcessor: timeoutResponseProcessor{timeoutPlugin: base, processor: responseProcessor},
+		}
+	case isRequestProcessor:
+		return &timeoutRequestProcessor{timeoutPlugin: base, processor: requestProcessor}
+	case isResponseProcessor:
+		return &timeoutResponseProcessor{timeoutPlugin: base, processor: responseProcessor}
+	default:
+		return bbrPlugin
+	}
+}
+
+type timeoutPlugin struct {
+	BBRPlugin
+	timeout time.Duration
+}
+
+// run runs fn with a context canceled after the timeout, and returns ErrPluginTimeout if the deadline expired.
+func (p timeoutPlugin) run(ctx context.Context, fn func(ctx context.Context) error) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	err := fn(timeoutCtx)
+	if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return fmt.Errorf("%w: plugin '%s' did not complete within %s", ErrPluginTimeout, p.TypedName(), p.timeout)
+	}
+	return err
+}
+
+type timeoutRequestProcessor struct {
+	timeoutPlugin
+	processor RequestProcessor
+}
+
+func (p *timeoutRequestProcessor) ProcessRequest(ctx context.Context, cycleState *CycleState, request *InferenceRequest) error {
+	return p.run(ctx, func(ctx context.Context) error {
+		return p.processor.ProcessRequest(ctx, cycleState, request)
+	})
+}
+
+type timeoutResponseProcessor struct {
+	timeoutPlugin
+	processor ResponseProcessor
+}
+
+func (p *timeoutResponseProcessor) ProcessResponse(ctx context.Context, cycleState *CycleState, response *InferenceResponse) error {
+	return p.run(ctx, func(ctx context.Context) error {
+		return p.processor.ProcessResponse(ctx, cycleState, response)
+	})
+}
+
+// timeoutProcessor decorates plugins that process both requests and responses.
+type timeoutProcessor struct {
+	timeoutRequestProcessor
+	timeoutResponseProcessor
+}
+
+func (p *timeoutProcessor) TypedName() plugin.TypedName {
+	return p.timeoutRequestProcessor.TypedName()
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// sleepingPlugin processes requests and responses after a delay, or until its context is canceled.
+type sleepingPlugin struct {
+	delay time.Duration
+}
+
+func (p *sleepingPlugin) TypedName() plugin.TypedName {
+	return plugin.TypedName{Type: "sleeping", Name: "sleeper"}
+}
+
+func (p *sleepingPlugin) sleep(ctx context.Context) error {
+	select {
+	case <-time.After(p.delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *sleepingPlugin) ProcessRequest(ctx context.Context, _ *CycleState, _ *InferenceRequest) error {
+	return p.sleep(ctx)
+}
+
+func (p *sleepingPlugin) ProcessResponse(ctx context.Context, _ *CycleState, _ *InferenceResponse) error {
+	return p.sleep(ctx)
+}
+
+func TestWithTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		delay   time.Duration
+		wantErr error
+	}{
+		{name: "completes before the deadline", delay: time.Millisecond},
+		{name: "sleeps past the deadline", delay: time.Minute, wantErr: ErrPluginTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decorated := WithTimeout(&sleepingPlugin{delay: tt.delay}, 50*time.Millisecond)
+			if got := decorated.TypedName().Name; got != "sleeper" {
+				t.Errorf("TypedName().Name = %q, want %q", got, "sleeper")
+			}
+			requestProcessor, ok := decorated.(RequestProcessor)
+			if !ok {
+				t.Fatal("expected the decorated plugin to be a RequestProcessor")
+			}
+			responseProcessor, ok := decorated.(ResponseProcessor)
+			if !ok {
+				t.Fatal("expected the decorated plugin to be a ResponseProcessor")
+			}
+
+			if err := requestProcessor.ProcessRequest(context.Background(), NewCycleState(), NewInferenceRequest()); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ProcessRequest() error = %v, want %v", err, tt.wantErr)
+			}
+			if err := responseProcessor.ProcessResponse(context.Background(), NewCycleState(), NewInferenceResponse()); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ProcessResponse() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithTimeoutKeepsProcessorInterfaces(t *testing.T) {
+	decorated := WithTimeout(&noopRequestPlugin{name: "noop"}, time.Second)
+	if _, ok := decorated.(RequestProcessor); !ok {
+		t.Error("expected the decorated plugin to be a RequestProcessor")
+	}
+	if _, ok := decorated.(ResponseProcessor); ok {
+		t.Error("expected the decorated plugin not to be a ResponseProcessor")
+	}
+}
@@ -17,9 +17,31 @@ limitations under the License.
 package framework
 
 import (
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+const (
+	// PathHeader is the Envoy pseudo-header carrying the request path.
+	PathHeader = ":path"
+	// StatusHeader is the Envoy pseudo-header carrying the response status code.
+	StatusHeader = ":status"
+	// BatchesPath is the path of the batch endpoint, whose body is newline-delimited JSON rather than a
+	// single JSON object. The body of batch requests is not parsed, and plugins read the raw body bytes
+	// from the CycleState under RequestBodyBytesKey.
+	BatchesPath = "/v1/batches"
+
+	contentTypeHeader      = "content-type"
+	eventStreamContentType = "text/event-stream"
+	// multipartContentType is the content type of multipart form requests, e.g. audio transcriptions.
+	// Like batch requests, their body is not parsed, and plugins read the raw body bytes from the
+	// CycleState under RequestBodyBytesKey.
+	multipartContentType = "multipart/form-data"
+)
+
 func newInferenceMessage() InferenceMessage {
 	return InferenceMessage{
 		Headers:        map[string]string{},
@@ -48,6 +70,20 @@ func (r *InferenceMessage) SetHeader(key string, value string) {
 	}
 }
 
+// GetHeader returns the value of the given header, or an empty string if it is not set.
+// The lookup is case-insensitive, since Envoy forwards header keys in lower case.
+func (r *InferenceMessage) GetHeader(key string) string {
+	if value, ok := r.Headers[key]; ok {
+		return value
+	}
+	for k, value := range r.Headers {
+		if strings.EqualFold(k, key) {
+			return value
+		}
+	}
+	return ""
+}
+
 func (r *InferenceMessage) RemoveHeader(key string) {
 	if _, ok := r.Headers[key]; ok {
 		delete(r.Headers, key)
@@ -87,10 +123,66 @@ func (r *InferenceMessage) BodyMutated() bool {
 
 type InferenceRequest struct {
 	InferenceMessage
+
+	// DynamicMetadata is returned to Envoy as the dynamic metadata of the request, e.g. for use in RBAC
+	// policies. It is keyed by the metadata namespace, and is nil when no plugin sets metadata.
+	DynamicMetadata *structpb.Struct
+}
+
+// SetDynamicMetadata sets the given field of the dynamic metadata in the given namespace.
+func (r *InferenceRequest) SetDynamicMetadata(namespace, key string, value *structpb.Value) {
+	if r.DynamicMetadata == nil {
+		r.DynamicMetadata = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	}
+	ns := r.DynamicMetadata.Fields[namespace].GetStructValue()
+	if ns == nil {
+		ns = &structpb.Struct{Fields: map[string]*structpb.Value{}}
+		r.DynamicMetadata.Fields[namespace] = structpb.NewStructValue(ns)
+	}
+	ns.Fields[key] = value
+}
+
+// IsBatchRequest returns whether the request targets the batch endpoint.
+func (r *InferenceRequest) IsBatchRequest() bool {
+	path, _, _ := strings.Cut(r.GetHeader(PathHeader), "?")
+	return path == BatchesPath
+}
+
+// IsMultipartRequest returns whether the request body is a multipart form.
+func (r *InferenceRequest) IsMultipartRequest() bool {
+	return strings.HasPrefix(strings.ToLower(r.GetHeader(contentTypeHeader)), multipartContentType)
 }
 
 type InferenceResponse struct {
 	InferenceMessage
+
+	// rawBody replaces the response body when set, e.g. for event streams whose body is not parsed.
+	rawBody []byte
+}
+
+// SetRawBody replaces the response body with the given bytes, which take precedence over the parsed Body.
+// It is used by plugins rewriting event streams, which are not parsed into Body.
+func (r *InferenceResponse) SetRawBody(body []byte) {
+	r.rawBody = body
+	r.bodyMutated = true
+}
+
+// RawBody returns the body bytes set by SetRawBody, or nil if the body was not replaced.
+func (r *InferenceResponse) RawBody() []byte {
+	return r.rawBody
+}
+
+// IsEventStream returns whether the response is a stream of server-sent events.
+func (r *InferenceResponse) IsEventStream() bool {
+	return strings.HasPrefix(r.GetHeader(contentTypeHeader), eventStreamContentType)
+}
+
+// IsSuccess returns whether the response has a 2xx status code. Responses without a status code are not
+// successful. Error bodies vary across model servers, e.g. {"object":"error",...} for vLLM, so plugins storing
+// or sharing responses should check the status code rather than the body.
+func (r *InferenceResponse) IsSuccess() bool {
+	status, err := strconv.Atoi(r.GetHeader(StatusHeader))
+	return err == nil && status >= 200 && status < 300
 }
 
 // NewInferenceRequest returns a new request with initialized Headers, Body, and mutatedHeaders.
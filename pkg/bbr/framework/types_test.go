@@ -17,7 +17,10 @@ limitations under the License.
 package framework
 
 import (
+	"reflect"
 	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestSetBodyField(t *testing.T) {
@@ -101,3 +104,120 @@ func TestBodyMutated_FalseByDefault(t *testing.T) {
 		t.Error("new InferenceResponse should not be marked as body-mutated")
 	}
 }
+
+func TestGetHeader(t *testing.T) {
+	msg := newInferenceMessage()
+	msg.Headers["authorization"] = "Bearer token"
+	msg.Headers["X-Custom"] = "value"
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "authorization", want: "Bearer token"},
+		{key: "Authorization", want: "Bearer token"},
+		{key: "x-custom", want: "value"},
+		{key: "missing", want: ""},
+	}
+	for _, tt := range tests {
+		if got := msg.GetHeader(tt.key); got != tt.want {
+			t.Errorf("GetHeader(%q) = %q; want %q", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestIsBatchRequest(t *testing.T) {
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{path: "/v1/batches", want: true},
+		{path: "/v1/batches?limit=10", want: true},
+		{path: "/v1/chat/completions", want: false},
+		{path: "", want: false},
+	}
+	for _, tt := range tests {
+		req := NewInferenceRequest()
+		req.Headers[PathHeader] = tt.path
+		if got := req.IsBatchRequest(); got != tt.want {
+			t.Errorf("IsBatchRequest() with path %q = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestIsMultipartRequest(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        bool
+	}{
+		{contentType: "multipart/form-data; boundary=xyz", want: true},
+		{contentType: "Multipart/Form-Data; boundary=xyz", want: true},
+		{contentType: "application/json", want: false},
+		{contentType: "", want: false},
+	}
+	for _, tt := range tests {
+		req := NewInferenceRequest()
+		req.Headers["content-type"] = tt.contentType
+		if got := req.IsMultipartRequest(); got != tt.want {
+			t.Errorf("IsMultipartRequest() with content type %q = %v, want %v", tt.contentType, got, tt.want)
+		}
+	}
+}
+
+func TestIsSuccess(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{status: "200", want: true},
+		{status: "204", want: true},
+		{status: "304", want: false},
+		{status: "400", want: false},
+		{status: "503", want: false},
+		{status: "", want: false},
+	}
+	for _, tt := range tests {
+		resp := NewInferenceResponse()
+		if tt.status != "" {
+			resp.Headers[StatusHeader] = tt.status
+		}
+		if got := resp.IsSuccess(); got != tt.want {
+			t.Errorf("IsSuccess() with status %q = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestSetRawBody(t *testing.T) {
+	resp := NewInferenceResponse()
+	if resp.RawBody() != nil || resp.BodyMutated() {
+		t.Fatal("new response should not have a raw body")
+	}
+
+	resp.SetRawBody([]byte("data: [DONE]\n\n"))
+	if got := string(resp.RawBody()); got != "data: [DONE]\n\n" {
+		t.Errorf("RawBody() = %q, want %q", got, "data: [DONE]\n\n")
+	}
+	if !resp.BodyMutated() {
+		t.Error("BodyMutated() = false after SetRawBody, want true")
+	}
+}
+
+func TestSetDynamicMetadata(t *testing.T) {
+	req := NewInferenceRequest()
+	if req.DynamicMetadata != nil {
+		t.Fatal("new request should not have dynamic metadata")
+	}
+
+	req.SetDynamicMetadata("ns", "model", structpb.NewStringValue("llama"))
+	req.SetDynamicMetadata("ns", "tier", structpb.NewStringValue("premium"))
+	req.SetDynamicMetadata("other", "key", structpb.NewBoolValue(true))
+
+	got := req.DynamicMetadata.AsMap()
+	want := map[string]any{
+		"ns":    map[string]any{"model": "llama", "tier": "premium"},
+		"other": map[string]any{"key": true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DynamicMetadata = %v, want %v", got, want)
+	}
+}
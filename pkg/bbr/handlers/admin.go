@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+const (
+	requestChainName  = "request"
+	responseChainName = "response"
+)
+
+// AdminHandler returns the handler of the admin API, which modifies the plugin chains of the Server at runtime:
+//
+//	GET    /chains/{chain}                  returns the plugins of the chain, as a list of PluginEntry
+//	POST   /chains/{chain}/plugins          appends the plugin of the PluginEntry in the body to the chain
+//	DELETE /chains/{chain}/plugins/{index}  removes the plugin at the index from the chain
+//	DELETE /plugins/{name}/cache            evicts all the cache entries of the plugin
+//	DELETE /plugins/{name}/cache/{key}      evicts the cache entry with the key from the plugin
+//
+// where chain is "request" or "response". Appended plugins are created with the factories of the plugin
+// registry and the given handle. Like at startup, a plugin processing both requests and responses is appended
+// to both chains, and removed from both when removed from either; removed plugins implementing
+// framework.Closer are closed. A change replaces the chain under the write lock of the Server, so requests
+// in flight complete with the chain they started with, and later requests run the new chain. Request
+// plugins executed as a PluginsDAG can be listed but not changed. Cache entries can be evicted from plugins
+// implementing framework.CacheEvictor. The admin API is not authenticated, so it must not be reachable by
+// untrusted clients.
+func (s *Server) AdminHandler(handle framework.Handle) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /chains/{chain}", s.getChain)
+	mux.HandleFunc("POST /chains/{chain}/plugins", func(w http.ResponseWriter, r *http.Request) {
+		s.appendPlugin(w, r, handle)
+	})
+	mux.HandleFunc("DELETE /chains/{chain}/plugins/{index}", s.removePlugin)
+	mux.HandleFunc("DELETE /plugins/{name}/cache", s.evictCache)
+	mux.HandleFunc("DELETE /plugins/{name}/cache/{key}", s.evictCache)
+	return mux
+}
+
+func (s *Server) getChain(w http.ResponseWriter, r *http.Request) {
+	switch r.PathValue("chain") {
+	case requestChainName:
+		writeChain(w, http.StatusOK, requestPluginEntries(s.requestChain()))
+	case responseChainName:
+		writeChain(w, http.StatusOK, pluginEntries(s.responseChain(), nil))
+	default:
+		http.Error(w, fmt.Sprintf("unknown chain '%s'", r.PathValue("chain")), http.StatusNotFound)
+	}
+}
+
+func (s *Server) appendPlugin(w http.ResponseWriter, r *http.Request, handle framework.Handle) {
+	chain := r.PathValue("chain")
+	if chain != requestChainName && chain != responseChainName {
+		http.Error(w, fmt.Sprintf("unknown chain '%s'", chain), http.StatusNotFound)
+		return
+	}
+
+	var entry PluginEntry
+	if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+		http.Error(w, fmt.Sprintf("failed to parse the plugin - %v", err), http.StatusBadRequest)
+		return
+	}
+	if entry.Name == "" {
+		http.Error(w, "plugin name cannot be empty", http.StatusBadRequest)
+		return
+	}
+	factory, err := framework.GetFactory(entry.Type)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// the plugin is created before taking the lock, as factories may be slow, e.g. fetch remote keys
+	instance, err := factory(entry.Name, entry.Parameters, handle)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to create plugin '%s' of type '%s' - %v", entry.Name, entry.Type, err), http.StatusBadRequest)
+		return
+	}
+
+	requestPlugin, isRequestPlugin := instance.(framework.RequestProcessor)
+	responsePlugin, isResponsePlugin := instance.(framework.ResponseProcessor)
+	if chain == requestChainName && !isRequestPlugin {
+		s.rejectPlugin(w, r, instance, fmt.Sprintf("plugin '%s' of type '%s' is not a request plugin", entry.Name, entry.Type), http.StatusBadRequest)
+		return
+	}
+	if chain == responseChainName && !isResponsePlugin {
+		s.rejectPlugin(w, r, instance, fmt.Sprintf("plugin '%s' of type '%s' is not a response plugin", entry.Name, entry.Type), http.StatusBadRequest)
+		return
+	}
+
+	s.chainsMu.Lock()
+	defer s.chainsMu.Unlock()
+
+	// the plugin is validated against both chains before changing either, so that it is appended to all or none
+	current, isChain := s.requestExecutor.(*pluginsChain)
+	if isRequestPlugin && !isChain {
+		s.rejectPlugin(w, r, instance, "the request plugins are not executed as a chain and cannot be changed", http.StatusConflict)
+		return
+	}
+	if (isRequestPlugin && slices.ContainsFunc(current.configured, hasName[framework.RequestProcessor](entry.Name))) ||
+		(isResponsePlugin && slices.ContainsFunc(s.responsePlugins, hasName[framework.ResponseProcessor](entry.Name))) {
+		s.rejectPlugin(w, r, instance, fmt.Sprintf("duplicate plugin name '%s'", entry.Name), http.StatusConflict)
+		return
+	}
+
+	var requestPlugins []framework.RequestProcessor
+	var parameters map[string]json.RawMessage
+	if isRequestPlugin {
+		requestPlugins = append(slices.Clip(current.configured), requestPlugin)
+		parameters = maps.Clone(current.parameters)
+		if len(entry.Parameters) > 0 {
+			if parameters == nil {
+				parameters = map[string]json.RawMessage{}
+			}
+			parameters[entry.Name] = entry.Parameters
+		}
+		s.requestExecutor = NewPluginsChainWithParameters(requestPlugins, current.options, parameters)
+	}
+	if isResponsePlugin {
+		s.responsePlugins = append(slices.Clip(s.responsePlugins), responsePlugin)
+	}
+
+	log.FromContext(r.Context()).V(logutil.DEFAULT).Info("Appended plugin to chains", "chain", chain, "plugin", instance.TypedName(),
+		"request", isRequestPlugin, "response", isResponsePlugin)
+	if chain == requestChainName {
+		writeChain(w, http.StatusCreated, pluginEntries(requestPlugins, parameters))
+	} else {
+		writeChain(w, http.StatusCreated, pluginEntries(s.responsePlugins, nil))
+	}
+}
+
+// rejectPlugin closes the plugin created for a rejected append request, so that its background work stops,
+// and writes the error.
+func (s *Server) rejectPlugin(w http.ResponseWriter, r *http.Request, instance framework.BBRPlugin, msg string, code int) {
+	closePlugin(r.Context(), instance)
+	http.Error(w, msg, code)
+}
+
+func (s *Server) removePlugin(w http.ResponseWriter, r *http.Request) {
+	chain := r.PathValue("chain")
+	if chain != requestChainName && chain != responseChainName {
+		http.Error(w, fmt.Sprintf("unknown chain '%s'", chain), http.StatusNotFound)
+		return
+	}
+	index, err := strconv.Atoi(r.PathValue("index"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid plugin index '%s'", r.PathValue("index")), http.StatusBadRequest)
+		return
+	}
+
+	s.chainsMu.Lock()
+	defer s.chainsMu.Unlock()
+
+	current, isChain := s.requestExecutor.(*pluginsChain)
+	var removed framework.BBRPlugin
+	switch chain {
+	case requestChainName:
+		if !isChain {
+			http.Error(w, "the request plugins are not executed as a chain and cannot be changed", http.StatusConflict)
+			return
+		}
+		if index < 0 || index >= len(current.configured) {
+			http.Error(w, fmt.Sprintf("no plugin at index %d", index), http.StatusNotFound)
+			return
+		}
+		removed = current.configured[index]
+	case responseChainName:
+		if index < 0 || index >= len(s.responsePlugins) {
+			http.Error(w, fmt.Sprintf("no plugin at index %d", index), http.StatusNotFound)
+			return
+		}
+		removed = s.responsePlugins[index]
+		if _, isRequestPlugin := removed.(framework.RequestProcessor); isRequestPlugin && !isChain {
+			http.Error(w, "the request plugins are not executed as a chain and cannot be changed", http.StatusConflict)
+			return
+		}
+	}
+
+	// a plugin processing both requests and responses is removed from both chains
+	if isChain && slices.ContainsFunc(current.configured, isPlugin[framework.RequestProcessor](removed)) {
+		plugins := slices.DeleteFunc(slices.Clone(current.configured), isPlugin[framework.RequestProcessor](removed))
+		parameters := maps.Clone(current.parameters)
+		delete(parameters, removed.TypedName().Name)
+		s.requestExecutor = NewPluginsChainWithParameters(plugins, current.options, parameters)
+	}
+	s.responsePlugins = slices.DeleteFunc(slices.Clone(s.responsePlugins), isPlugin[framework.ResponseProcessor](removed))
+	closePlugin(r.Context(), removed)
+
+	log.FromContext(r.Context()).V(logutil.DEFAULT).Info("Removed plugin from chains", "chain", chain, "index", index, "plugin", removed.TypedName())
+	if chain == requestChainName {
+		writeChain(w, http.StatusOK, requestPluginEntries(s.requestExecutor))
+	} else {
+		writeChain(w, http.StatusOK, pluginEntries(s.responsePlugins, nil))
+	}
+}
+
+// closePlugin stops the background work of the plugin, if it implements framework.Closer.
+func closePlugin(ctx context.Context, instance framework.BBRPlugin) {
+	closer, ok := instance.(framework.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to close plugin", "plugin", instance.TypedName())
+	}
+}
+
+func (s *Server) evictCache(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	requestPlugins, responsePlugins := s.requestChain().RequestPlugins(), s.responseChain()
+	var found framework.BBRPlugin
+	if index := slices.IndexFunc(requestPlugins, hasName[framework.RequestProcessor](name)); index >= 0 {
+		found = requestPlugins[index]
+	} else if index := slices.IndexFunc(responsePlugins, hasName[framework.ResponseProcessor](name)); index >= 0 {
+		found = responsePlugins[index]
+	}
+	if found == nil {
+		http.Error(w, fmt.Sprintf("unknown plugin '%s'", name), http.StatusNotFound)
+		return
+	}
+	evictor, ok := found.(framework.CacheEvictor)
+	if !ok {
+		http.Error(w, fmt.Sprintf("plugin '%s' has no cache", name), http.StatusBadRequest)
+		return
+	}
+
+	evicted := evictor.Evict(r.PathValue("key"))
+	log.FromContext(r.Context()).V(logutil.DEFAULT).Info("Evicted plugin cache entries", "plugin", found.TypedName(), "evicted", evicted)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]int{"evicted": evicted})
+}
+
+// pluginEntries returns the given plugins as a list of PluginEntry, indexed in the given order, with the given
+// parameters keyed by the plugin instance names.
+func pluginEntries[P framework.BBRPlugin](plugins []P, parameters map[string]json.RawMessage) []PluginEntry {
+	entries := make([]PluginEntry, 0, len(plugins))
+	for i, plugin := range plugins {
+		name := plugin.TypedName().Name
+		entries = append(entries, PluginEntry{Type: plugin.TypedName().Type, Name: name, Index: i, Parameters: parameters[name]})
+	}
+	return entries
+}
+
+// requestPluginEntries returns the request plugins of the executor as a list of PluginEntry, with the
+// parameters recorded by a chain.
+func requestPluginEntries(executor PluginsExecutor) []PluginEntry {
+	var parameters map[string]json.RawMessage
+	if chain, ok := executor.(*pluginsChain); ok {
+		parameters = chain.parameters
+	}
+	return pluginEntries(executor.RequestPlugins(), parameters)
+}
+
+func hasName[P framework.BBRPlugin](name string) func(P) bool {
+	return func(plugin P) bool { return plugin.TypedName().Name == name }
+}
+
+// isPlugin matches the given plugin instance, which may be registered on both chains.
+func isPlugin[P framework.BBRPlugin](instance framework.BBRPlugin) func(P) bool {
+	return func(plugin P) bool { return framework.BBRPlugin(plugin) == instance }
+}
+
+func writeChain(w http.ResponseWriter, code int, entries []PluginEntry) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(entries)
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+// fakeResponsePluginType is the factory type of the fakeResponsePlugin instances created by the admin API.
+const fakeResponsePluginType = "fake-response"
+
+type adminStep struct {
+	name       string
+	method     string
+	path       string
+	body       string
+	wantCode   int
+	wantPlugin []string
+}
+
+func runAdminSteps(t *testing.T, handler http.Handler, steps []adminStep) {
+	t.Helper()
+	for _, step := range steps {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(step.method, step.path, strings.NewReader(step.body)))
+		if rec.Code != step.wantCode {
+			t.Fatalf("%s: unexpected status code %d, want %d, body: %s", step.name, rec.Code, step.wantCode, rec.Body.String())
+		}
+		if step.wantPlugin == nil {
+			continue
+		}
+		var entries []PluginEntry
+		if err := json.Unmarshal(rec.Body.Bytes(), &entries); err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+		names := []string{}
+		for i, entry := range entries {
+			if entry.Index != i {
+				t.Errorf("%s: unexpected index %d of plugin '%s', want %d", step.name, entry.Index, entry.Name, i)
+			}
+			names = append(names, entry.Name)
+		}
+		if diff := cmp.Diff(step.wantPlugin, names); diff != "" {
+			t.Errorf("%s: unexpected plugins (-want +got):\n%s", step.name, diff)
+		}
+	}
+}
+
+func TestAdminHandler_RequestChain(t *testing.T) {
+	registerFakePluginFactory(t)
+
+	server := NewServerWithExecutor(false, NewPluginsChain([]framework.RequestProcessor{&bodyMutatingPlugin{name: "first"}}), nil)
+	handler := server.AdminHandler(nil)
+	snapshot := server.requestChain()
+
+	runAdminSteps(t, handler, []adminStep{
+		{name: "get", method: http.MethodGet, path: "/chains/request", wantCode: http.StatusOK, wantPlugin: []string{"first"}},
+		{name: "append", method: http.MethodPost, path: "/chains/request/plugins", body: `{"type":"fake","name":"second","parameters":"X-Second"}`,
+			wantCode: http.StatusCreated, wantPlugin: []string{"first", "second"}},
+		{name: "append duplicate name", method: http.MethodPost, path: "/chains/request/plugins", body: `{"type":"fake","name":"first"}`,
+			wantCode: http.StatusConflict},
+		{name: "append unknown type", method: http.MethodPost, path: "/chains/request/plugins", body: `{"type":"unknown","name":"third"}`,
+			wantCode: http.StatusBadRequest},
+		{name: "append without name", method: http.MethodPost, path: "/chains/request/plugins", body: `{"type":"fake"}`,
+			wantCode: http.StatusBadRequest},
+		{name: "append invalid body", method: http.MethodPost, path: "/chains/request/plugins", body: `{`,
+			wantCode: http.StatusBadRequest},
+		{name: "remove", method: http.MethodDelete, path: "/chains/request/plugins/0", wantCode: http.StatusOK, wantPlugin: []string{"second"}},
+		{name: "remove out of range", method: http.MethodDelete, path: "/chains/request/plugins/1", wantCode: http.StatusNotFound},
+		{name: "remove invalid index", method: http.MethodDelete, path: "/chains/request/plugins/first", wantCode: http.StatusBadRequest},
+		{name: "get after changes", method: http.MethodGet, path: "/chains/request", wantCode: http.StatusOK, wantPlugin: []string{"second"}},
+		{name: "get unknown chain", method: http.MethodGet, path: "/chains/other", wantCode: http.StatusNotFound},
+	})
+
+	// the parameters of appended plugins are part of the snapshots of the chain
+	data, err := json.Marshal(server.requestChain())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(`[{"type":"fake","name":"second","index":0,"parameters":"X-Second"}]`, string(data)); diff != "" {
+		t.Errorf("unexpected JSON of the chain (-want +got):\n%s", diff)
+	}
+
+	// requests in flight keep running the chain they started with
+	if got := len(snapshot.RequestPlugins()); got != 1 || snapshot.RequestPlugins()[0].TypedName().Name != "first" {
+		t.Errorf("the snapshot taken before the changes was modified, got %d plugins", got)
+	}
+}
+
+func TestAdminHandler_ResponseChain(t *testing.T) {
+	registerFakePluginFactory(t)
+	factory := func(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+		return &fakeResponsePlugin{name: name}, nil
+	}
+	if err := framework.Register(fakeResponsePluginType, factory); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { delete(framework.Registry, fakeResponsePluginType) })
+
+	server := NewServer(false, nil, nil)
+	handler := server.AdminHandler(nil)
+
+	runAdminSteps(t, handler, []adminStep{
+		{name: "get empty", method: http.MethodGet, path: "/chains/response", wantCode: http.StatusOK, wantPlugin: []string{}},
+		{name: "append request plugin", method: http.MethodPost, path: "/chains/response/plugins", body: `{"type":"fake","name":"first"}`,
+			wantCode: http.StatusBadRequest},
+		{name: "append", method: http.MethodPost, path: "/chains/response/plugins", body: `{"type":"fake-response","name":"first"}`,
+			wantCode: http.StatusCreated, wantPlugin: []string{"first"}},
+		{name: "append second", method: http.MethodPost, path: "/chains/response/plugins", body: `{"type":"fake-response","name":"second"}`,
+			wantCode: http.StatusCreated, wantPlugin: []string{"first", "second"}},
+		{name: "append response plugin to request chain", method: http.MethodPost, path: "/chains/request/plugins",
+			body: `{"type":"fake-response","name":"third"}`, wantCode: http.StatusBadRequest},
+		{name: "remove", method: http.MethodDelete, path: "/chains/response/plugins/1", wantCode: http.StatusOK, wantPlugin: []string{"first"}},
+		{name: "remove from unknown chain", method: http.MethodDelete, path: "/chains/other/plugins/0", wantCode: http.StatusNotFound},
+	})
+
+	if got := len(server.responseChain()); got != 1 {
+		t.Errorf("unexpected number of response plugins %d, want 1", got)
+	}
+}
+
+func TestAdminHandler_PluginsDAG(t *testing.T) {
+	registerFakePluginFactory(t)
+
+	executor := newTestPluginsDAG(t, []framework.RequestProcessor{&bodyMutatingPlugin{name: "a"}, &bodyMutatingPlugin{name: "b"}},
+		[][2]string{{"a", "b"}})
+	handler := NewServerWithExecutor(false, executor, nil).AdminHandler(nil)
+
+	runAdminSteps(t, handler, []adminStep{
+		{name: "get", method: http.MethodGet, path: "/chains/request", wantCode: http.StatusOK, wantPlugin: []string{"a", "b"}},
+		{name: "append", method: http.MethodPost, path: "/chains/request/plugins", body: `{"type":"fake","name":"c"}`,
+			wantCode: http.StatusConflict},
+		{name: "remove", method: http.MethodDelete, path: "/chains/request/plugins/0", wantCode: http.StatusConflict},
+	})
+}
+
+// fakeBothPluginType is the factory type of the fakeBothPlugin instances created by the admin API.
+const fakeBothPluginType = "fake-both"
+
+// fakeBothPlugin is a plugin processing both requests and responses, counting how often it was closed.
+type fakeBothPlugin struct {
+	bodyMutatingPlugin
+	closed int
+}
+
+func (p *fakeBothPlugin) ProcessResponse(context.Context, *framework.CycleState, *framework.InferenceResponse) error {
+	return nil
+}
+
+func (p *fakeBothPlugin) Close() error {
+	p.closed++
+	return nil
+}
+
+func TestAdminHandler_BothChains(t *testing.T) {
+	created := map[string]*fakeBothPlugin{}
+	factory := func(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+		created[name] = &fakeBothPlugin{bodyMutatingPlugin: bodyMutatingPlugin{name: name}}
+		return created[name], nil
+	}
+	if err := framework.Register(fakeBothPluginType, factory); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { delete(framework.Registry, fakeBothPluginType) })
+
+	server := NewServer(false, []framework.RequestProcessor{&bodyMutatingPlugin{name: "first"}}, nil)
+	handler := server.AdminHandler(nil)
+
+	runAdminSteps(t, handler, []adminStep{
+		{name: "append to the response chain", method: http.MethodPost, path: "/chains/response/plugins", body: `{"type":"fake-both","name":"both"}`,
+			wantCode: http.StatusCreated, wantPlugin: []string{"both"}},
+		{name: "appended to the request chain too", method: http.MethodGet, path: "/chains/request", wantCode: http.StatusOK,
+			wantPlugin: []string{"first", "both"}},
+		{name: "append duplicate name of a request plugin", method: http.MethodPost, path: "/chains/response/plugins",
+			body: `{"type":"fake-both","name":"first"}`, wantCode: http.StatusConflict},
+		{name: "response chain unchanged", method: http.MethodGet, path: "/chains/response", wantCode: http.StatusOK,
+			wantPlugin: []string{"both"}},
+		{name: "remove from the request chain", method: http.MethodDelete, path: "/chains/request/plugins/1", wantCode: http.StatusOK,
+			wantPlugin: []string{"first"}},
+		{name: "removed from the response chain too", method: http.MethodGet, path: "/chains/response", wantCode: http.StatusOK,
+			wantPlugin: []string{}},
+	})
+
+	if got := created["both"].closed; got != 1 {
+		t.Errorf("removed plugin closed %d times, want 1", got)
+	}
+	if got := created["first"].closed; got != 1 {
+		t.Errorf("rejected plugin closed %d times, want 1", got)
+	}
+}
+
+// fakeCachePlugin is a request plugin recording the keys of the evicted cache entries.
+type fakeCachePlugin struct {
+	bodyMutatingPlugin
+	evicted []string
+}
+
+func (p *fakeCachePlugin) Evict(key string) int {
+	p.evicted = append(p.evicted, key)
+	return 1
+}
+
+func TestAdminHandler_EvictCache(t *testing.T) {
+	cache := &fakeCachePlugin{bodyMutatingPlugin: bodyMutatingPlugin{name: "cache"}}
+	handler := NewServer(false, []framework.RequestProcessor{cache, &bodyMutatingPlugin{name: "other"}}, nil).AdminHandler(nil)
+
+	runAdminSteps(t, handler, []adminStep{
+		{name: "evict all", method: http.MethodDelete, path: "/plugins/cache/cache", wantCode: http.StatusOK},
+		{name: "evict key", method: http.MethodDelete, path: "/plugins/cache/cache/0123abcd", wantCode: http.StatusOK},
+		{name: "plugin without cache", method: http.MethodDelete, path: "/plugins/other/cache", wantCode: http.StatusBadRequest},
+		{name: "unknown plugin", method: http.MethodDelete, path: "/plugins/missing/cache", wantCode: http.StatusNotFound},
+	})
+
+	if diff := cmp.Diff([]string{"", "0123abcd"}, cache.evicted); diff != "" {
+		t.Errorf("unexpected evicted keys (-want +got):\n%s", diff)
+	}
+}
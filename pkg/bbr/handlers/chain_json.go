@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+// PluginEntry is the JSON representation of a plugin of a chain, used to snapshot the chain, e.g. in a
+// ConfigMap, and restore it.
+type PluginEntry struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Index int    `json:"index"`
+	// Parameters are the parameters passed to the factory of the plugin when the chain is restored. Snapshots
+	// have the parameters recorded when the chain was created, see NewPluginsChainWithParameters; plugins
+	// created without recorded parameters are restored with their defaults.
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// NewPluginsChainFromJSON restores a chain from its JSON representation, a list of PluginEntry. Each entry is
+// validated against the plugin registry, and instantiated with the factory registered for its type.
+func NewPluginsChainFromJSON(data []byte, handle framework.Handle) (PluginsExecutor, error) {
+	chain := &pluginsChain{}
+	if err := chain.unmarshal(data, handle); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+// MarshalJSON returns the plugins of the chain as a list of PluginEntry, in execution order, with the
+// parameters recorded for the plugins.
+func (c *pluginsChain) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pluginEntries(c.configured, c.parameters))
+}
+
+// UnmarshalJSON replaces the plugins of the chain with the plugins of a list of PluginEntry, validated against
+// the plugin registry. The plugins are instantiated without a framework.Handle; plugins that need one must be
+// restored with NewPluginsChainFromJSON. The execution options of the chain are reset. It must not be called
+// while the chain executes requests.
+func (c *pluginsChain) UnmarshalJSON(data []byte) error {
+	return c.unmarshal(data, nil)
+}
+
+func (c *pluginsChain) unmarshal(data []byte, handle framework.Handle) error {
+	var entries []PluginEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	slices.SortFunc(entries, func(a, b PluginEntry) int { return a.Index - b.Index })
+
+	plugins := make([]framework.RequestProcessor, 0, len(entries))
+	parameters := map[string]json.RawMessage{}
+	names := sets.New[string]()
+	for i, entry := range entries {
+		if entry.Index != i {
+			return fmt.Errorf("plugin indexes must be 0 to %d, found index %d", len(entries)-1, entry.Index)
+		}
+		if entry.Name == "" {
+			return fmt.Errorf("plugin name cannot be empty at index %d", entry.Index)
+		}
+		if names.Has(entry.Name) {
+			return fmt.Errorf("duplicate plugin name '%s' at index %d", entry.Name, entry.Index)
+		}
+		names.Insert(entry.Name)
+
+		factory, err := framework.GetFactory(entry.Type)
+		if err != nil {
+			return err
+		}
+		instance, err := factory(entry.Name, entry.Parameters, handle)
+		if err != nil {
+			return fmt.Errorf("failed to create plugin '%s' of type '%s' - %w", entry.Name, entry.Type, err)
+		}
+		requestProcessor, ok := instance.(framework.RequestProcessor)
+		if !ok {
+			return fmt.Errorf("plugin '%s' of type '%s' is not a request plugin", entry.Name, entry.Type)
+		}
+		plugins = append(plugins, requestProcessor)
+		if len(entry.Parameters) > 0 {
+			parameters[entry.Name] = entry.Parameters
+		}
+	}
+
+	*c = pluginsChain{configured: plugins, plugins: plugins, parameters: parameters}
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+// fakePluginType is the type of bodyMutatingPlugin.
+const fakePluginType = "fake"
+
+func registerFakePluginFactory(t *testing.T) {
+	t.Helper()
+	factory := func(name string, parameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+		var header string
+		if len(parameters) > 0 {
+			if err := json.Unmarshal(parameters, &header); err != nil {
+				return nil, err
+			}
+		}
+		return &bodyMutatingPlugin{
+			name: name,
+			mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+				if header != "" {
+					request.SetHeader(header, name)
+				}
+				return nil
+			},
+		}, nil
+	}
+	if err := framework.Register(fakePluginType, factory); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { delete(framework.Registry, fakePluginType) })
+}
+
+func TestPluginsChainJSON(t *testing.T) {
+	registerFakePluginFactory(t)
+
+	chain := NewPluginsChain([]framework.RequestProcessor{
+		&bodyMutatingPlugin{name: "first"},
+		&bodyMutatingPlugin{name: "second"},
+	})
+	data, err := json.Marshal(chain)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `[{"type":"fake","name":"first","index":0},{"type":"fake","name":"second","index":1}]`
+	if diff := cmp.Diff(want, string(data)); diff != "" {
+		t.Errorf("unexpected JSON (-want +got):\n%s", diff)
+	}
+
+	restored := NewPluginsChain(nil)
+	if err := json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restoredData, err := json.Marshal(restored)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, string(restoredData)); diff != "" {
+		t.Errorf("unexpected JSON of the restored chain (-want +got):\n%s", diff)
+	}
+
+	// entries are ordered by index, and their parameters are passed to the factories
+	restored, err = NewPluginsChainFromJSON([]byte(`[
+		{"type":"fake","name":"second","index":1,"parameters":"X-Second"},
+		{"type":"fake","name":"first","index":0,"parameters":"X-First"}
+	]`), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	request := framework.NewInferenceRequest()
+	if err := restored.ExecuteRequestPlugins(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"X-First": "first", "X-Second": "second"}, request.MutatedHeaders()); diff != "" {
+		t.Errorf("unexpected headers (-want +got):\n%s", diff)
+	}
+}
+
+func TestPluginsChainJSONParameters(t *testing.T) {
+	registerFakePluginFactory(t)
+
+	chain := NewPluginsChainWithParameters([]framework.RequestProcessor{
+		&bodyMutatingPlugin{name: "first"},
+		&bodyMutatingPlugin{name: "second"},
+	}, nil, map[string]json.RawMessage{"first": json.RawMessage(`"X-First"`)})
+	data, err := json.Marshal(chain)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `[{"type":"fake","name":"first","index":0,"parameters":"X-First"},{"type":"fake","name":"second","index":1}]`
+	if diff := cmp.Diff(want, string(data)); diff != "" {
+		t.Errorf("unexpected JSON (-want +got):\n%s", diff)
+	}
+
+	// the parameters of the restored plugins are recorded again
+	restored, err := NewPluginsChainFromJSON(data, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restoredData, err := json.Marshal(restored)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, string(restoredData)); diff != "" {
+		t.Errorf("unexpected JSON of the restored chain (-want +got):\n%s", diff)
+	}
+}
+
+func TestPluginsChainJSONValidation(t *testing.T) {
+	registerFakePluginFactory(t)
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "invalid JSON", data: `[{`},
+		{name: "unregistered type", data: `[{"type":"unknown","name":"a","index":0}]`},
+		{name: "missing index", data: `[{"type":"fake","name":"a","index":1}]`},
+		{name: "duplicate index", data: `[{"type":"fake","name":"a","index":0},{"type":"fake","name":"b","index":0}]`},
+		{name: "empty name", data: `[{"type":"fake","name":"","index":0}]`},
+		{name: "duplicate name", data: `[{"type":"fake","name":"a","index":0},{"type":"fake","name":"a","index":1}]`},
+		{name: "factory error", data: `[{"type":"fake","name":"a","index":0,"parameters":42}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewPluginsChainFromJSON([]byte(tt.data), nil); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+// ExecutionPlan is the predicted execution of the request plugins on a request, returned by DryRun.
+type ExecutionPlan struct {
+	// Steps are the request plugins, in execution order.
+	Steps []PluginStep
+}
+
+// PluginStep is the predicted execution of a request plugin.
+type PluginStep struct {
+	Plugin plugin.TypedName
+	// Planned is whether the plugin implements framework.RequestPlanner. The processing of other plugins
+	// can't be predicted, and the remaining fields are left empty.
+	Planned bool
+	framework.RequestPlan
+}
+
+// DryRun parses the raw body bytes as HandleRequestBody does, and returns how the request plugins would
+// process the request, without running them. Plugins are asked for their plan in execution order, on the
+// same request, so plans don't account for the mutations of the previous plugins. DryRun has no side
+// effects, so it can be used to validate a plugin chain configuration against sample payloads, e.g. in CI.
+func (s *Server) DryRun(ctx context.Context, requestBodyBytes []byte) (ExecutionPlan, error) {
+	request := framework.NewInferenceRequest()
+	if err := parseRequestBody(request, requestBodyBytes); err != nil {
+		return ExecutionPlan{}, err
+	}
+
+	plugins := s.requestChain().RequestPlugins()
+	plan := ExecutionPlan{Steps: make([]PluginStep, 0, len(plugins))}
+	for _, requestPlugin := range plugins {
+		step := PluginStep{Plugin: requestPlugin.TypedName()}
+		if planner, ok := requestPlugin.(framework.RequestPlanner); ok {
+			step.Planned = true
+			step.RequestPlan = planner.PlanRequest(ctx, request)
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+	return plan, nil
+}
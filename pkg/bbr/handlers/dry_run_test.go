@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/basemodelextractor"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bodyfieldtoheader"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	epp "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+func TestDryRun(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	modelToHeaderPlugin, _ := bodyfieldtoheader.NewBodyFieldToHeaderPlugin(modelField, bodyfieldtoheader.ModelHeader)
+	baseModelToHeaderPlugin := &basemodelextractor.BaseModelToHeaderPlugin{AdaptersStore: basemodelextractor.NewAdaptersStore()}
+	called := false
+	unplannedPlugin := &bodyMutatingPlugin{
+		name: "unplanned",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, _ *framework.InferenceRequest) error {
+			called = true
+			return nil
+		},
+	}
+	server := NewServer(false, []framework.RequestProcessor{modelToHeaderPlugin, baseModelToHeaderPlugin, unplannedPlugin}, []framework.ResponseProcessor{})
+
+	tests := []struct {
+		name     string
+		body     string
+		wantPlan ExecutionPlan
+		wantCode string
+	}{
+		{
+			name: "model in body",
+			body: `{"model":"foo","prompt":"test"}`,
+			wantPlan: ExecutionPlan{Steps: []PluginStep{
+				{
+					Plugin:      modelToHeaderPlugin.TypedName(),
+					Planned:     true,
+					RequestPlan: framework.RequestPlan{Fires: true, RequiresBody: true, Headers: []string{bodyfieldtoheader.ModelHeader}},
+				},
+				{
+					Plugin:      baseModelToHeaderPlugin.TypedName(),
+					Planned:     true,
+					RequestPlan: framework.RequestPlan{Fires: true, RequiresBody: true, Headers: []string{basemodelextractor.BaseModelHeader}},
+				},
+				{Plugin: epp.TypedName{Type: "fake", Name: "unplanned"}},
+			}},
+		},
+		{
+			name: "no model in body",
+			body: `{"prompt":"test"}`,
+			wantPlan: ExecutionPlan{Steps: []PluginStep{
+				{
+					Plugin:      modelToHeaderPlugin.TypedName(),
+					Planned:     true,
+					RequestPlan: framework.RequestPlan{RequiresBody: true},
+				},
+				{
+					Plugin:      baseModelToHeaderPlugin.TypedName(),
+					Planned:     true,
+					RequestPlan: framework.RequestPlan{Fires: true, RequiresBody: true, Headers: []string{basemodelextractor.BaseModelHeader}},
+				},
+				{Plugin: epp.TypedName{Type: "fake", Name: "unplanned"}},
+			}},
+		},
+		{
+			name:     "invalid body",
+			body:     `{"model":`,
+			wantCode: errcommon.BadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := server.DryRun(ctx, []byte(tt.body))
+			if tt.wantCode != "" {
+				if errcommon.CanonicalCode(err) != tt.wantCode {
+					t.Fatalf("DryRun() error = %v, want code %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DryRun() returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantPlan, plan); diff != "" {
+				t.Errorf("unexpected plan (-want +got):\n%s", diff)
+			}
+		})
+	}
+	if called {
+		t.Error("DryRun() ran a request plugin")
+	}
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+// PluginsExecutor executes the request plugins on a request.
+type PluginsExecutor interface {
+	// ExecuteRequestPlugins runs the request plugins on the request, and returns the error of the first
+	// failing plugin. A plugin returning framework.ErrSkipRemainingPlugins stops the execution without error.
+	ExecuteRequestPlugins(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error
+	// RequestPlugins returns the configured request plugins, in execution order.
+	RequestPlugins() []framework.RequestProcessor
+}
+
+// NewPluginsChain returns a PluginsExecutor that runs the given plugins sequentially, in the given order.
+func NewPluginsChain(plugins []framework.RequestProcessor) PluginsExecutor {
+	return NewPluginsChainWithOptions(plugins, nil)
+}
+
+// NewPluginsChainWithOptions returns a PluginsExecutor that runs the given plugins sequentially, in the given
+// order, with the execution options of the plugins keyed by the plugin instance names. A plugin with a timeout
+// is decorated with framework.WithTimeout, and when it times out the chain either fails the request or
+// continues with the next plugin, according to its SkipOnTimeout option.
+func NewPluginsChainWithOptions(plugins []framework.RequestProcessor, options map[string]framework.PluginOptions) PluginsExecutor {
+	return NewPluginsChainWithParameters(plugins, options, nil)
+}
+
+// NewPluginsChainWithParameters returns a PluginsExecutor like NewPluginsChainWithOptions, which also records
+// the parameters the plugins were created with, keyed by the plugin instance names. The parameters are part
+// of the JSON representation of the chain, so that a restored chain creates the plugins as configured.
+func NewPluginsChainWithParameters(plugins []framework.RequestProcessor, options map[string]framework.PluginOptions,
+	parameters map[string]json.RawMessage) PluginsExecutor {
+	chain := &pluginsChain{configured: plugins, plugins: make([]framework.RequestProcessor, 0, len(plugins)), options: options,
+		parameters: parameters}
+	for _, plugin := range plugins {
+		if timeout := options[plugin.TypedName().Name].Timeout; timeout > 0 {
+			plugin = framework.WithTimeout(plugin, timeout).(framework.RequestProcessor)
+		}
+		chain.plugins = append(chain.plugins, plugin)
+	}
+	return chain
+}
+
+// NewPluginsDAGExecutor returns a PluginsExecutor that runs the plugins of the given graph layer by layer,
+// in topological order. The plugins of a layer run concurrently, each on its own copy of the request, and
+// their header and body mutations are merged into the request once all of them are done. When plugins of
+// the same layer mutate the same header or top-level body field, the plugin added last to the graph wins.
+// It returns an error if the graph has no topological order.
+func NewPluginsDAGExecutor(dag framework.PluginsDAG) (PluginsExecutor, error) {
+	return NewPluginsDAGExecutorWithOptions(dag, nil)
+}
+
+// NewPluginsDAGExecutorWithOptions returns a PluginsExecutor like NewPluginsDAGExecutor, with the execution
+// options of the plugins keyed by the plugin instance names. As in a chain, a plugin with a timeout is
+// decorated with framework.WithTimeout, and when it times out the execution either fails the request or
+// continues without the mutations of the plugin, according to its SkipOnTimeout option.
+func NewPluginsDAGExecutorWithOptions(dag framework.PluginsDAG, options map[string]framework.PluginOptions) (PluginsExecutor, error) {
+	order, err := dag.TopologicalOrder()
+	if err != nil {
+		return nil, err
+	}
+	executor := &pluginsDAGExecutor{
+		configured: make([][]framework.RequestProcessor, len(order)),
+		layers:     make([][]framework.RequestProcessor, len(order)),
+		options:    options,
+	}
+	for i, names := range order {
+		for _, name := range names {
+			plugin := dag.Plugin(name)
+			executor.configured[i] = append(executor.configured[i], plugin)
+			if timeout := options[plugin.TypedName().Name].Timeout; timeout > 0 {
+				plugin = framework.WithTimeout(plugin, timeout).(framework.RequestProcessor)
+			}
+			executor.layers[i] = append(executor.layers[i], plugin)
+		}
+	}
+	return executor, nil
+}
+
+// pluginsChain executes request plugins in the order they were registered.
+type pluginsChain struct {
+	// configured are the plugins as configured, and plugins are the plugins decorated with their options.
+	configured []framework.RequestProcessor
+	plugins    []framework.RequestProcessor
+	options    map[string]framework.PluginOptions
+	// parameters are the parameters the plugins were created with, keyed by the plugin instance names.
+	parameters map[string]json.RawMessage
+}
+
+func (c *pluginsChain) RequestPlugins() []framework.RequestProcessor {
+	return c.configured
+}
+
+func (c *pluginsChain) ExecuteRequestPlugins(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	for i, plugin := range c.plugins {
+		err := runRequestPlugin(ctx, i, plugin, cycleState, request)
+		if skippedOnTimeout(ctx, c.options, plugin, err) {
+			continue
+		}
+		skip, err := skipRemaining(ctx, plugin, err)
+		if skip || err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pluginsDAGExecutor executes the layers of a PluginsDAG in topological order.
+type pluginsDAGExecutor struct {
+	// configured are the layers of the plugins as configured, and layers are the layers of the plugins
+	// decorated with their options.
+	configured [][]framework.RequestProcessor
+	layers     [][]framework.RequestProcessor
+	options    map[string]framework.PluginOptions
+}
+
+func (e *pluginsDAGExecutor) RequestPlugins() []framework.RequestProcessor {
+	var plugins []framework.RequestProcessor
+	for _, layer := range e.configured {
+		plugins = append(plugins, layer...)
+	}
+	return plugins
+}
+
+func (e *pluginsDAGExecutor) ExecuteRequestPlugins(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	index := 0
+	for _, layer := range e.layers {
+		skip, err := e.executeLayer(ctx, index, layer, cycleState, request)
+		if skip || err != nil {
+			return err
+		}
+		index += len(layer)
+	}
+	return nil
+}
+
+// executeLayer runs the plugins of a layer concurrently, and merges their mutations into the request.
+// The plugins are indexed from the given index on, in their order in the layer. It returns whether one of
+// the plugins requested to skip the remaining plugins.
+func (e *pluginsDAGExecutor) executeLayer(ctx context.Context, index int, layer []framework.RequestProcessor, cycleState *framework.CycleState, request *framework.InferenceRequest) (bool, error) {
+	if len(layer) == 1 {
+		// nothing runs concurrently, so the plugin can mutate the request directly
+		err := runRequestPlugin(ctx, index, layer[0], cycleState, request)
+		if skippedOnTimeout(ctx, e.options, layer[0], err) {
+			return false, nil
+		}
+		return skipRemaining(ctx, layer[0], err)
+	}
+
+	base := copyRequest(request)
+	copies := make([]*framework.InferenceRequest, len(layer))
+	errs := make([]error, len(layer))
+	var wg sync.WaitGroup
+	for i, plugin := range layer {
+		copies[i] = copyRequest(request)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = runRequestPlugin(ctx, index+i, plugin, cycleState, copies[i])
+		}()
+	}
+	wg.Wait()
+
+	skip := false
+	for i, plugin := range layer {
+		if skippedOnTimeout(ctx, e.options, plugin, errs[i]) {
+			copies[i] = nil // drop the mutations of the plugin
+			continue
+		}
+		skipped, err := skipRemaining(ctx, plugin, errs[i])
+		if err != nil {
+			return false, err
+		}
+		skip = skip || skipped
+	}
+	for _, mutated := range copies {
+		if mutated != nil {
+			mergeRequest(request, base, mutated)
+		}
+	}
+	return skip, nil
+}
+
+// runRequestPlugin runs a single request plugin, and records its processing latency. The plugin runs in a
+// child span of the span in the context, tagged with the plugin type, name and index in the execution.
+func runRequestPlugin(ctx context.Context, index int, plugin framework.RequestProcessor, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	tracer := trace.SpanFromContext(ctx).TracerProvider().Tracer(pluginTracerName)
+	ctx, span := tracer.Start(ctx, requestPluginSpanName,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String(pluginTypeAttributeKey, plugin.TypedName().Type),
+			attribute.String(pluginNameAttributeKey, plugin.TypedName().Name),
+			attribute.Int(pluginIndexAttributeKey, index),
+		),
+	)
+	defer span.End()
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("Executing request plugin", "plugin", plugin.TypedName())
+	before := time.Now()
+	err := plugin.ProcessRequest(ctx, cycleState, request)
+	outcome := pluginOutcome(err)
+	metrics.RecordPluginProcessingLatency(requestPluginExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, outcome, time.Since(before))
+	if outcome != successOutcome {
+		log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to execute request plugin", "plugin", plugin.TypedName())
+		metrics.RecordPluginErrors(requestPluginExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, outcome)
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	return err
+}
+
+// pluginOutcome returns the outcome label of the plugin metrics for the error returned by a plugin. Skipping the
+// remaining plugins and answering the request with an OK immediate response, e.g. from a cache, are successes.
+func pluginOutcome(err error) string {
+	switch {
+	case err == nil, errors.Is(err, framework.ErrSkipRemainingPlugins), errcommon.CanonicalCode(err) == errcommon.OK:
+		return successOutcome
+	case errors.Is(err, framework.ErrPluginTimeout):
+		return timeoutOutcome
+	default:
+		return errorOutcome
+	}
+}
+
+// skippedOnTimeout returns whether the plugin timed out and its options continue the execution without it.
+func skippedOnTimeout(ctx context.Context, options map[string]framework.PluginOptions, plugin framework.RequestProcessor, err error) bool {
+	if !errors.Is(err, framework.ErrPluginTimeout) || !options[plugin.TypedName().Name].SkipOnTimeout {
+		return false
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("Request plugin timed out, continuing with the next plugin", "plugin", plugin.TypedName())
+	return true
+}
+
+// skipRemaining returns whether the plugin error requests to skip the remaining plugins, and the error
+// otherwise.
+func skipRemaining(ctx context.Context, plugin framework.RequestProcessor, err error) (bool, error) {
+	if errors.Is(err, framework.ErrSkipRemainingPlugins) {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("Skipping the remaining request plugins", "plugin", plugin.TypedName())
+		return true, nil
+	}
+	return false, err
+}
+
+// copyRequest returns a copy of the request headers and body, without the mutations, so that a plugin can
+// mutate it concurrently with other plugins.
+func copyRequest(request *framework.InferenceRequest) *framework.InferenceRequest {
+	requestCopy := framework.NewInferenceRequest()
+	for key, value := range request.Headers {
+		requestCopy.Headers[key] = value
+	}
+	requestCopy.Body = copyValue(request.Body).(map[string]any)
+	return requestCopy
+}
+
+// copyValue returns a deep copy of a value of a parsed JSON body.
+func copyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		valueCopy := make(map[string]any, len(v))
+		for key, field := range v {
+			valueCopy[key] = copyValue(field)
+		}
+		return valueCopy
+	case []any:
+		valueCopy := make([]any, len(v))
+		for i, item := range v {
+			valueCopy[i] = copyValue(item)
+		}
+		return valueCopy
+	default:
+		return v
+	}
+}
+
+// mergeRequest applies the mutations of a copy of the request to the request. The body of the copy is
+// compared field by field with the body of base, the request as it was before the copy was mutated.
+func mergeRequest(request, base, mutated *framework.InferenceRequest) {
+	for key, value := range mutated.MutatedHeaders() {
+		request.SetHeader(key, value)
+	}
+	for _, key := range mutated.RemovedHeaders() {
+		request.RemoveHeader(key)
+	}
+	if mutated.BodyMutated() {
+		for key, value := range mutated.Body {
+			if baseValue, ok := base.Body[key]; !ok || !reflect.DeepEqual(baseValue, value) {
+				request.SetBodyField(key, value)
+			}
+		}
+		for key := range base.Body {
+			if _, ok := mutated.Body[key]; !ok {
+				request.RemoveBodyField(key)
+			}
+		}
+	}
+	if mutated.DynamicMetadata != nil {
+		for namespace, fields := range mutated.DynamicMetadata.Fields {
+			for key, value := range fields.GetStructValue().GetFields() {
+				request.SetDynamicMetadata(namespace, key, value)
+			}
+		}
+	}
+}
@@ -0,0 +1,381 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+)
+
+// waitFor makes a plugin wait until another plugin of the same layer started, to verify that the plugins
+// of a layer run concurrently.
+func waitFor(started <-chan struct{}) error {
+	select {
+	case <-started:
+		return nil
+	case <-time.After(5 * time.Second):
+		return errors.New("the plugins of the layer did not run concurrently")
+	}
+}
+
+func newTestPluginsDAG(t *testing.T, plugins []framework.RequestProcessor, edges [][2]string) PluginsExecutor {
+	t.Helper()
+	dag := framework.NewPluginsDAG()
+	for _, plugin := range plugins {
+		if err := dag.AddPlugin(plugin); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	for _, edge := range edges {
+		if err := dag.AddEdge(edge[0], edge[1]); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	executor, err := NewPluginsDAGExecutor(dag)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return executor
+}
+
+func TestPluginsDAGExecutor(t *testing.T) {
+	t.Cleanup(metrics.ResetPluginMetrics) // the plugins record their latency in the global registry
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	aStarted, bStarted := make(chan struct{}), make(chan struct{})
+	a := &bodyMutatingPlugin{
+		name: "a",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+			close(aStarted)
+			request.SetHeader("X-A", "a")
+			request.SetBodyField("a", "from-a")
+			request.RemoveBodyField("removed")
+			return waitFor(bStarted)
+		},
+	}
+	b := &bodyMutatingPlugin{
+		name: "b",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+			close(bStarted)
+			request.SetHeader("X-B", "b")
+			request.SetBodyField("b", "from-b")
+			return waitFor(aStarted)
+		},
+	}
+	c := &bodyMutatingPlugin{
+		name: "c",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+			request.SetHeader("X-C", request.GetHeader("X-A")+request.GetHeader("X-B"))
+			request.SetBodyField("c", request.Body["a"].(string)+"+"+request.Body["b"].(string))
+			return nil
+		},
+	}
+	executor := newTestPluginsDAG(t, []framework.RequestProcessor{c, a, b}, [][2]string{{"a", "c"}, {"b", "c"}})
+
+	request := framework.NewInferenceRequest()
+	request.Body = map[string]any{"model": "llama", "removed": true}
+	if err := executor.ExecuteRequestPlugins(ctx, framework.NewCycleState(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantHeaders := map[string]string{"X-A": "a", "X-B": "b", "X-C": "ab"}
+	if diff := cmp.Diff(wantHeaders, request.MutatedHeaders()); diff != "" {
+		t.Errorf("unexpected headers (-want +got):\n%s", diff)
+	}
+	wantBody := map[string]any{"model": "llama", "a": "from-a", "b": "from-b", "c": "from-a+from-b"}
+	if diff := cmp.Diff(wantBody, request.Body); diff != "" {
+		t.Errorf("unexpected body (-want +got):\n%s", diff)
+	}
+	if !request.BodyMutated() {
+		t.Error("expected the body to be mutated")
+	}
+}
+
+func TestPluginsDAGExecutorStops(t *testing.T) {
+	t.Cleanup(metrics.ResetPluginMetrics)
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	failure := errors.New("failed")
+
+	tests := []struct {
+		name        string
+		firstErr    error
+		wantErr     error
+		wantHeaders map[string]string
+	}{
+		{
+			name:        "skip remaining plugins",
+			firstErr:    framework.ErrSkipRemainingPlugins,
+			wantHeaders: map[string]string{"X-First": "true", "X-Sibling": "true"},
+		},
+		{
+			name:        "plugin error",
+			firstErr:    failure,
+			wantErr:     failure,
+			wantHeaders: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := &bodyMutatingPlugin{
+				name: "first",
+				mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+					request.SetHeader("X-First", "true")
+					return tt.firstErr
+				},
+			}
+			sibling := &bodyMutatingPlugin{
+				name: "sibling",
+				mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+					request.SetHeader("X-Sibling", "true")
+					return nil
+				},
+			}
+			next := &bodyMutatingPlugin{
+				name: "next",
+				mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+					request.SetHeader("X-Next", "true")
+					return nil
+				},
+			}
+			executor := newTestPluginsDAG(t, []framework.RequestProcessor{first, sibling, next}, [][2]string{{"first", "next"}})
+
+			request := framework.NewInferenceRequest()
+			err := executor.ExecuteRequestPlugins(ctx, framework.NewCycleState(), request)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ExecuteRequestPlugins() error = %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantHeaders, request.MutatedHeaders()); diff != "" {
+				t.Errorf("unexpected headers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPluginsChainTimeout(t *testing.T) {
+	t.Cleanup(metrics.ResetPluginMetrics)
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	tests := []struct {
+		name        string
+		options     framework.PluginOptions
+		wantErr     error
+		wantHeaders map[string]string
+	}{
+		{
+			name:        "no timeout",
+			wantHeaders: map[string]string{"X-Slow": "true", "X-Next": "true"},
+		},
+		{
+			name:        "abort on timeout",
+			options:     framework.PluginOptions{Timeout: 20 * time.Millisecond},
+			wantErr:     framework.ErrPluginTimeout,
+			wantHeaders: map[string]string{},
+		},
+		{
+			name:        "skip on timeout",
+			options:     framework.PluginOptions{Timeout: 20 * time.Millisecond, SkipOnTimeout: true},
+			wantHeaders: map[string]string{"X-Next": "true"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slow := &bodyMutatingPlugin{
+				name: "slow",
+				mutateFn: func(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+					select {
+					case <-time.After(200 * time.Millisecond):
+						request.SetHeader("X-Slow", "true")
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				},
+			}
+			next := &bodyMutatingPlugin{
+				name: "next",
+				mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+					request.SetHeader("X-Next", "true")
+					return nil
+				},
+			}
+			chain := NewPluginsChainWithOptions([]framework.RequestProcessor{slow, next}, map[string]framework.PluginOptions{"slow": tt.options})
+
+			request := framework.NewInferenceRequest()
+			err := chain.ExecuteRequestPlugins(ctx, framework.NewCycleState(), request)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ExecuteRequestPlugins() error = %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantHeaders, request.MutatedHeaders()); diff != "" {
+				t.Errorf("unexpected headers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPluginsDAGExecutorTimeout(t *testing.T) {
+	t.Cleanup(metrics.ResetPluginMetrics)
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	tests := []struct {
+		name        string
+		options     framework.PluginOptions
+		wantErr     error
+		wantHeaders map[string]string
+	}{
+		{
+			name:        "no timeout",
+			wantHeaders: map[string]string{"X-Slow": "true", "X-Fast": "true", "X-Next": "true"},
+		},
+		{
+			name:        "abort on timeout",
+			options:     framework.PluginOptions{Timeout: 20 * time.Millisecond},
+			wantErr:     framework.ErrPluginTimeout,
+			wantHeaders: map[string]string{},
+		},
+		{
+			name:        "skip on timeout",
+			options:     framework.PluginOptions{Timeout: 20 * time.Millisecond, SkipOnTimeout: true},
+			wantHeaders: map[string]string{"X-Fast": "true", "X-Next": "true"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slow := &bodyMutatingPlugin{
+				name: "slow",
+				mutateFn: func(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+					request.SetHeader("X-Partial", "true")
+					select {
+					case <-time.After(200 * time.Millisecond):
+						request.SetHeader("X-Slow", "true")
+						request.RemoveHeader("X-Partial")
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					}
+				},
+			}
+			fast := &bodyMutatingPlugin{
+				name: "fast",
+				mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+					request.SetHeader("X-Fast", "true")
+					return nil
+				},
+			}
+			next := &bodyMutatingPlugin{
+				name: "next",
+				mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+					request.SetHeader("X-Next", "true")
+					return nil
+				},
+			}
+			dag := framework.NewPluginsDAG()
+			for _, plugin := range []framework.RequestProcessor{slow, fast, next} {
+				if err := dag.AddPlugin(plugin); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			for _, before := range []string{"slow", "fast"} {
+				if err := dag.AddEdge(before, "next"); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			executor, err := NewPluginsDAGExecutorWithOptions(dag, map[string]framework.PluginOptions{"slow": tt.options})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := executor.RequestPlugins()[0]; got != framework.RequestProcessor(slow) {
+				t.Errorf("RequestPlugins() should return the plugins as configured, got %T", got)
+			}
+
+			request := framework.NewInferenceRequest()
+			err = executor.ExecuteRequestPlugins(ctx, framework.NewCycleState(), request)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ExecuteRequestPlugins() error = %v, want %v", err, tt.wantErr)
+			}
+			// the mutations of a plugin that timed out are dropped
+			if diff := cmp.Diff(tt.wantHeaders, request.MutatedHeaders()); diff != "" {
+				t.Errorf("unexpected headers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRequestPluginOutcomes(t *testing.T) {
+	metrics.Register()
+	t.Cleanup(metrics.ResetPluginMetrics)
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	tests := []struct {
+		name        string
+		err         error
+		wantOutcome string
+		wantErrors  bool
+	}{
+		{name: "success", wantOutcome: successOutcome},
+		{name: "skip remaining plugins", err: framework.ErrSkipRemainingPlugins, wantOutcome: successOutcome},
+		{name: "cache hit", err: errcommon.ImmediateResponse{Body: []byte(`{}`)}, wantOutcome: successOutcome},
+		{name: "rejection", err: errcommon.ImmediateResponse{Code: errcommon.BadRequest}, wantOutcome: errorOutcome, wantErrors: true},
+		{name: "error", err: errors.New("failed"), wantOutcome: errorOutcome, wantErrors: true},
+		{name: "timeout", err: framework.ErrPluginTimeout, wantOutcome: timeoutOutcome, wantErrors: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics.ResetPluginMetrics()
+			plugin := &bodyMutatingPlugin{
+				name:     "outcome",
+				mutateFn: func(context.Context, *framework.CycleState, *framework.InferenceRequest) error { return tt.err },
+			}
+			_ = NewPluginsChain([]framework.RequestProcessor{plugin}).ExecuteRequestPlugins(ctx, framework.NewCycleState(), framework.NewInferenceRequest())
+
+			mfs, err := crmetrics.Registry.Gather()
+			if err != nil {
+				t.Fatalf("Failed to gather metrics: %v", err)
+			}
+			outcomes, errorsCount := []string{}, 0.0
+			for _, mf := range mfs {
+				for _, m := range mf.GetMetric() {
+					labels := map[string]string{}
+					for _, lp := range m.GetLabel() {
+						labels[lp.GetName()] = lp.GetValue()
+					}
+					switch mf.GetName() {
+					case "bbr_plugin_duration_seconds":
+						outcomes = append(outcomes, labels["outcome"])
+					case "bbr_plugin_errors_total":
+						errorsCount += m.GetCounter().GetValue()
+					}
+				}
+			}
+			if diff := cmp.Diff([]string{tt.wantOutcome}, outcomes); diff != "" {
+				t.Errorf("unexpected outcomes (-want +got):\n%s", diff)
+			}
+			if got := errorsCount > 0; got != tt.wantErrors {
+				t.Errorf("errors recorded = %v, want %v", got, tt.wantErrors)
+			}
+		})
+	}
+}
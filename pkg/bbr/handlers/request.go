@@ -17,6 +17,7 @@ limitations under the License.
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -24,6 +25,8 @@ import (
 	"time"
 
 	eppb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
@@ -63,24 +66,20 @@ func (s *Server) HandleRequestHeaders(ctx context.Context, reqCtx *RequestContex
 func (s *Server) HandleRequestBody(ctx context.Context, reqCtx *RequestContext, requestBodyBytes []byte) ([]*eppb.ProcessingResponse, error) {
 	var ret []*eppb.ProcessingResponse
 
-	if err := json.Unmarshal(requestBodyBytes, &reqCtx.Request.Body); err != nil {
-		return nil, errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("failed to parse request body: %v", err)}
-	}
+	ctx, span := s.tracer.Start(ctx, requestBodySpanName, trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
 
-	if err := s.runRequestPlugins(ctx, reqCtx.CycleState, reqCtx.Request); err != nil {
+	mutatedBodyBytes, err := s.processRequestBody(ctx, reqCtx, requestBodyBytes)
+	if err != nil {
+		// an OK immediate response, e.g. from a cache, answers the request successfully
+		if errcommon.CanonicalCode(err) != errcommon.OK {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
 		return nil, err
 	}
-
-	bodyMutated := reqCtx.Request.BodyMutated()
-	var mutatedBodyBytes []byte
-	if bodyMutated {
-		var err error
-		mutatedBodyBytes, err = json.Marshal(reqCtx.Request.Body)
-		if err != nil {
-			return nil, err
-		}
-		reqCtx.Request.SetHeader(contentLengthHeader, strconv.Itoa(len(mutatedBodyBytes)))
-	} else if s.streaming {
+	bodyMutated := mutatedBodyBytes != nil
+	if !bodyMutated && s.streaming {
 		// In streaming mode, always set Content-Length even if body is not mutated
 		// to inform Envoy of the body size that will follow
 		reqCtx.Request.SetHeader(contentLengthHeader, strconv.Itoa(len(requestBodyBytes)))
@@ -101,6 +100,7 @@ func (s *Server) HandleRequestBody(ctx context.Context, reqCtx *RequestContext,
 					},
 				},
 			},
+			DynamicMetadata: reqCtx.Request.DynamicMetadata,
 		})
 		if bodyMutated {
 			ret = addStreamedBodyResponse(ret, mutatedBodyBytes)
@@ -133,24 +133,91 @@ func (s *Server) HandleRequestBody(ctx context.Context, reqCtx *RequestContext,
 					Response: response,
 				},
 			},
+			DynamicMetadata: reqCtx.Request.DynamicMetadata,
 		},
 	}, nil
 }
 
-// runRequestPlugins executes request plugins in the order they were registered.
-func (s *Server) runRequestPlugins(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
-	var err error
-	for _, plugin := range s.requestPlugins {
-		log.FromContext(ctx).V(logutil.VERBOSE).Info("Executing request plugin", "plugin", plugin.TypedName())
-		before := time.Now()
-		err = plugin.ProcessRequest(ctx, cycleState, request)
-		metrics.RecordPluginProcessingLatency(requestPluginExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
+// Replay runs the request plugins on the given request headers and raw body bytes exactly as
+// HandleRequestBody does, and returns the headers set by the plugins and the body that would be
+// forwarded upstream. Replay does not send anything to Envoy, so it can be used for deterministic
+// test replays and for evaluating a plugin chain in shadow mode.
+func (s *Server) Replay(ctx context.Context, headers map[string]string, requestBodyBytes []byte) (map[string]string, []byte, error) {
+	reqCtx := &RequestContext{
+		CycleState: framework.NewCycleState(),
+		Request:    framework.NewInferenceRequest(),
+	}
+	defer s.completeRequest(ctx, reqCtx)
+	for key, value := range headers {
+		reqCtx.Request.Headers[key] = value
+	}
+
+	mutatedBodyBytes, err := s.processRequestBody(ctx, reqCtx, requestBodyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mutatedBodyBytes == nil {
+		return reqCtx.Request.MutatedHeaders(), requestBodyBytes, nil
+	}
+	return reqCtx.Request.MutatedHeaders(), mutatedBodyBytes, nil
+}
+
+// processRequestBody parses the raw body bytes into reqCtx.Request.Body and runs the request plugins.
+// If the plugins mutated the body, it returns the marshaled body and sets the Content-Length header.
+// Otherwise, it returns nil.
+func (s *Server) processRequestBody(ctx context.Context, reqCtx *RequestContext, requestBodyBytes []byte) ([]byte, error) {
+	if err := parseRequestBody(reqCtx.Request, requestBodyBytes); err != nil {
+		return nil, err
+	}
+
+	if reqCtx.CycleState != nil {
+		reqCtx.CycleState.Write(framework.RequestBodyBytesKey, requestBodyBytes)
+	}
+
+	if len(reqCtx.Request.Body) > 0 && reqCtx.Request.IsAnthropicRequest() {
+		// the request is forwarded as is when its body cannot be parsed, the upstream validates it
+		params, err := framework.ParseAnthropicMessage(requestBodyBytes)
 		if err != nil {
-			log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to execute request plugin", "plugin", plugin.TypedName())
-			return err
+			log.FromContext(ctx).V(logutil.DEBUG).Info("Failed to parse the Anthropic request body", "error", err)
+		} else if reqCtx.CycleState != nil {
+			reqCtx.CycleState.Write(framework.AnthropicMessageParamsKey, params)
 		}
 	}
 
+	requestChain := s.requestChain()
+	reqCtx.requestPlugins = requestChain.RequestPlugins()
+	if err := requestChain.ExecuteRequestPlugins(ctx, reqCtx.CycleState, reqCtx.Request); err != nil {
+		return nil, err
+	}
+
+	if !reqCtx.Request.BodyMutated() {
+		return nil, nil
+	}
+	mutatedBodyBytes, err := json.Marshal(reqCtx.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+	reqCtx.Request.SetHeader(contentLengthHeader, strconv.Itoa(len(mutatedBodyBytes)))
+	return mutatedBodyBytes, nil
+}
+
+// parseRequestBody parses the raw body bytes into the request body.
+func parseRequestBody(request *framework.InferenceRequest, requestBodyBytes []byte) error {
+	switch {
+	case request.IsBatchRequest():
+		// the batch body is newline-delimited JSON, which plugins read from the raw body bytes
+		request.Body = map[string]any{}
+	case request.IsMultipartRequest():
+		// the multipart form body, e.g. of audio transcriptions, is read by plugins from the raw body bytes
+		request.Body = map[string]any{}
+	case len(bytes.TrimSpace(requestBodyBytes)) == 0:
+		// requests without a body, e.g. health check probes, are passed to the plugins with an empty body
+		request.Body = map[string]any{}
+	default:
+		if err := json.Unmarshal(requestBodyBytes, &request.Body); err != nil {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("failed to parse request body: %v", err)}
+		}
+	}
 	return nil
 }
 
@@ -19,6 +19,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strconv"
 	"strings"
 	"testing"
@@ -26,7 +27,12 @@ import (
 	basepb "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
 	metricsutils "k8s.io/component-base/metrics/testutil"
 	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
@@ -35,6 +41,7 @@ import (
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/basemodelextractor"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bodyfieldtoheader"
 	envoytest "sigs.k8s.io/gateway-api-inference-extension/pkg/common/envoy/test"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	epp "sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 )
@@ -484,6 +491,8 @@ func TestHandleRequestBody(t *testing.T) {
 
 func TestHandleRequestBodyWithPluginMetrics(t *testing.T) {
 	metrics.Register()
+	// the series of the plugins are split by outcome, so drop the failures observed by the previous tests
+	metrics.ResetPluginMetrics()
 	ctx := logutil.NewTestLoggerIntoContext(context.Background())
 
 	modelToHeaderPlugin, _ := bodyfieldtoheader.NewBodyFieldToHeaderPlugin(modelField, bodyfieldtoheader.ModelHeader)
@@ -528,6 +537,113 @@ func TestHandleRequestBodyWithPluginMetrics(t *testing.T) {
 	}
 }
 
+func TestHandleRequestBodyWithPluginSpans(t *testing.T) {
+	metrics.Register()
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer func() { _ = tracerProvider.Shutdown(ctx) }()
+
+	modelToHeaderPlugin, _ := bodyfieldtoheader.NewBodyFieldToHeaderPlugin(modelField, bodyfieldtoheader.ModelHeader)
+	baseModelToHeaderPlugin := &basemodelextractor.BaseModelToHeaderPlugin{AdaptersStore: basemodelextractor.NewAdaptersStore()}
+	server := NewServer(false, []framework.RequestProcessor{modelToHeaderPlugin, baseModelToHeaderPlugin}, []framework.ResponseProcessor{}).
+		WithTracer(tracerProvider.Tracer("test"))
+	reqCtx := &RequestContext{
+		CycleState: framework.NewCycleState(),
+		Request:    framework.NewInferenceRequest(),
+	}
+
+	bodyBytes, _ := json.Marshal(map[string]any{
+		"model":  "bar",
+		"prompt": "test",
+	})
+	if _, err := server.HandleRequestBody(ctx, reqCtx, bodyBytes); err != nil {
+		t.Fatalf("HandleRequestBody returned unexpected error: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("Expected 3 spans, got %d", len(spans))
+	}
+	// the plugin spans end before the root span
+	root := spans[2]
+	if root.Name != requestBodySpanName {
+		t.Fatalf("Expected root span %q, got %q", requestBodySpanName, root.Name)
+	}
+	if root.Parent.IsValid() {
+		t.Errorf("Expected root span without parent, got parent %s", root.Parent.SpanID())
+	}
+
+	wantPlugins := []framework.RequestProcessor{modelToHeaderPlugin, baseModelToHeaderPlugin}
+	for i, plugin := range wantPlugins {
+		span := spans[i]
+		if span.Name != requestPluginSpanName {
+			t.Errorf("Expected plugin span %q, got %q", requestPluginSpanName, span.Name)
+		}
+		if span.Parent.SpanID() != root.SpanContext.SpanID() {
+			t.Errorf("Expected plugin span %d to be a child of the root span", i)
+		}
+		if span.SpanContext.TraceID() != root.SpanContext.TraceID() {
+			t.Errorf("Expected plugin span %d to be in the trace of the root span", i)
+		}
+		wantAttributes := []attribute.KeyValue{
+			attribute.String(pluginTypeAttributeKey, plugin.TypedName().Type),
+			attribute.String(pluginNameAttributeKey, plugin.TypedName().Name),
+			attribute.Int(pluginIndexAttributeKey, i),
+		}
+		if diff := cmp.Diff(wantAttributes, span.Attributes, cmp.Comparer(func(a, b attribute.Value) bool { return a.Emit() == b.Emit() })); diff != "" {
+			t.Errorf("Unexpected attributes of plugin span %d (-want +got):\n%s", i, diff)
+		}
+	}
+}
+
+func TestHandleRequestBodyWithImmediateResponseSpans(t *testing.T) {
+	metrics.Register()
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus otelcodes.Code
+	}{
+		{name: "cache hit", err: errcommon.ImmediateResponse{Body: []byte(`{}`)}, wantStatus: otelcodes.Unset},
+		{name: "rejection", err: errcommon.ImmediateResponse{Code: errcommon.BadRequest}, wantStatus: otelcodes.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+			defer func() { _ = tracerProvider.Shutdown(ctx) }()
+
+			plugin := &bodyMutatingPlugin{
+				name:     "immediate",
+				mutateFn: func(context.Context, *framework.CycleState, *framework.InferenceRequest) error { return tt.err },
+			}
+			server := NewServer(false, []framework.RequestProcessor{plugin}, []framework.ResponseProcessor{}).
+				WithTracer(tracerProvider.Tracer("test"))
+			reqCtx := &RequestContext{
+				CycleState: framework.NewCycleState(),
+				Request:    framework.NewInferenceRequest(),
+			}
+			bodyBytes, _ := json.Marshal(map[string]any{"model": "bar", "prompt": "test"})
+			if _, err := server.HandleRequestBody(ctx, reqCtx, bodyBytes); err == nil {
+				t.Fatal("HandleRequestBody should return the immediate response")
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans) != 2 {
+				t.Fatalf("Expected 2 spans, got %d", len(spans))
+			}
+			for _, span := range spans {
+				if span.Status.Code != tt.wantStatus {
+					t.Errorf("Expected status %v of span %q, got %v", tt.wantStatus, span.Name, span.Status.Code)
+				}
+			}
+		})
+	}
+}
+
 type bodyMutatingPlugin struct {
 	name     string
 	mutateFn func(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error
@@ -679,3 +795,300 @@ func TestHandleRequestBody_BodyMutation(t *testing.T) {
 		})
 	}
 }
+
+func TestReplay(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	modelToHeaderPlugin, err := bodyfieldtoheader.NewBodyFieldToHeaderPlugin(modelField, bodyfieldtoheader.ModelHeader)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	mutatingPlugin := &bodyMutatingPlugin{
+		name: "body-mutator",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+			if request.Headers["x-inject"] == "true" {
+				request.SetBodyField("injected", "value")
+			}
+			return nil
+		},
+	}
+	server := NewServer(false, []framework.RequestProcessor{modelToHeaderPlugin, mutatingPlugin}, []framework.ResponseProcessor{})
+
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		body        string
+		wantHeaders map[string]string
+		wantBody    string
+		wantErr     bool
+	}{
+		{
+			name:        "body is not mutated",
+			body:        `{"model":"llama","prompt":"test"}`,
+			wantHeaders: map[string]string{bodyfieldtoheader.ModelHeader: "llama"},
+			wantBody:    `{"model":"llama","prompt":"test"}`,
+		},
+		{
+			name:    "body is mutated",
+			headers: map[string]string{"x-inject": "true"},
+			body:    `{"model":"llama","prompt":"test"}`,
+			wantHeaders: map[string]string{
+				bodyfieldtoheader.ModelHeader: "llama",
+				contentLengthHeader:           strconv.Itoa(len(`{"injected":"value","model":"llama","prompt":"test"}`)),
+			},
+			wantBody: `{"injected":"value","model":"llama","prompt":"test"}`,
+		},
+		{
+			name:    "invalid body",
+			body:    `{invalid`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			headers, body, err := server.Replay(ctx, tc.headers, []byte(tc.body))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Replay returned unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantHeaders, headers); diff != "" {
+				t.Errorf("Replay returned unexpected headers, diff(-want, +got): %v", diff)
+			}
+			if string(body) != tc.wantBody {
+				t.Errorf("Replay returned body %s, want %s", body, tc.wantBody)
+			}
+		})
+	}
+}
+
+func TestReplayBatchRequest(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	var gotBody map[string]any
+	var gotBytes []byte
+	capturingPlugin := &bodyMutatingPlugin{
+		name: "capture",
+		mutateFn: func(_ context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+			gotBody = request.Body
+			gotBytes, _ = framework.ReadCycleStateKey[[]byte](cycleState, framework.RequestBodyBytesKey)
+			return nil
+		},
+	}
+	server := NewServer(false, []framework.RequestProcessor{capturingPlugin}, []framework.ResponseProcessor{})
+
+	batch := "{\"custom_id\":\"1\"}\n{\"custom_id\":\"2\"}\n"
+	_, body, err := server.Replay(ctx, map[string]string{framework.PathHeader: framework.BatchesPath}, []byte(batch))
+	if err != nil {
+		t.Fatalf("Replay returned unexpected error: %v", err)
+	}
+	if len(gotBody) != 0 {
+		t.Errorf("expected an empty parsed body for a batch request, got %v", gotBody)
+	}
+	if string(gotBytes) != batch {
+		t.Errorf("raw body bytes = %q, want %q", gotBytes, batch)
+	}
+	if string(body) != batch {
+		t.Errorf("forwarded body = %q, want %q", body, batch)
+	}
+}
+
+func TestReplayMultipartRequest(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	var gotBody map[string]any
+	var gotBytes []byte
+	capturingPlugin := &bodyMutatingPlugin{
+		name: "capture",
+		mutateFn: func(_ context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+			gotBody = request.Body
+			gotBytes, _ = framework.ReadCycleStateKey[[]byte](cycleState, framework.RequestBodyBytesKey)
+			return nil
+		},
+	}
+	server := NewServer(false, []framework.RequestProcessor{capturingPlugin}, []framework.ResponseProcessor{})
+
+	form := "--xyz\r\nContent-Disposition: form-data; name=\"model\"\r\n\r\nwhisper-1\r\n--xyz--\r\n"
+	headers := map[string]string{"content-type": "multipart/form-data; boundary=xyz"}
+	_, body, err := server.Replay(ctx, headers, []byte(form))
+	if err != nil {
+		t.Fatalf("Replay returned unexpected error: %v", err)
+	}
+	if len(gotBody) != 0 {
+		t.Errorf("expected an empty parsed body for a multipart request, got %v", gotBody)
+	}
+	if string(gotBytes) != form {
+		t.Errorf("raw body bytes = %q, want %q", gotBytes, form)
+	}
+	if string(body) != form {
+		t.Errorf("forwarded body = %q, want %q", body, form)
+	}
+}
+
+func TestHandleRequestBody_AnthropicRequest(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+	server := NewServer(false, []framework.RequestProcessor{}, []framework.ResponseProcessor{})
+
+	newRequestContext := func() *RequestContext {
+		reqCtx := &RequestContext{
+			CycleState: framework.NewCycleState(),
+			Request:    framework.NewInferenceRequest(),
+		}
+		reqCtx.Request.Headers[framework.AnthropicVersionHeader] = "2023-06-01"
+		return reqCtx
+	}
+
+	reqCtx := newRequestContext()
+	body := []byte(`{"model":"claude-3","max_tokens":64,"system":"be brief","messages":[{"role":"user","content":"hi"}]}`)
+	if _, err := server.HandleRequestBody(ctx, reqCtx, body); err != nil {
+		t.Fatalf("HandleRequestBody returned unexpected error: %v", err)
+	}
+	params, err := framework.ReadCycleStateKey[framework.AnthropicMessageParams](reqCtx.CycleState, framework.AnthropicMessageParamsKey)
+	if err != nil {
+		t.Fatalf("Anthropic message params were not written to the cycle state: %v", err)
+	}
+	want := framework.AnthropicMessageParams{
+		Model:     "claude-3",
+		Messages:  []framework.AnthropicMessage{{Role: "user", Content: "hi"}},
+		MaxTokens: 64,
+		System:    "be brief",
+	}
+	if diff := cmp.Diff(want, params); diff != "" {
+		t.Errorf("unexpected Anthropic message params (-want +got):\n%s", diff)
+	}
+
+	// Anthropic requests are not rejected when they lack fields, e.g. the model of a Bedrock body, nor when
+	// their body cannot be parsed, but then get no Anthropic message params
+	reqCtx = newRequestContext()
+	if _, err := server.HandleRequestBody(ctx, reqCtx, []byte(`{"anthropic_version":"bedrock-2023-05-31","messages":[{"role":"user","content":"hi"}]}`)); err != nil {
+		t.Fatalf("HandleRequestBody returned unexpected error for a Bedrock body: %v", err)
+	}
+	if _, err := reqCtx.CycleState.Read(framework.AnthropicMessageParamsKey); err != nil {
+		t.Errorf("Anthropic message params were not written for a Bedrock body: %v", err)
+	}
+	reqCtx = newRequestContext()
+	if _, err := server.HandleRequestBody(ctx, reqCtx, []byte(`{"model":"claude-3","system":42,"messages":[]}`)); err != nil {
+		t.Fatalf("HandleRequestBody returned unexpected error for an invalid body: %v", err)
+	}
+	if _, err := reqCtx.CycleState.Read(framework.AnthropicMessageParamsKey); err == nil {
+		t.Error("expected no Anthropic message params for an invalid body")
+	}
+
+	// OpenAI requests don't get Anthropic message params
+	reqCtx = &RequestContext{CycleState: framework.NewCycleState(), Request: framework.NewInferenceRequest()}
+	if _, err := server.HandleRequestBody(ctx, reqCtx, []byte(`{"model":"m","messages":[]}`)); err != nil {
+		t.Fatalf("HandleRequestBody returned unexpected error: %v", err)
+	}
+	if _, err := reqCtx.CycleState.Read(framework.AnthropicMessageParamsKey); err == nil {
+		t.Error("expected no Anthropic message params for an OpenAI request")
+	}
+}
+
+func TestReplaySkipRemainingPlugins(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	skippingPlugin := &bodyMutatingPlugin{
+		name: "skip",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, _ *framework.InferenceRequest) error {
+			return framework.ErrSkipRemainingPlugins
+		},
+	}
+	skippedPlugin := &bodyMutatingPlugin{
+		name: "skipped",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+			request.SetHeader("X-Skipped", "false")
+			return nil
+		},
+	}
+	server := NewServer(false, []framework.RequestProcessor{skippingPlugin, skippedPlugin}, []framework.ResponseProcessor{})
+
+	headers, body, err := server.Replay(ctx, map[string]string{"x-envoy-internal": "true"}, nil)
+	if err != nil {
+		t.Fatalf("Replay returned unexpected error: %v", err)
+	}
+	if len(headers) != 0 {
+		t.Errorf("expected no header mutations after skipping the remaining plugins, got %v", headers)
+	}
+	if len(body) != 0 {
+		t.Errorf("forwarded body = %q, want it empty", body)
+	}
+}
+
+func TestHandleRequestBody_DynamicMetadata(t *testing.T) {
+	metrics.Register()
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	plugin := &bodyMutatingPlugin{
+		name: "metadata-setter",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+			request.SetDynamicMetadata("envoy.filters.http.ext_proc", "model", structpb.NewStringValue("llama"))
+			return nil
+		},
+	}
+	want, _ := structpb.NewStruct(map[string]any{
+		"envoy.filters.http.ext_proc": map[string]any{"model": "llama"},
+	})
+
+	for _, streaming := range []bool{false, true} {
+		server := NewServer(streaming, []framework.RequestProcessor{plugin}, []framework.ResponseProcessor{})
+		reqCtx := &RequestContext{
+			CycleState: framework.NewCycleState(),
+			Request:    framework.NewInferenceRequest(),
+		}
+		responses, err := server.HandleRequestBody(ctx, reqCtx, []byte(`{"model":"llama"}`))
+		if err != nil {
+			t.Fatalf("HandleRequestBody returned unexpected error: %v", err)
+		}
+		if len(responses) == 0 {
+			t.Fatalf("streaming=%v: expected responses, got none", streaming)
+		}
+		if diff := cmp.Diff(want, responses[0].GetDynamicMetadata(), protocmp.Transform()); diff != "" {
+			t.Errorf("streaming=%v: unexpected dynamic metadata (-want +got):\n%s", streaming, diff)
+		}
+	}
+}
+
+type completingPlugin struct {
+	bodyMutatingPlugin
+	completed []*framework.CycleState
+}
+
+func (p *completingPlugin) CompleteRequest(_ context.Context, cycleState *framework.CycleState) {
+	p.completed = append(p.completed, cycleState)
+}
+
+var _ framework.RequestCompleter = &completingPlugin{}
+
+func TestReplayCompletesRequestPlugins(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	plugin := &completingPlugin{bodyMutatingPlugin: bodyMutatingPlugin{
+		name: "completing",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+			if request.Headers["x-fail"] == "true" {
+				return errors.New("failed")
+			}
+			return nil
+		},
+	}}
+	server := NewServer(false, []framework.RequestProcessor{plugin}, []framework.ResponseProcessor{})
+
+	if _, _, err := server.Replay(ctx, nil, []byte(`{"model":"llama"}`)); err != nil {
+		t.Fatalf("Replay returned unexpected error: %v", err)
+	}
+	// the plugin is completed even if it failed
+	if _, _, err := server.Replay(ctx, map[string]string{"x-fail": "true"}, []byte(`{"model":"llama"}`)); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	// the plugins are not completed if the request was not processed
+	if _, _, err := server.Replay(ctx, nil, []byte(`{invalid`)); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if got := len(plugin.completed); got != 2 {
+		t.Errorf("completed requests = %d, want 2", got)
+	}
+}
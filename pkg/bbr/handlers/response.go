@@ -59,7 +59,8 @@ func (s *Server) HandleResponseHeaders(ctx context.Context, reqCtx *RequestConte
 // HandleResponseBody handles response bodies by executing response plugins in order.
 func (s *Server) HandleResponseBody(ctx context.Context, reqCtx *RequestContext, responseBodyBytes []byte) ([]*eppb.ProcessingResponse, error) {
 	logger := log.FromContext(ctx)
-	if len(s.responsePlugins) == 0 {
+	responsePlugins := s.responseChain()
+	if len(responsePlugins) == 0 {
 		if s.streaming {
 			return s.generateEmptyResponseBodyResponse(responseBodyBytes), nil
 		}
@@ -72,31 +73,42 @@ func (s *Server) HandleResponseBody(ctx context.Context, reqCtx *RequestContext,
 		}, nil
 	}
 
-	if err := json.Unmarshal(responseBodyBytes, &reqCtx.Response.Body); err != nil {
-		logger.Error(err, "Failed to parse response body as JSON, skipping response plugins")
-		if s.streaming {
-			return s.generateEmptyResponseBodyResponse(responseBodyBytes), nil
+	switch {
+	case reqCtx.Response.IsEventStream():
+		// the event stream is not a single JSON object, plugins read it from the raw body bytes
+		reqCtx.Response.Body = map[string]any{}
+		if reqCtx.CycleState != nil {
+			reqCtx.CycleState.Write(framework.ResponseBodyBytesKey, responseBodyBytes)
 		}
-		return []*eppb.ProcessingResponse{
-			{
-				Response: &eppb.ProcessingResponse_ResponseBody{
-					ResponseBody: &eppb.BodyResponse{},
+	default:
+		if err := json.Unmarshal(responseBodyBytes, &reqCtx.Response.Body); err != nil {
+			logger.Error(err, "Failed to parse response body as JSON, skipping response plugins")
+			if s.streaming {
+				return s.generateEmptyResponseBodyResponse(responseBodyBytes), nil
+			}
+			return []*eppb.ProcessingResponse{
+				{
+					Response: &eppb.ProcessingResponse_ResponseBody{
+						ResponseBody: &eppb.BodyResponse{},
+					},
 				},
-			},
-		}, nil
+			}, nil
+		}
 	}
 
-	if err := s.runResponsePlugins(ctx, reqCtx.CycleState, reqCtx.Response); err != nil {
+	if err := s.runResponsePlugins(ctx, responsePlugins, reqCtx.CycleState, reqCtx.Response); err != nil {
 		return nil, err
 	}
 
 	bodyMutated := reqCtx.Response.BodyMutated()
 	var mutatedBytes []byte
 	if bodyMutated {
-		var err error
-		mutatedBytes, err = json.Marshal(reqCtx.Response.Body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal mutated response body - %w", err)
+		if mutatedBytes = reqCtx.Response.RawBody(); mutatedBytes == nil {
+			var err error
+			mutatedBytes, err = json.Marshal(reqCtx.Response.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal mutated response body - %w", err)
+			}
 		}
 		reqCtx.Response.SetHeader(contentLengthHeader, strconv.Itoa(len(mutatedBytes)))
 	}
@@ -175,16 +187,20 @@ func (s *Server) HandleResponseTrailers(trailers *eppb.HttpTrailers) ([]*eppb.Pr
 	}, nil
 }
 
-// runResponsePlugins executes response plugins in the order they were registered.
-func (s *Server) runResponsePlugins(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+// runResponsePlugins executes the given response plugins in order.
+func (s *Server) runResponsePlugins(ctx context.Context, responsePlugins []framework.ResponseProcessor, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
 	var err error
-	for _, plugin := range s.responsePlugins {
+	for _, plugin := range responsePlugins {
 		log.FromContext(ctx).V(logutil.VERBOSE).Info("Executing response plugin", "plugin", plugin.TypedName())
 		before := time.Now()
 		err = plugin.ProcessResponse(ctx, cycleState, response)
-		metrics.RecordPluginProcessingLatency(responsePluginExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, time.Since(before))
-		if err != nil {
+		outcome := pluginOutcome(err)
+		metrics.RecordPluginProcessingLatency(responsePluginExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, outcome, time.Since(before))
+		if outcome != successOutcome {
 			log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to execute response plugin", "plugin", plugin.TypedName())
+			metrics.RecordPluginErrors(responsePluginExtensionPoint, plugin.TypedName().Type, plugin.TypedName().Name, outcome)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -423,3 +423,60 @@ func expectedStreamedResponseBodyMutation(bodyBytes []byte) []*extProcPb.Process
 		},
 	}
 }
+
+func TestHandleResponseBody_EventStream(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	var gotBody map[string]any
+	var gotBytes []byte
+	capturingPlugin := &fakeResponsePlugin{
+		name: "capture",
+		mutateFn: func(_ context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+			gotBody = response.Body
+			gotBytes, _ = framework.ReadCycleStateKey[[]byte](cycleState, framework.ResponseBodyBytesKey)
+			return nil
+		},
+	}
+	server := NewServer(false, nil, []framework.ResponseProcessor{capturingPlugin})
+	reqCtx := newTestRequestContext()
+	reqCtx.Response.Headers["content-type"] = "text/event-stream; charset=utf-8"
+
+	stream := "data: {\"choices\":[{\"delta\":{\"content\":\"Hi\"}}]}\n\ndata: [DONE]\n\n"
+	if _, err := server.HandleResponseBody(ctx, reqCtx, []byte(stream)); err != nil {
+		t.Fatalf("HandleResponseBody returned unexpected error: %v", err)
+	}
+	if gotBody == nil || len(gotBody) != 0 {
+		t.Errorf("expected the plugin to run with an empty parsed body, got %v", gotBody)
+	}
+	if string(gotBytes) != stream {
+		t.Errorf("raw body bytes = %q, want %q", gotBytes, stream)
+	}
+}
+
+func TestHandleResponseBody_RawBody(t *testing.T) {
+	ctx := logutil.NewTestLoggerIntoContext(context.Background())
+
+	truncated := "data: {\"choices\":[{\"delta\":{\"content\":\"Hi\"}}]}\n\ndata: [DONE]\n\n"
+	rewritingPlugin := &fakeResponsePlugin{
+		name: "rewrite",
+		mutateFn: func(_ context.Context, _ *framework.CycleState, response *framework.InferenceResponse) error {
+			response.SetRawBody([]byte(truncated))
+			return nil
+		},
+	}
+	server := NewServer(false, nil, []framework.ResponseProcessor{rewritingPlugin})
+	reqCtx := newTestRequestContext()
+	reqCtx.Response.Headers["content-type"] = "text/event-stream"
+
+	stream := "data: {\"choices\":[{\"delta\":{\"content\":\"Hi\"}}]}\n\ndata: {\"choices\":[{\"delta\":{\"content\":\" there\"}}]}\n\ndata: [DONE]\n\n"
+	responses, err := server.HandleResponseBody(ctx, reqCtx, []byte(stream))
+	if err != nil {
+		t.Fatalf("HandleResponseBody returned unexpected error: %v", err)
+	}
+	if got := string(responses[0].GetResponseBody().GetResponse().GetBodyMutation().GetBody()); got != truncated {
+		t.Errorf("body mutation = %q, want %q", got, truncated)
+	}
+	if got := reqCtx.Response.MutatedHeaders()["Content-Length"]; got != strconv.Itoa(len(truncated)) {
+		t.Errorf("Content-Length = %q, want %d", got, len(truncated))
+	}
+}
@@ -20,12 +20,14 @@ import (
 	"context"
 	"errors"
 	"io"
+	"sync"
 	"time"
 
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -43,22 +45,69 @@ const (
 
 	requestPluginExtensionPoint  = "request"
 	responsePluginExtensionPoint = "response"
+
+	// outcomes of the plugin executions in the plugin metrics
+	successOutcome = "success"
+	errorOutcome   = "error"
+	timeoutOutcome = "timeout"
+
+	// names and attributes of the request body processing spans
+	requestBodySpanName     = "gateway.bbr.request_body"
+	requestPluginSpanName   = "gateway.bbr.request_plugin"
+	pluginTracerName        = "gateway-api-inference-extension/bbr/plugins"
+	pluginTypeAttributeKey  = "bbr.plugin.type"
+	pluginNameAttributeKey  = "bbr.plugin.name"
+	pluginIndexAttributeKey = "bbr.plugin.index"
 )
 
 func NewServer(streaming bool, requestPlugins []framework.RequestProcessor, responsePlugins []framework.ResponseProcessor) *Server {
+	return NewServerWithExecutor(streaming, NewPluginsChain(requestPlugins), responsePlugins)
+}
+
+// NewServerWithExecutor returns a Server that runs the request plugins with the given executor, e.g. a
+// PluginsDAG executor running independent plugins concurrently, or a chain with per-plugin options.
+func NewServerWithExecutor(streaming bool, requestExecutor PluginsExecutor, responsePlugins []framework.ResponseProcessor) *Server {
 	return &Server{
 		streaming:       streaming,
-		requestPlugins:  requestPlugins,
+		requestExecutor: requestExecutor,
 		responsePlugins: responsePlugins,
+		tracer:          noop.NewTracerProvider().Tracer(""),
 	}
 }
 
+// WithTracer sets the tracer of the spans of the request body processing, and returns the Server.
+// By default, the Server uses a no-op tracer.
+func (s *Server) WithTracer(tracer trace.Tracer) *Server {
+	s.tracer = tracer
+	return s
+}
+
 // Server implements the Envoy external processing server.
 // https://www.envoyproxy.io/docs/envoy/latest/api-v3/service/ext_proc/v3/external_processor.proto
 type Server struct {
-	streaming       bool
-	requestPlugins  []framework.RequestProcessor
+	streaming bool
+	// chainsMu guards the request executor and the response plugins, which the admin handler replaces at
+	// runtime. Requests take a snapshot of them, so requests in flight complete with the chains they started with.
+	chainsMu        sync.RWMutex
+	requestExecutor PluginsExecutor
 	responsePlugins []framework.ResponseProcessor
+	// tracer starts the root span of the request body processing. The spans of the request plugins are
+	// started as its children, with the tracer provider of the root span.
+	tracer trace.Tracer
+}
+
+// requestChain returns the current request executor.
+func (s *Server) requestChain() PluginsExecutor {
+	s.chainsMu.RLock()
+	defer s.chainsMu.RUnlock()
+	return s.requestExecutor
+}
+
+// responseChain returns the current response plugins. The slice is replaced, never modified, on changes.
+func (s *Server) responseChain() []framework.ResponseProcessor {
+	s.chainsMu.RLock()
+	defer s.chainsMu.RUnlock()
+	return s.responsePlugins
 }
 
 // RequestContext stores context information during the lifetime of an HTTP request.
@@ -68,6 +117,19 @@ type RequestContext struct {
 	CycleState                *framework.CycleState
 	Request                   *framework.InferenceRequest
 	Response                  *framework.InferenceResponse
+	// requestPlugins are the request plugins the request was processed with, which are completed when the
+	// processing of the request ends.
+	requestPlugins []framework.RequestProcessor
+}
+
+// completeRequest calls the request plugins the request was processed with that implement
+// framework.RequestCompleter, so that they release the state they hold for the request.
+func (s *Server) completeRequest(ctx context.Context, reqCtx *RequestContext) {
+	for _, plugin := range reqCtx.requestPlugins {
+		if completer, ok := plugin.(framework.RequestCompleter); ok {
+			completer.CompleteRequest(ctx, reqCtx.CycleState)
+		}
+	}
 }
 
 func (s *Server) Process(srv extProcPb.ExternalProcessor_ProcessServer) error {
@@ -93,6 +155,8 @@ func (s *Server) Process(srv extProcPb.ExternalProcessor_ProcessServer) error {
 		Response:   framework.NewInferenceResponse(),
 		CycleState: framework.NewCycleState(),
 	}
+	// the stream context is canceled once the stream ended, but the plugins still need the logger to complete
+	defer func() { s.completeRequest(context.WithoutCancel(ctx), reqCtx) }()
 	// TODO set a max cap on these.
 	// both requestBody and responseBody accumulate without an upper bound.
 	// An arbitrarily large body can OOM the code.
@@ -140,6 +204,9 @@ func (s *Server) Process(srv extProcPb.ExternalProcessor_ProcessServer) error {
 			loggerVerbose.Info("processing response headers complete")
 		case *extProcPb.ProcessingRequest_ResponseBody:
 			loggerVerbose.Info("Incoming response body chunk", "EoS", v.ResponseBody.EndOfStream)
+			if len(responseBody) == 0 && len(v.ResponseBody.Body) > 0 {
+				reqCtx.CycleState.Write(framework.FirstResponseChunkTimeKey, time.Now())
+			}
 			responseBody = append(responseBody, v.ResponseBody.Body...)
 			if s.streaming && !v.ResponseBody.EndOfStream {
 				continue
@@ -155,7 +222,9 @@ func (s *Server) Process(srv extProcPb.ExternalProcessor_ProcessServer) error {
 
 		// Handle the err and fire an immediate response.
 		if err != nil {
-			if logger.V(logutil.DEBUG).Enabled() {
+			if errcommon.CanonicalCode(err) == errcommon.OK {
+				loggerVerbose.Info("responding to the request immediately")
+			} else if logger.V(logutil.DEBUG).Enabled() {
 				logger.V(logutil.DEBUG).Error(err, "failed to process request", "request", req)
 			} else {
 				logger.V(logutil.DEFAULT).Error(err, "failed to process request")
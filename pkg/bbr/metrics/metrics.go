@@ -71,12 +71,119 @@ var (
 		prometheus.HistogramOpts{
 			Subsystem: component,
 			Name:      "plugin_duration_seconds",
-			Help:      metricsutil.HelpMsgWithStability("Plugin processing latency distribution in seconds for each extension point, plugin type and plugin name.", compbasemetrics.ALPHA),
+			Help:      metricsutil.HelpMsgWithStability("Plugin processing latency distribution in seconds for each extension point, plugin type, plugin name and outcome (success, error, timeout).", compbasemetrics.ALPHA),
 			Buckets: []float64{
 				0.0001, 0.0002, 0.0005, 0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1,
 			},
 		},
-		[]string{"extension_point", "plugin_type", "plugin_name"},
+		[]string{"extension_point", "plugin_type", "plugin_name", "outcome"},
+	)
+
+	pluginErrorsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: component,
+			Name:      "plugin_errors_total",
+			Help:      metricsutil.HelpMsgWithStability("Count of plugin executions that failed, by extension point, plugin type, plugin name and reason (error, timeout).", compbasemetrics.ALPHA),
+		},
+		[]string{"extension_point", "plugin_type", "plugin_name", "reason"},
+	)
+
+	modelCircuitBreakerOpenGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: component,
+			Name:      "model_circuit_breaker_open",
+			Help:      metricsutil.HelpMsgWithStability("Whether requests to a model are currently blocked due to high P99 latency (1) or not (0).", compbasemetrics.ALPHA),
+		},
+		[]string{"model"},
+	)
+
+	modelWhitelistRejectionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: component,
+			Name:      "model_whitelist_rejections_total",
+			Help:      metricsutil.HelpMsgWithStability("Count of requests rejected because their model does not match any whitelisted pattern.", compbasemetrics.ALPHA),
+		},
+		[]string{"model"},
+	)
+
+	promptTokens = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: component,
+			Name:      "prompt_tokens",
+			Help:      metricsutil.HelpMsgWithStability("Prompt token count distribution reported in the responses of each model.", compbasemetrics.ALPHA),
+			// Most models have a input context window less than 1 million tokens.
+			Buckets: []float64{1, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768, 65536, 131072, 262144, 524288, 1048576},
+		},
+		[]string{"model"},
+	)
+
+	completionTokens = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: component,
+			Name:      "completion_tokens",
+			Help:      metricsutil.HelpMsgWithStability("Completion token count distribution reported in the responses of each model.", compbasemetrics.ALPHA),
+			// Most models generates output less than 8192 tokens.
+			Buckets: []float64{1, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192},
+		},
+		[]string{"model"},
+	)
+
+	completionToPromptTokensRatio = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: component,
+			Name:      "completion_to_prompt_tokens_ratio",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the ratio of completion tokens to prompt tokens in the responses of each model.", compbasemetrics.ALPHA),
+			Buckets:   []float64{0.01, 0.02, 0.05, 0.1, 0.2, 0.5, 1, 2, 5, 10, 20, 50, 100},
+		},
+		[]string{"model"},
+	)
+
+	shadowComparisonsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: component,
+			Name:      "shadow_comparisons_total",
+			Help:      metricsutil.HelpMsgWithStability("Count of shadow mode comparisons between the primary and the shadow responses, by result (match, mismatch, error).", compbasemetrics.ALPHA),
+		},
+		[]string{"model", "result"},
+	)
+
+	timeToFirstToken = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: component,
+			Name:      "time_to_first_token_seconds",
+			Help:      metricsutil.HelpMsgWithStability("Distribution of the time to first token of streamed responses, from the request arrival to the first response body chunk.", compbasemetrics.ALPHA),
+			Buckets: []float64{
+				0.005, 0.025, 0.05, 0.1, 0.2, 0.4, 0.6, 0.8, 1.0, 1.25, 1.5, 2, 3, 4, 5, 6, 8, 10, 15, 20, 30, 45, 60,
+			},
+		},
+		[]string{"model"},
+	)
+
+	codeExecutionDetectedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: component,
+			Name:      "code_execution_detected_total",
+			Help:      metricsutil.HelpMsgWithStability("Count of requests whose prompt was detected to contain code execution.", compbasemetrics.ALPHA),
+		},
+		[]string{"model"},
+	)
+
+	healthCheckBypassesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: component,
+			Name:      "health_check_bypasses_total",
+			Help:      metricsutil.HelpMsgWithStability("Count of health check probes forwarded without running the request plugins.", compbasemetrics.ALPHA),
+		},
+		[]string{},
+	)
+
+	tokenBudgetRemainingGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: component,
+			Name:      "token_budget_remaining",
+			Help:      metricsutil.HelpMsgWithStability("Number of users by the percentage of their daily token budget that remains, in buckets (0, 0-10, 10-25, 25-50, 50-75, 75-100).", compbasemetrics.ALPHA),
+		},
+		[]string{"remaining_percent"},
 	)
 )
 
@@ -90,12 +197,29 @@ func Register(customCollectors ...prometheus.Collector) {
 		metrics.Registry.MustRegister(bodyFieldNotFoundCounter)
 		metrics.Registry.MustRegister(bodyFieldEmptyCounter)
 		metrics.Registry.MustRegister(pluginProcessingLatencies)
+		metrics.Registry.MustRegister(pluginErrorsCounter)
+		metrics.Registry.MustRegister(modelCircuitBreakerOpenGauge)
+		metrics.Registry.MustRegister(modelWhitelistRejectionsCounter)
+		metrics.Registry.MustRegister(promptTokens)
+		metrics.Registry.MustRegister(completionTokens)
+		metrics.Registry.MustRegister(completionToPromptTokensRatio)
+		metrics.Registry.MustRegister(shadowComparisonsCounter)
+		metrics.Registry.MustRegister(timeToFirstToken)
+		metrics.Registry.MustRegister(codeExecutionDetectedCounter)
+		metrics.Registry.MustRegister(tokenBudgetRemainingGauge)
+		metrics.Registry.MustRegister(healthCheckBypassesCounter)
 		for _, collector := range customCollectors {
 			metrics.Registry.MustRegister(collector)
 		}
 	})
 }
 
+// ResetPluginMetrics clears the observations of the plugin metrics. Just for tests, which share the global registry.
+func ResetPluginMetrics() {
+	pluginProcessingLatencies.Reset()
+	pluginErrorsCounter.Reset()
+}
+
 // RecordBBRInfo records bbr build info.
 func RecordBBRInfo(commitSha, buildRef string) {
 	bbrInfo.WithLabelValues(commitSha, buildRef).Set(1)
@@ -116,7 +240,65 @@ func RecordBodyFieldEmpty(fieldName string) {
 	bodyFieldEmptyCounter.WithLabelValues(fieldName).Inc()
 }
 
-// RecordPluginProcessingLatency records the processing latency for a BBR plugin.
-func RecordPluginProcessingLatency(extensionPoint, pluginType, pluginName string, duration time.Duration) {
-	pluginProcessingLatencies.WithLabelValues(extensionPoint, pluginType, pluginName).Observe(duration.Seconds())
+// RecordPluginProcessingLatency records the processing latency for a BBR plugin, where outcome is "success",
+// "error" or "timeout".
+func RecordPluginProcessingLatency(extensionPoint, pluginType, pluginName, outcome string, duration time.Duration) {
+	pluginProcessingLatencies.WithLabelValues(extensionPoint, pluginType, pluginName, outcome).Observe(duration.Seconds())
+}
+
+// RecordPluginErrors records a BBR plugin execution that returned an error, where reason is "error" or "timeout".
+func RecordPluginErrors(extensionPoint, pluginType, pluginName, reason string) {
+	pluginErrorsCounter.WithLabelValues(extensionPoint, pluginType, pluginName, reason).Inc()
+}
+
+// RecordModelCircuitBreakerState records whether requests to the given model are currently blocked.
+func RecordModelCircuitBreakerState(model string, open bool) {
+	value := 0.0
+	if open {
+		value = 1
+	}
+	modelCircuitBreakerOpenGauge.WithLabelValues(model).Set(value)
+}
+
+// RecordModelWhitelistRejection records a request rejected because its model is not whitelisted.
+func RecordModelWhitelistRejection(model string) {
+	modelWhitelistRejectionsCounter.WithLabelValues(model).Inc()
+}
+
+// RecordTokenUsage records the prompt and completion token counts of a response, and their ratio.
+func RecordTokenUsage(model string, prompt, completion int) {
+	if prompt > 0 {
+		promptTokens.WithLabelValues(model).Observe(float64(prompt))
+	}
+	if completion > 0 {
+		completionTokens.WithLabelValues(model).Observe(float64(completion))
+	}
+	if prompt > 0 && completion > 0 {
+		completionToPromptTokensRatio.WithLabelValues(model).Observe(float64(completion) / float64(prompt))
+	}
+}
+
+// RecordShadowComparison records the result of comparing a primary response with its shadow response.
+func RecordShadowComparison(model, result string) {
+	shadowComparisonsCounter.WithLabelValues(model, result).Inc()
+}
+
+// RecordTimeToFirstToken records the time to first token of a streamed response.
+func RecordTimeToFirstToken(model string, ttft time.Duration) {
+	timeToFirstToken.WithLabelValues(model).Observe(ttft.Seconds())
+}
+
+// RecordCodeExecutionDetected records a request whose prompt was detected to contain code execution.
+func RecordCodeExecutionDetected(model string) {
+	codeExecutionDetectedCounter.WithLabelValues(model).Inc()
+}
+
+// RecordTokenBudgetRemainingUsers records the number of users whose remaining daily token budget is in the given bucket.
+func RecordTokenBudgetRemainingUsers(remainingPercent string, users int) {
+	tokenBudgetRemainingGauge.WithLabelValues(remainingPercent).Set(float64(users))
+}
+
+// RecordHealthCheckBypass records a health check probe forwarded without running the request plugins.
+func RecordHealthCheckBypass() {
+	healthCheckBypassesCounter.WithLabelValues().Inc()
 }
@@ -18,6 +18,7 @@ package metrics
 
 import (
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -32,6 +33,7 @@ func TestPluginProcessingLatency(t *testing.T) {
 		extensionPoint string
 		pluginType     string
 		pluginName     string
+		outcome        string
 		duration       time.Duration
 	}
 
@@ -46,12 +48,14 @@ func TestPluginProcessingLatency(t *testing.T) {
 					extensionPoint: "Request",
 					pluginType:     "TestPluginA",
 					pluginName:     "PluginA",
+					outcome:        "success",
 					duration:       5 * time.Millisecond,
 				},
 				{
 					extensionPoint: "Request",
 					pluginType:     "TestPluginB",
 					pluginName:     "PluginB",
+					outcome:        "error",
 					duration:       10 * time.Microsecond,
 				},
 			},
@@ -60,7 +64,7 @@ func TestPluginProcessingLatency(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
 			for _, latency := range scenario.latencies {
-				RecordPluginProcessingLatency(latency.extensionPoint, latency.pluginType, latency.pluginName, latency.duration)
+				RecordPluginProcessingLatency(latency.extensionPoint, latency.pluginType, latency.pluginName, latency.outcome, latency.duration)
 			}
 
 			wantPluginLatencies, err := os.Open("testdata/plugin_processing_latencies_metric")
@@ -78,3 +82,20 @@ func TestPluginProcessingLatency(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginErrors(t *testing.T) {
+	Register()
+
+	RecordPluginErrors("request", "TestPluginA", "PluginA", "error")
+	RecordPluginErrors("request", "TestPluginA", "PluginA", "error")
+	RecordPluginErrors("response", "TestPluginB", "PluginB", "timeout")
+
+	want := `# HELP bbr_plugin_errors_total [ALPHA] Count of plugin executions that failed, by extension point, plugin type, plugin name and reason (error, timeout).
+# TYPE bbr_plugin_errors_total counter
+bbr_plugin_errors_total{extension_point="request",plugin_name="PluginA",plugin_type="TestPluginA",reason="error"} 2
+bbr_plugin_errors_total{extension_point="response",plugin_name="PluginB",plugin_type="TestPluginB",reason="timeout"} 1
+`
+	if err := testutil.GatherAndCompare(crmetrics.Registry, strings.NewReader(want), "bbr_plugin_errors_total"); err != nil {
+		t.Error(err)
+	}
+}
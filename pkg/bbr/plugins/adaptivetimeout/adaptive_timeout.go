@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adaptivetimeout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	AdaptiveTimeoutPluginType = "adaptive-timeout"
+
+	// EstimatedPromptTokensHeader carries the estimated number of tokens of the prompt.
+	EstimatedPromptTokensHeader = "X-Estimated-Prompt-Tokens"
+	// UpstreamTimeoutHeader is the Envoy header overriding the route timeout of the request, in milliseconds.
+	UpstreamTimeoutHeader = "x-envoy-upstream-rq-timeout-ms"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &AdaptiveTimeoutPlugin{}
+
+// AdaptiveTimeoutConfig defines the JSON configuration structure for the plugin.
+type AdaptiveTimeoutConfig struct {
+	// BaseTimeoutMs is the timeout of a request with an empty prompt, in milliseconds.
+	BaseTimeoutMs int64 `json:"base_timeout_ms"`
+	// TimeoutMsPerToken is the timeout added for each prompt token, in milliseconds.
+	TimeoutMsPerToken float64 `json:"timeout_ms_per_token"`
+	// MaxTimeoutMs caps the timeout, in milliseconds.
+	MaxTimeoutMs int64 `json:"max_timeout_ms"`
+}
+
+// AdaptiveTimeoutPluginFactory defines the factory function for NewAdaptiveTimeoutPlugin.
+func AdaptiveTimeoutPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := AdaptiveTimeoutConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", AdaptiveTimeoutPluginType, err)
+		}
+	}
+
+	plugin, err := NewAdaptiveTimeoutPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", AdaptiveTimeoutPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewAdaptiveTimeoutPlugin initializes a new AdaptiveTimeoutPlugin and returns its pointer.
+func NewAdaptiveTimeoutPlugin(config AdaptiveTimeoutConfig) (*AdaptiveTimeoutPlugin, error) {
+	if config.BaseTimeoutMs <= 0 {
+		return nil, errors.New("base_timeout_ms must be positive in AdaptiveTimeout plugin")
+	}
+	if config.TimeoutMsPerToken < 0 {
+		return nil, errors.New("timeout_ms_per_token must not be negative in AdaptiveTimeout plugin")
+	}
+	if config.MaxTimeoutMs < config.BaseTimeoutMs {
+		return nil, errors.New("max_timeout_ms must not be smaller than base_timeout_ms in AdaptiveTimeout plugin")
+	}
+
+	return &AdaptiveTimeoutPlugin{
+		typedName: plugin.TypedName{
+			Type: AdaptiveTimeoutPluginType,
+			Name: AdaptiveTimeoutPluginType,
+		},
+		baseTimeoutMs:     config.BaseTimeoutMs,
+		timeoutMsPerToken: config.TimeoutMsPerToken,
+		maxTimeoutMs:      config.MaxTimeoutMs,
+	}, nil
+}
+
+// AdaptiveTimeoutPlugin sets the upstream timeout of each request from the estimated number of tokens
+// of its prompt, which is read from the X-Estimated-Prompt-Tokens header set by an earlier plugin,
+// since longer prompts take longer to process. The timeout grows linearly from the base timeout and
+// is capped at the maximum timeout. Requests without an estimate keep the route timeout.
+type AdaptiveTimeoutPlugin struct {
+	typedName         plugin.TypedName
+	baseTimeoutMs     int64
+	timeoutMsPerToken float64
+	maxTimeoutMs      int64
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *AdaptiveTimeoutPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *AdaptiveTimeoutPlugin) WithName(name string) *AdaptiveTimeoutPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the upstream timeout of the request from its estimated prompt tokens.
+func (p *AdaptiveTimeoutPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	rawTokens := request.GetHeader(EstimatedPromptTokensHeader)
+	if rawTokens == "" {
+		return nil
+	}
+	tokens, err := strconv.ParseInt(rawTokens, 10, 64)
+	if err != nil || tokens < 0 {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("ignoring an invalid estimate of prompt tokens", "header", EstimatedPromptTokensHeader, "value", rawTokens)
+		return nil
+	}
+
+	timeoutMs := p.timeout(tokens)
+	request.SetHeader(UpstreamTimeoutHeader, strconv.FormatInt(timeoutMs, 10))
+	log.FromContext(ctx).V(logutil.DEBUG).Info("set the upstream timeout", "promptTokens", tokens, "timeoutMs", timeoutMs)
+	return nil
+}
+
+// timeout returns the timeout of a request with the given number of prompt tokens, in milliseconds.
+func (p *AdaptiveTimeoutPlugin) timeout(tokens int64) int64 {
+	// computed in floating point, so extremely long prompts saturate instead of overflowing
+	timeoutMs := float64(p.baseTimeoutMs) + p.timeoutMsPerToken*float64(tokens)
+	return int64(math.Min(math.Ceil(timeoutMs), float64(p.maxTimeoutMs)))
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adaptivetimeout
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestAdaptiveTimeoutPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"base_timeout_ms":5000,"timeout_ms_per_token":2.5,"max_timeout_ms":120000}`)},
+		{name: "missing base timeout", rawParams: json.RawMessage(`{"timeout_ms_per_token":2.5,"max_timeout_ms":120000}`), wantErr: true},
+		{name: "negative factor", rawParams: json.RawMessage(`{"base_timeout_ms":5000,"timeout_ms_per_token":-1,"max_timeout_ms":120000}`), wantErr: true},
+		{name: "max below base", rawParams: json.RawMessage(`{"base_timeout_ms":5000,"max_timeout_ms":1000}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := AdaptiveTimeoutPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestAdaptiveTimeoutPlugin(t *testing.T) {
+	p, err := NewAdaptiveTimeoutPlugin(AdaptiveTimeoutConfig{BaseTimeoutMs: 5000, TimeoutMsPerToken: 2.5, MaxTimeoutMs: 120000})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		tokens      string
+		wantTimeout string
+	}{
+		{name: "zero tokens", tokens: "0", wantTimeout: "5000"},
+		{name: "regular prompt", tokens: "1000", wantTimeout: "7500"},
+		{name: "fractional timeout is rounded up", tokens: "3", wantTimeout: "5008"},
+		{name: "long prompt is capped", tokens: "100000", wantTimeout: "120000"},
+		{name: "extremely long prompt is capped", tokens: "9223372036854775807", wantTimeout: "120000"},
+		{name: "missing estimate"},
+		{name: "negative estimate", tokens: "-5"},
+		{name: "invalid estimate", tokens: "many"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			if tt.tokens != "" {
+				request.Headers["x-estimated-prompt-tokens"] = tt.tokens
+			}
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.GetHeader(UpstreamTimeoutHeader); got != tt.wantTimeout {
+				t.Errorf("%s = %q, want %q", UpstreamTimeoutHeader, got, tt.wantTimeout)
+			}
+		})
+	}
+}
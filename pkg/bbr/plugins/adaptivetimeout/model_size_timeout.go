@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adaptivetimeout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ModelSizeTimeoutPluginType = "model-size-timeout"
+	modelField                 = "model"
+
+	// ExpectedTimeoutHeader is the Envoy header announcing the timeout of the request to the upstream, in milliseconds.
+	ExpectedTimeoutHeader = "x-envoy-expected-rq-timeout-ms"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ModelSizeTimeoutPlugin{}
+
+// ModelSizeTimeoutConfig defines the JSON configuration structure for the plugin.
+type ModelSizeTimeoutConfig struct {
+	// ModelParamsBillions maps a model name to its parameter count in billions, e.g. {"llama-3-70b": 70}.
+	ModelParamsBillions map[string]float64 `json:"model_params_billions"`
+	// BaseTimeoutMs is the timeout of a request independent of the model size, in milliseconds.
+	BaseTimeoutMs int64 `json:"base_timeout_ms"`
+	// MsPerBillion is the timeout added for each billion parameters of the model, in milliseconds.
+	MsPerBillion float64 `json:"ms_per_billion"`
+}
+
+// ModelSizeTimeoutPluginFactory defines the factory function for NewModelSizeTimeoutPlugin.
+func ModelSizeTimeoutPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ModelSizeTimeoutConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ModelSizeTimeoutPluginType, err)
+		}
+	}
+
+	plugin, err := NewModelSizeTimeoutPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ModelSizeTimeoutPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewModelSizeTimeoutPlugin initializes a new ModelSizeTimeoutPlugin and returns its pointer.
+func NewModelSizeTimeoutPlugin(config ModelSizeTimeoutConfig) (*ModelSizeTimeoutPlugin, error) {
+	if len(config.ModelParamsBillions) == 0 {
+		return nil, errors.New("model_params_billions is required in ModelSizeTimeout plugin")
+	}
+	for model, params := range config.ModelParamsBillions {
+		if params <= 0 {
+			return nil, fmt.Errorf("parameter count of model %q must be positive in ModelSizeTimeout plugin", model)
+		}
+	}
+	if config.BaseTimeoutMs <= 0 {
+		return nil, errors.New("base_timeout_ms must be positive in ModelSizeTimeout plugin")
+	}
+	if config.MsPerBillion < 0 {
+		return nil, errors.New("ms_per_billion must not be negative in ModelSizeTimeout plugin")
+	}
+
+	timeouts := make(map[string]string, len(config.ModelParamsBillions))
+	for model, params := range config.ModelParamsBillions {
+		timeoutMs := math.Ceil(float64(config.BaseTimeoutMs) + params*config.MsPerBillion)
+		timeouts[model] = strconv.FormatFloat(timeoutMs, 'f', 0, 64)
+	}
+
+	return &ModelSizeTimeoutPlugin{
+		typedName: plugin.TypedName{
+			Type: ModelSizeTimeoutPluginType,
+			Name: ModelSizeTimeoutPluginType,
+		},
+		timeouts: timeouts,
+	}, nil
+}
+
+// ModelSizeTimeoutPlugin sets the timeout of each request from the size of its model, since larger models
+// have a higher inference latency, replacing a static timeout in the Envoy route configuration with
+// per-model values. The timeout is the base timeout plus a timeout per billion parameters, and is set in
+// both the x-envoy-upstream-rq-timeout-ms and x-envoy-expected-rq-timeout-ms headers.
+// Requests for models of unknown size keep the route timeout.
+type ModelSizeTimeoutPlugin struct {
+	typedName plugin.TypedName
+	// timeouts maps a model name to its timeout in milliseconds, precomputed from its size
+	timeouts map[string]string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ModelSizeTimeoutPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ModelSizeTimeoutPlugin) WithName(name string) *ModelSizeTimeoutPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the timeout headers of the request from the size of its model.
+func (p *ModelSizeTimeoutPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, _ := request.Body[modelField].(string)
+	timeoutMs, ok := p.timeouts[model]
+	if !ok {
+		return nil
+	}
+
+	request.SetHeader(UpstreamTimeoutHeader, timeoutMs)
+	request.SetHeader(ExpectedTimeoutHeader, timeoutMs)
+	log.FromContext(ctx).V(logutil.DEBUG).Info("set the timeout from the model size", "model", model, "timeoutMs", timeoutMs)
+	return nil
+}
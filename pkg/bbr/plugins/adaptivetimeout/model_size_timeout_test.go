@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adaptivetimeout
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestModelSizeTimeoutPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"model_params_billions":{"llama-3-8b":8,"llama-3-70b":70},"base_timeout_ms":10000,"ms_per_billion":500}`)},
+		{name: "missing models", rawParams: json.RawMessage(`{"base_timeout_ms":10000,"ms_per_billion":500}`), wantErr: true},
+		{name: "non-positive parameter count", rawParams: json.RawMessage(`{"model_params_billions":{"llama-3-8b":0},"base_timeout_ms":10000}`), wantErr: true},
+		{name: "non-positive base timeout", rawParams: json.RawMessage(`{"model_params_billions":{"llama-3-8b":8},"ms_per_billion":500}`), wantErr: true},
+		{name: "negative timeout per billion", rawParams: json.RawMessage(`{"model_params_billions":{"llama-3-8b":8},"base_timeout_ms":10000,"ms_per_billion":-1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ModelSizeTimeoutPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestModelSizeTimeoutPlugin(t *testing.T) {
+	p, err := NewModelSizeTimeoutPlugin(ModelSizeTimeoutConfig{
+		ModelParamsBillions: map[string]float64{"llama-3-8b": 8, "llama-3-70b": 70, "phi-3-mini": 3.8},
+		BaseTimeoutMs:       10000,
+		MsPerBillion:        500,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		model       string
+		wantTimeout string
+	}{
+		{model: "llama-3-8b", wantTimeout: "14000"},
+		{model: "llama-3-70b", wantTimeout: "45000"},
+		{model: "phi-3-mini", wantTimeout: "11900"},
+		{model: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body["model"] = tt.model
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, header := range []string{UpstreamTimeoutHeader, ExpectedTimeoutHeader} {
+				if got := request.GetHeader(header); got != tt.wantTimeout {
+					t.Errorf("%s = %q, want %q", header, got, tt.wantTimeout)
+				}
+			}
+		})
+	}
+}
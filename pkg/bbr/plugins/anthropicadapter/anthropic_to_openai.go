@@ -0,0 +1,396 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anthropicadapter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	AnthropicToOpenAIPluginType = "anthropic-to-openai"
+	ProviderHeader              = "X-API-Provider"
+	anthropicProvider           = "anthropic"
+
+	anthropicMessagesPath = "/v1/messages"
+	chatCompletionsPath   = "/v1/chat/completions"
+)
+
+// parameterNames maps the Anthropic request fields to their OpenAI names.
+var parameterNames = map[string]string{
+	"max_tokens":     "max_tokens",
+	"temperature":    "temperature",
+	"top_p":          "top_p",
+	"stop_sequences": "stop",
+	"stream":         "stream",
+}
+
+// stopReasons maps the OpenAI finish reasons to the Anthropic stop reasons.
+var stopReasons = map[string]string{
+	"stop":           "end_turn",
+	"length":         "max_tokens",
+	"tool_calls":     "tool_use",
+	"function_call":  "tool_use",
+	"content_filter": "end_turn",
+}
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &AnthropicToOpenAIPlugin{}
+	_ framework.ResponseProcessor = &AnthropicToOpenAIPlugin{}
+)
+
+// AnthropicToOpenAIConfig defines the JSON configuration structure for the plugin.
+type AnthropicToOpenAIConfig struct {
+	// ModelMapping maps Anthropic model names to the models served by the backends, e.g.
+	// {"claude-3-opus-20240229": "llama-3-70b", "claude-3-*": "llama-3-8b"}. A name ending with "*" matches
+	// the models starting with the rest of the name, and exact names take precedence over it.
+	// Unmapped models are forwarded unchanged.
+	ModelMapping map[string]string `json:"model_mapping"`
+}
+
+// AnthropicToOpenAIPluginFactory defines the factory function for NewAnthropicToOpenAIPlugin.
+func AnthropicToOpenAIPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config AnthropicToOpenAIConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", AnthropicToOpenAIPluginType, err)
+		}
+	}
+
+	plugin, err := NewAnthropicToOpenAIPlugin(config.ModelMapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", AnthropicToOpenAIPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewAnthropicToOpenAIPlugin initializes a new AnthropicToOpenAIPlugin and returns its pointer.
+func NewAnthropicToOpenAIPlugin(modelMapping map[string]string) (*AnthropicToOpenAIPlugin, error) {
+	exact := map[string]string{}
+	var prefixes []modelPrefix
+	for from, to := range modelMapping {
+		if to == "" {
+			return nil, fmt.Errorf("mapping of model %q must not be empty in AnthropicToOpenAI plugin", from)
+		}
+		if prefix, ok := strings.CutSuffix(from, "*"); ok {
+			if prefix == "" || strings.Contains(prefix, "*") {
+				return nil, fmt.Errorf("invalid model pattern %q in AnthropicToOpenAI plugin", from)
+			}
+			prefixes = append(prefixes, modelPrefix{prefix: prefix, model: to})
+			continue
+		}
+		if from == "" {
+			return nil, errors.New("model names must not be empty in AnthropicToOpenAI plugin")
+		}
+		exact[from] = to
+	}
+
+	return &AnthropicToOpenAIPlugin{
+		typedName: plugin.TypedName{
+			Type: AnthropicToOpenAIPluginType,
+			Name: AnthropicToOpenAIPluginType,
+		},
+		exactModels:   exact,
+		modelPrefixes: prefixes,
+	}, nil
+}
+
+// modelPrefix maps the models starting with the prefix to a model.
+type modelPrefix struct {
+	prefix string
+	model  string
+}
+
+// AnthropicToOpenAIPlugin lets clients of the Anthropic Messages API use backends serving the OpenAI chat
+// completions API. Requests marked with "X-API-Provider: anthropic" are converted from Anthropic's
+// {"model","system","messages","max_tokens"} format, including image, tool use and tool result content
+// blocks, into an OpenAI chat completion request, and the chat completion response is converted back into
+// an Anthropic message. Streamed responses are forwarded unchanged.
+type AnthropicToOpenAIPlugin struct {
+	typedName     plugin.TypedName
+	exactModels   map[string]string
+	modelPrefixes []modelPrefix
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *AnthropicToOpenAIPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *AnthropicToOpenAIPlugin) WithName(name string) *AnthropicToOpenAIPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rewrites the Anthropic Messages request into an OpenAI chat completion request.
+func (p *AnthropicToOpenAIPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	if !strings.EqualFold(request.GetHeader(ProviderHeader), anthropicProvider) {
+		return nil
+	}
+
+	model, _ := request.Body["model"].(string)
+	rawMessages, ok := request.Body["messages"].([]any)
+	if !ok {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "field 'messages' is required for Anthropic requests"}
+	}
+
+	var messages []any
+	if system := messagetext.Join(request.Body["system"], ""); system != "" {
+		messages = append(messages, map[string]any{"role": "system", "content": system})
+	}
+	for i, raw := range rawMessages {
+		converted, err := convertMessage(raw)
+		if err != nil {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("invalid messages[%d] - %v", i, err)}
+		}
+		messages = append(messages, converted...)
+	}
+
+	body := map[string]any{
+		"model":    p.mapModel(model),
+		"messages": messages,
+	}
+	for anthropicName, openAIName := range parameterNames {
+		if value, ok := request.Body[anthropicName]; ok {
+			body[openAIName] = value
+		}
+	}
+	if tools, ok := request.Body["tools"].([]any); ok {
+		body["tools"] = convertTools(tools)
+	}
+	if metadata, ok := request.Body["metadata"].(map[string]any); ok {
+		if userID, ok := metadata["user_id"].(string); ok {
+			body["user"] = userID
+		}
+	}
+
+	request.SetBody(body)
+	if path := request.GetHeader(framework.PathHeader); strings.HasPrefix(path, anthropicMessagesPath) {
+		request.SetHeader(framework.PathHeader, chatCompletionsPath+strings.TrimPrefix(path, anthropicMessagesPath))
+	}
+	if cycleState != nil {
+		cycleState.Write(p.typedName.String(), model)
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("converted Anthropic request to OpenAI format", "model", model, "mappedModel", body["model"])
+
+	return nil
+}
+
+// ProcessResponse converts the OpenAI chat completion response into an Anthropic message.
+func (p *AnthropicToOpenAIPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+
+	model, err := framework.ReadCycleStateKey[string](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not an Anthropic request
+	}
+
+	choices, ok := response.Body["choices"].([]any)
+	if !ok || len(choices) == 0 {
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("response has no choices, leaving it unchanged")
+		return nil
+	}
+	choice, _ := choices[0].(map[string]any)
+	message, _ := choice["message"].(map[string]any)
+
+	content := []any{}
+	if text := messagetext.Join(message["content"], ""); text != "" {
+		content = append(content, map[string]any{"type": "text", "text": text})
+	}
+	toolCalls, _ := message["tool_calls"].([]any)
+	for _, raw := range toolCalls {
+		toolCall, _ := raw.(map[string]any)
+		function, _ := toolCall["function"].(map[string]any)
+		input := map[string]any{}
+		if arguments, ok := function["arguments"].(string); ok && arguments != "" {
+			if err := json.Unmarshal([]byte(arguments), &input); err != nil {
+				log.FromContext(ctx).V(logutil.DEFAULT).Info("tool call arguments are not a JSON object, leaving them empty")
+			}
+		}
+		content = append(content, map[string]any{
+			"type":  "tool_use",
+			"id":    toolCall["id"],
+			"name":  function["name"],
+			"input": input,
+		})
+	}
+
+	stopReason, ok := stopReasons[fmt.Sprint(choice["finish_reason"])]
+	if !ok {
+		stopReason = "end_turn"
+	}
+	body := map[string]any{
+		"id":            response.Body["id"],
+		"type":          "message",
+		"role":          "assistant",
+		"model":         model,
+		"content":       content,
+		"stop_reason":   stopReason,
+		"stop_sequence": nil,
+	}
+	if usage, ok := response.Body["usage"].(map[string]any); ok {
+		body["usage"] = map[string]any{
+			"input_tokens":  usage["prompt_tokens"],
+			"output_tokens": usage["completion_tokens"],
+		}
+	}
+
+	response.SetBody(body)
+	return nil
+}
+
+// mapModel returns the backend model of an Anthropic model.
+func (p *AnthropicToOpenAIPlugin) mapModel(model string) string {
+	if mapped, ok := p.exactModels[model]; ok {
+		return mapped
+	}
+	longest := -1
+	mapped := model
+	for _, mp := range p.modelPrefixes {
+		if strings.HasPrefix(model, mp.prefix) && len(mp.prefix) > longest {
+			longest = len(mp.prefix)
+			mapped = mp.model
+		}
+	}
+	return mapped
+}
+
+// convertMessage converts an Anthropic message into OpenAI messages. Tool results become tool messages,
+// which precede the rest of the content of the user message.
+func convertMessage(raw any) ([]any, error) {
+	message, ok := raw.(map[string]any)
+	if !ok {
+		return nil, errors.New("must be an object")
+	}
+	role, _ := message["role"].(string)
+	if role != "user" && role != "assistant" {
+		return nil, errors.New("role must be user or assistant")
+	}
+
+	blocks, ok := message["content"].([]any)
+	if !ok {
+		text, ok := message["content"].(string)
+		if !ok {
+			return nil, errors.New("content must be a string or an array of content blocks")
+		}
+		return []any{map[string]any{"role": role, "content": text}}, nil
+	}
+
+	var toolMessages, parts, toolCalls []any
+	for _, rawBlock := range blocks {
+		block, _ := rawBlock.(map[string]any)
+		switch block["type"] {
+		case "text":
+			parts = append(parts, map[string]any{"type": "text", "text": block["text"]})
+		case "image":
+			source, _ := block["source"].(map[string]any)
+			url, ok := imageURL(source)
+			if !ok {
+				return nil, errors.New("image source must be base64 or url")
+			}
+			parts = append(parts, map[string]any{"type": "image_url", "image_url": map[string]any{"url": url}})
+		case "tool_use":
+			arguments, err := json.Marshal(block["input"])
+			if err != nil {
+				return nil, err
+			}
+			toolCalls = append(toolCalls, map[string]any{
+				"id":       block["id"],
+				"type":     "function",
+				"function": map[string]any{"name": block["name"], "arguments": string(arguments)},
+			})
+		case "tool_result":
+			toolMessages = append(toolMessages, map[string]any{
+				"role":         "tool",
+				"tool_call_id": block["tool_use_id"],
+				"content":      messagetext.Join(block["content"], ""),
+			})
+		default:
+			return nil, fmt.Errorf("unsupported content block type %v", block["type"])
+		}
+	}
+
+	messages := toolMessages
+	if len(parts) == 0 && len(toolCalls) == 0 {
+		return messages, nil
+	}
+	converted := map[string]any{"role": role}
+	switch {
+	case len(parts) == 0:
+		converted["content"] = nil
+	case role == "assistant":
+		converted["content"] = messagetext.Join(parts, "") // assistant messages only have text content
+	default:
+		converted["content"] = parts
+	}
+	if len(toolCalls) > 0 {
+		converted["tool_calls"] = toolCalls
+	}
+	return append(messages, converted), nil
+}
+
+// imageURL returns the URL of an Anthropic image source, as a data URL for base64 sources.
+func imageURL(source map[string]any) (string, bool) {
+	switch source["type"] {
+	case "base64":
+		mediaType, _ := source["media_type"].(string)
+		data, _ := source["data"].(string)
+		return fmt.Sprintf("data:%s;base64,%s", mediaType, data), mediaType != "" && data != ""
+	case "url":
+		url, _ := source["url"].(string)
+		return url, url != ""
+	}
+	return "", false
+}
+
+// convertTools converts Anthropic tool definitions into OpenAI function tools.
+func convertTools(tools []any) []any {
+	converted := make([]any, 0, len(tools))
+	for _, raw := range tools {
+		tool, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		function := map[string]any{"name": tool["name"], "parameters": tool["input_schema"]}
+		if description, ok := tool["description"]; ok {
+			function["description"] = description
+		}
+		converted = append(converted, map[string]any{"type": "function", "function": function})
+	}
+	return converted
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package anthropicadapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestAnthropicToOpenAIPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "model mapping", rawParams: json.RawMessage(`{"model_mapping":{"claude-3-opus-20240229":"llama-3-70b","claude-3-*":"llama-3-8b"}}`)},
+		{name: "empty mapped model", rawParams: json.RawMessage(`{"model_mapping":{"claude-3-opus":""}}`), wantErr: true},
+		{name: "invalid pattern", rawParams: json.RawMessage(`{"model_mapping":{"claude-*-opus*":"llama-3-70b"}}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := AnthropicToOpenAIPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestAnthropicToOpenAIPlugin_ProcessRequest(t *testing.T) {
+	p, err := NewAnthropicToOpenAIPlugin(map[string]string{
+		"claude-3-opus-20240229": "llama-3-70b",
+		"claude-3-*":             "llama-3-8b",
+		"claude-3-5-*":           "qwen-2",
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		provider string
+		path     string
+		body     map[string]any
+		wantBody map[string]any
+		wantPath string
+		wantCode string
+	}{
+		{
+			name:     "messages request is converted",
+			provider: "Anthropic",
+			path:     "/v1/messages",
+			body: map[string]any{
+				"model":          "claude-3-opus-20240229",
+				"system":         "Be brief.",
+				"max_tokens":     1024.0,
+				"stop_sequences": []any{"END"},
+				"metadata":       map[string]any{"user_id": "user-1"},
+				"tools": []any{map[string]any{
+					"name":         "get_weather",
+					"description":  "Get the weather",
+					"input_schema": map[string]any{"type": "object"},
+				}},
+				"messages": []any{
+					map[string]any{"role": "user", "content": []any{
+						map[string]any{"type": "text", "text": "What is in this image?"},
+						map[string]any{"type": "image", "source": map[string]any{"type": "base64", "media_type": "image/png", "data": "iVBOR"}},
+					}},
+					map[string]any{"role": "assistant", "content": []any{
+						map[string]any{"type": "text", "text": "Let me check."},
+						map[string]any{"type": "tool_use", "id": "toolu_1", "name": "get_weather", "input": map[string]any{"city": "Paris"}},
+					}},
+					map[string]any{"role": "user", "content": []any{
+						map[string]any{"type": "tool_result", "tool_use_id": "toolu_1", "content": "Sunny"},
+					}},
+				},
+			},
+			wantBody: map[string]any{
+				"model":      "llama-3-70b",
+				"max_tokens": 1024.0,
+				"stop":       []any{"END"},
+				"user":       "user-1",
+				"tools": []any{map[string]any{"type": "function", "function": map[string]any{
+					"name":        "get_weather",
+					"description": "Get the weather",
+					"parameters":  map[string]any{"type": "object"},
+				}}},
+				"messages": []any{
+					map[string]any{"role": "system", "content": "Be brief."},
+					map[string]any{"role": "user", "content": []any{
+						map[string]any{"type": "text", "text": "What is in this image?"},
+						map[string]any{"type": "image_url", "image_url": map[string]any{"url": "data:image/png;base64,iVBOR"}},
+					}},
+					map[string]any{"role": "assistant", "content": "Let me check.", "tool_calls": []any{map[string]any{
+						"id":       "toolu_1",
+						"type":     "function",
+						"function": map[string]any{"name": "get_weather", "arguments": `{"city":"Paris"}`},
+					}}},
+					map[string]any{"role": "tool", "tool_call_id": "toolu_1", "content": "Sunny"},
+				},
+			},
+			wantPath: "/v1/chat/completions",
+		},
+		{
+			name:     "longest model pattern wins",
+			provider: "anthropic",
+			body:     map[string]any{"model": "claude-3-5-sonnet-20240620", "messages": []any{map[string]any{"role": "user", "content": "Hi"}}},
+			wantBody: map[string]any{"model": "qwen-2", "messages": []any{map[string]any{"role": "user", "content": "Hi"}}},
+		},
+		{
+			name:     "unmapped model is kept",
+			provider: "anthropic",
+			body:     map[string]any{"model": "claude-2.1", "messages": []any{map[string]any{"role": "user", "content": "Hi"}}},
+			wantBody: map[string]any{"model": "claude-2.1", "messages": []any{map[string]any{"role": "user", "content": "Hi"}}},
+		},
+		{
+			name:     "other providers are ignored",
+			provider: "openai",
+			path:     "/v1/messages",
+			body:     map[string]any{"model": "claude-3-opus-20240229", "messages": []any{}},
+			wantBody: map[string]any{"model": "claude-3-opus-20240229", "messages": []any{}},
+			wantPath: "/v1/messages",
+		},
+		{
+			name:     "missing messages",
+			provider: "anthropic",
+			body:     map[string]any{"model": "claude-3-opus-20240229"},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "unsupported content block",
+			provider: "anthropic",
+			body: map[string]any{"model": "claude-3-opus-20240229", "messages": []any{
+				map[string]any{"role": "user", "content": []any{map[string]any{"type": "document"}}},
+			}},
+			wantCode: errcommon.BadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Headers["x-api-provider"] = tt.provider
+			if tt.path != "" {
+				request.Headers[framework.PathHeader] = tt.path
+			}
+			request.Body = tt.body
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+			if got := request.GetHeader(framework.PathHeader); got != tt.wantPath {
+				t.Errorf("path = %q, want %q", got, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestAnthropicToOpenAIPlugin_ProcessResponse(t *testing.T) {
+	p, err := NewAnthropicToOpenAIPlugin(nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	cycleState := framework.NewCycleState()
+	request := framework.NewInferenceRequest()
+	request.Headers["x-api-provider"] = "anthropic"
+	request.Body = map[string]any{"model": "claude-3-haiku-20240307", "messages": []any{map[string]any{"role": "user", "content": "Weather?"}}}
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("ProcessRequest() unexpected error: %v", err)
+	}
+
+	response := framework.NewInferenceResponse()
+	response.Body = map[string]any{
+		"id":    "chatcmpl-1",
+		"model": "llama-3-8b",
+		"choices": []any{map[string]any{
+			"index": 0.0,
+			"message": map[string]any{
+				"role":    "assistant",
+				"content": "Checking.",
+				"tool_calls": []any{map[string]any{
+					"id":       "call_1",
+					"type":     "function",
+					"function": map[string]any{"name": "get_weather", "arguments": `{"city":"Paris"}`},
+				}},
+			},
+			"finish_reason": "tool_calls",
+		}},
+		"usage": map[string]any{"prompt_tokens": 12.0, "completion_tokens": 7.0, "total_tokens": 19.0},
+	}
+	if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+		t.Fatalf("ProcessResponse() unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"id":    "chatcmpl-1",
+		"type":  "message",
+		"role":  "assistant",
+		"model": "claude-3-haiku-20240307",
+		"content": []any{
+			map[string]any{"type": "text", "text": "Checking."},
+			map[string]any{"type": "tool_use", "id": "call_1", "name": "get_weather", "input": map[string]any{"city": "Paris"}},
+		},
+		"stop_reason":   "tool_use",
+		"stop_sequence": nil,
+		"usage":         map[string]any{"input_tokens": 12.0, "output_tokens": 7.0},
+	}
+	if diff := cmp.Diff(want, response.Body); diff != "" {
+		t.Errorf("unexpected body (-want +got):\n%s", diff)
+	}
+
+	// responses of other requests are left unchanged
+	other := framework.NewInferenceResponse()
+	other.Body = map[string]any{"choices": []any{}}
+	if err := p.ProcessResponse(context.Background(), framework.NewCycleState(), other); err != nil {
+		t.Fatalf("ProcessResponse() unexpected error: %v", err)
+	}
+	if other.BodyMutated() {
+		t.Error("response of a non-Anthropic request was mutated")
+	}
+}
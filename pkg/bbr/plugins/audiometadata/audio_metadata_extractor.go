@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audiometadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"mime/multipart"
+	"path"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	AudioMetadataExtractorPluginType = "audio-metadata-extractor"
+
+	// DurationEstimateHeader is set to the estimated duration of the audio file, in whole seconds.
+	DurationEstimateHeader = "X-BBR-Audio-Duration-Estimate"
+	// LanguageHeader is set to the language of the audio, as given in the language form field.
+	LanguageHeader = "X-BBR-Audio-Language"
+
+	fileField     = "file"
+	languageField = "language"
+
+	transcriptionsPath = "/v1/audio/transcriptions"
+	translationsPath   = "/v1/audio/translations"
+
+	defaultBitrateKbps = 128
+)
+
+// defaultBitratesKbps are typical bitrates of the audio formats accepted by Whisper, keyed by file extension.
+var defaultBitratesKbps = map[string]float64{
+	"flac": 700,
+	"m4a":  128,
+	"mp3":  128,
+	"mp4":  128,
+	"mpeg": 128,
+	"mpga": 128,
+	"ogg":  96,
+	"wav":  1411,
+	"webm": 64,
+}
+
+// compile-time type validation
+var _ framework.RequestProcessor = &AudioMetadataExtractorPlugin{}
+
+// AudioMetadataExtractorConfig defines the JSON configuration structure for the plugin.
+type AudioMetadataExtractorConfig struct {
+	// BitratesKbps overrides the typical bitrate of audio formats, keyed by lowercase file extension, e.g. {"mp3": 64}.
+	BitratesKbps map[string]float64 `json:"bitrates_kbps"`
+	// DefaultBitrateKbps is the bitrate assumed for files of unknown format.
+	DefaultBitrateKbps float64 `json:"default_bitrate_kbps"`
+}
+
+// AudioMetadataExtractorPluginFactory defines the factory function for NewAudioMetadataExtractorPlugin.
+func AudioMetadataExtractorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := AudioMetadataExtractorConfig{
+		DefaultBitrateKbps: defaultBitrateKbps,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", AudioMetadataExtractorPluginType, err)
+		}
+	}
+
+	plugin, err := NewAudioMetadataExtractorPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", AudioMetadataExtractorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewAudioMetadataExtractorPlugin initializes a new AudioMetadataExtractorPlugin and returns its pointer.
+func NewAudioMetadataExtractorPlugin(config AudioMetadataExtractorConfig) (*AudioMetadataExtractorPlugin, error) {
+	if config.DefaultBitrateKbps <= 0 {
+		return nil, errors.New("default_bitrate_kbps must be positive in AudioMetadataExtractor plugin")
+	}
+
+	bitrates := make(map[string]float64, len(defaultBitratesKbps)+len(config.BitratesKbps))
+	for ext, kbps := range defaultBitratesKbps {
+		bitrates[ext] = kbps
+	}
+	for ext, kbps := range config.BitratesKbps {
+		if kbps <= 0 {
+			return nil, fmt.Errorf("bitrate of format %q must be positive in AudioMetadataExtractor plugin", ext)
+		}
+		bitrates[strings.ToLower(ext)] = kbps
+	}
+
+	return &AudioMetadataExtractorPlugin{
+		typedName: plugin.TypedName{
+			Type: AudioMetadataExtractorPluginType,
+			Name: AudioMetadataExtractorPluginType,
+		},
+		bitratesKbps:       bitrates,
+		defaultBitrateKbps: config.DefaultBitrateKbps,
+	}, nil
+}
+
+// AudioMetadataExtractorPlugin extracts metadata of the audio file of Whisper transcription and translation
+// requests, for use in routing decisions, e.g. sending long recordings to dedicated backends.
+// The multipart form carries no duration, so it is estimated from the file size and the typical bitrate
+// of the format given by the file extension. The language form field, when present, is set as a header.
+type AudioMetadataExtractorPlugin struct {
+	typedName          plugin.TypedName
+	bitratesKbps       map[string]float64
+	defaultBitrateKbps float64
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *AudioMetadataExtractorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *AudioMetadataExtractorPlugin) WithName(name string) *AudioMetadataExtractorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the audio metadata headers of audio transcription and translation requests.
+func (p *AudioMetadataExtractorPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if !isAudioRequest(request) {
+		return nil
+	}
+
+	body, err := framework.ReadCycleStateKey[[]byte](cycleState, framework.RequestBodyBytesKey)
+	if err != nil {
+		return nil // the raw body is not available
+	}
+	_, params, err := mime.ParseMediaType(request.GetHeader("content-type"))
+	if err != nil || params["boundary"] == "" {
+		return nil // the backend rejects malformed forms
+	}
+
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				logger.Info("failed to read the multipart form of the audio request", "error", err)
+			}
+			return nil
+		}
+
+		switch part.FormName() {
+		case fileField:
+			size, err := io.Copy(io.Discard, part)
+			if err != nil {
+				logger.Info("failed to read the audio file", "error", err)
+				return nil
+			}
+			seconds := p.estimateDuration(part.FileName(), size)
+			request.SetHeader(DurationEstimateHeader, strconv.FormatInt(seconds, 10))
+			logger.Info("estimated the audio duration", "filename", part.FileName(), "bytes", size, "seconds", seconds)
+		case languageField:
+			language, err := io.ReadAll(part)
+			if err != nil {
+				logger.Info("failed to read the audio language", "error", err)
+				return nil
+			}
+			if language := strings.TrimSpace(string(language)); language != "" {
+				request.SetHeader(LanguageHeader, language)
+			}
+		}
+	}
+}
+
+// estimateDuration estimates the duration in seconds of an audio file of the given name and size,
+// from the typical bitrate of its format.
+func (p *AudioMetadataExtractorPlugin) estimateDuration(filename string, size int64) int64 {
+	kbps, ok := p.bitratesKbps[strings.ToLower(strings.TrimPrefix(path.Ext(filename), "."))]
+	if !ok {
+		kbps = p.defaultBitrateKbps
+	}
+	return int64(math.Ceil(float64(size) * 8 / (kbps * 1000)))
+}
+
+// isAudioRequest returns whether the request is a multipart audio transcription or translation request.
+func isAudioRequest(request *framework.InferenceRequest) bool {
+	if !request.IsMultipartRequest() {
+		return false
+	}
+	requestPath, _, _ := strings.Cut(request.GetHeader(framework.PathHeader), "?")
+	return requestPath == transcriptionsPath || requestPath == translationsPath
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audiometadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestAudioMetadataExtractorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "bitrate overrides", rawParams: json.RawMessage(`{"bitrates_kbps":{"mp3":64},"default_bitrate_kbps":96}`)},
+		{name: "non-positive bitrate", rawParams: json.RawMessage(`{"bitrates_kbps":{"mp3":0}}`), wantErr: true},
+		{name: "non-positive default bitrate", rawParams: json.RawMessage(`{"default_bitrate_kbps":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := AudioMetadataExtractorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+// newAudioForm returns a multipart form with an audio file of the given name and size, and its content type.
+func newAudioForm(t *testing.T, filename string, size int, language string) ([]byte, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := writer.WriteField("model", "whisper-1"); err != nil {
+		t.Fatal(err)
+	}
+	if language != "" {
+		if err := writer.WriteField("language", language); err != nil {
+			t.Fatal(err)
+		}
+	}
+	file, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write(make([]byte, size)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), writer.FormDataContentType()
+}
+
+func TestAudioMetadataExtractorPlugin(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       AudioMetadataExtractorConfig
+		path         string
+		filename     string
+		size         int
+		language     string
+		wantDuration string
+		wantLanguage string
+	}{
+		{
+			name:         "mp3 transcription",
+			path:         "/v1/audio/transcriptions",
+			filename:     "meeting.mp3",
+			size:         160000, // 10s at 128kbps
+			language:     "de",
+			wantDuration: "10",
+			wantLanguage: "de",
+		},
+		{
+			name:         "wav translation rounds up",
+			path:         "/v1/audio/translations?x=1",
+			filename:     "clip.WAV",
+			size:         176400, // 1s at 1411kbps is 176375 bytes
+			wantDuration: "2",
+		},
+		{
+			name:         "unknown format uses the default bitrate",
+			config:       AudioMetadataExtractorConfig{DefaultBitrateKbps: 64},
+			path:         "/v1/audio/transcriptions",
+			filename:     "voice.amr",
+			size:         80000,
+			wantDuration: "10",
+		},
+		{
+			name:         "configured bitrate overrides the typical bitrate",
+			config:       AudioMetadataExtractorConfig{BitratesKbps: map[string]float64{"MP3": 64}},
+			path:         "/v1/audio/transcriptions",
+			filename:     "meeting.mp3",
+			size:         160000,
+			wantDuration: "20",
+		},
+		{
+			name:     "other paths are ignored",
+			path:     "/v1/chat/completions",
+			filename: "meeting.mp3",
+			size:     160000,
+			language: "de",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.config.DefaultBitrateKbps == 0 {
+				tt.config.DefaultBitrateKbps = defaultBitrateKbps
+			}
+			p, err := NewAudioMetadataExtractorPlugin(tt.config)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			body, contentType := newAudioForm(t, tt.filename, tt.size, tt.language)
+			request := framework.NewInferenceRequest()
+			request.Headers[framework.PathHeader] = tt.path
+			request.Headers["content-type"] = contentType
+			cycleState := framework.NewCycleState()
+			cycleState.Write(framework.RequestBodyBytesKey, body)
+
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.GetHeader(DurationEstimateHeader); got != tt.wantDuration {
+				t.Errorf("%s = %q, want %q", DurationEstimateHeader, got, tt.wantDuration)
+			}
+			if got := request.GetHeader(LanguageHeader); got != tt.wantLanguage {
+				t.Errorf("%s = %q, want %q", LanguageHeader, got, tt.wantLanguage)
+			}
+		})
+	}
+}
+
+func TestAudioMetadataExtractorPluginMalformedForm(t *testing.T) {
+	p, err := NewAudioMetadataExtractorPlugin(AudioMetadataExtractorConfig{DefaultBitrateKbps: defaultBitrateKbps})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	request := framework.NewInferenceRequest()
+	request.Headers[framework.PathHeader] = "/v1/audio/transcriptions"
+	request.Headers["content-type"] = "multipart/form-data; boundary=xyz"
+	cycleState := framework.NewCycleState()
+	cycleState.Write(framework.RequestBodyBytesKey, []byte("not a form"))
+
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := request.GetHeader(DurationEstimateHeader); got != "" {
+		t.Errorf("%s = %q, want it unset", DurationEstimateHeader, got)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authgate
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	AuthenticationGatePluginType = "authentication-gate"
+
+	authorizationHeader   = "Authorization"
+	apiKeyHeader          = "X-API-Key"
+	wwwAuthenticateHeader = "WWW-Authenticate"
+	bearerChallenge       = "Bearer"
+)
+
+// DefaultBypassPaths are the health check paths that skip authentication when no bypass paths are configured.
+var DefaultBypassPaths = []string{"/health", "/healthz", "/livez", "/readyz"}
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor = &AuthenticationGatePlugin{}
+	_ framework.RequestPlanner   = &AuthenticationGatePlugin{}
+)
+
+// AuthenticationGateConfig defines the JSON configuration structure for the plugin.
+type AuthenticationGateConfig struct {
+	// BypassPaths are the request paths that skip authentication, e.g. health checks. Defaults to DefaultBypassPaths.
+	BypassPaths []string `json:"bypass_paths"`
+	// APIKeys optionally restricts the accepted X-API-Key values. When empty, any non-empty API key is accepted.
+	APIKeys []string `json:"api_keys"`
+}
+
+// AuthenticationGatePluginFactory defines the factory function for NewAuthenticationGatePlugin.
+func AuthenticationGatePluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := AuthenticationGateConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", AuthenticationGatePluginType, err)
+		}
+	}
+
+	return NewAuthenticationGatePlugin(config.BypassPaths, config.APIKeys).WithName(name), nil
+}
+
+// NewAuthenticationGatePlugin initializes a new AuthenticationGatePlugin and returns its pointer.
+// Empty bypassPaths default to DefaultBypassPaths.
+func NewAuthenticationGatePlugin(bypassPaths []string, apiKeys []string) *AuthenticationGatePlugin {
+	if len(bypassPaths) == 0 {
+		bypassPaths = DefaultBypassPaths
+	}
+
+	bypass := make(map[string]bool, len(bypassPaths))
+	for _, path := range bypassPaths {
+		bypass[path] = true
+	}
+
+	return &AuthenticationGatePlugin{
+		typedName: plugin.TypedName{
+			Type: AuthenticationGatePluginType,
+			Name: AuthenticationGatePluginType,
+		},
+		bypassPaths: bypass,
+		apiKeys:     apiKeys,
+	}
+}
+
+// AuthenticationGatePlugin rejects unauthenticated requests with HTTP 401 before the other plugins run,
+// so that they fail fast without running expensive plugins. A request is authenticated if it carries a
+// non-empty Authorization header or an accepted X-API-Key header. The credentials themselves are
+// validated by the plugins or the backends down the chain, e.g. the JWKS validation plugin.
+type AuthenticationGatePlugin struct {
+	typedName   plugin.TypedName
+	bypassPaths map[string]bool
+	apiKeys     []string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *AuthenticationGatePlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *AuthenticationGatePlugin) WithName(name string) *AuthenticationGatePlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if it carries no credentials.
+func (p *AuthenticationGatePlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	if p.authenticated(request) {
+		return nil
+	}
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected unauthenticated request", "path", requestPath(request))
+	return errcommon.ErrorWithHeaders{
+		Err:     errcommon.Error{Code: errcommon.Unauthorized, Msg: "authentication is required"},
+		Headers: map[string]string{wwwAuthenticateHeader: bearerChallenge},
+	}
+}
+
+// PlanRequest predicts that the plugin rejects the request if it carries no credentials.
+func (p *AuthenticationGatePlugin) PlanRequest(_ context.Context, request *framework.InferenceRequest) framework.RequestPlan {
+	if request == nil || request.Headers == nil {
+		return framework.RequestPlan{}
+	}
+	return framework.RequestPlan{Fires: !p.authenticated(request)}
+}
+
+// authenticated returns whether the request carries credentials, or its path skips authentication.
+func (p *AuthenticationGatePlugin) authenticated(request *framework.InferenceRequest) bool {
+	if p.bypassPaths[requestPath(request)] {
+		return true
+	}
+	return strings.TrimSpace(request.GetHeader(authorizationHeader)) != "" || p.validAPIKey(request.GetHeader(apiKeyHeader))
+}
+
+// requestPath returns the path of the request, without the query.
+func requestPath(request *framework.InferenceRequest) string {
+	path, _, _ := strings.Cut(request.GetHeader(framework.PathHeader), "?")
+	return path
+}
+
+// validAPIKey returns whether the API key is accepted.
+func (p *AuthenticationGatePlugin) validAPIKey(apiKey string) bool {
+	if apiKey == "" {
+		return false
+	}
+	if len(p.apiKeys) == 0 {
+		return true
+	}
+	for _, accepted := range p.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(accepted)) == 1 {
+			return true
+		}
+	}
+	return false
+}
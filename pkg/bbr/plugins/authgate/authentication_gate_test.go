@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authgate
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestAuthenticationGatePluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "valid config", rawParams: json.RawMessage(`{"bypass_paths":["/ping"],"api_keys":["key-1"]}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := AuthenticationGatePluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestAuthenticationGatePlugin(t *testing.T) {
+	tests := []struct {
+		name    string
+		apiKeys []string
+		headers map[string]string
+		wantErr bool
+	}{
+		{name: "authorization header", headers: map[string]string{":path": "/v1/chat/completions", "authorization": "Bearer token"}},
+		{name: "api key", headers: map[string]string{":path": "/v1/chat/completions", "x-api-key": "key-1"}},
+		{name: "accepted api key", apiKeys: []string{"key-1"}, headers: map[string]string{":path": "/v1/chat/completions", "x-api-key": "key-1"}},
+		{name: "unknown api key", apiKeys: []string{"key-1"}, headers: map[string]string{":path": "/v1/chat/completions", "x-api-key": "key-2"}, wantErr: true},
+		{name: "no credentials", headers: map[string]string{":path": "/v1/chat/completions"}, wantErr: true},
+		{name: "blank authorization header", headers: map[string]string{":path": "/v1/completions", "authorization": " "}, wantErr: true},
+		{name: "health check bypasses authentication", headers: map[string]string{":path": "/healthz?verbose=1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewAuthenticationGatePlugin(nil, tt.apiKeys)
+			request := framework.NewInferenceRequest()
+			for key, value := range tt.headers {
+				request.Headers[key] = value
+			}
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != errcommon.Unauthorized {
+				t.Errorf("CanonicalCode = %q, want %q", got, errcommon.Unauthorized)
+			}
+			withHeaders, ok := err.(errcommon.ErrorWithHeaders)
+			if !ok {
+				t.Fatalf("error %T does not carry headers", err)
+			}
+			if got := withHeaders.Headers["WWW-Authenticate"]; got != "Bearer" {
+				t.Errorf("WWW-Authenticate = %q, want %q", got, "Bearer")
+			}
+		})
+	}
+}
+
+func TestAuthenticationGatePluginPlanRequest(t *testing.T) {
+	p := NewAuthenticationGatePlugin(nil, nil)
+	tests := []struct {
+		name      string
+		headers   map[string]string
+		wantFires bool
+	}{
+		{name: "no credentials", headers: map[string]string{}, wantFires: true},
+		{name: "bearer token", headers: map[string]string{"authorization": "Bearer token"}},
+		{name: "health check path", headers: map[string]string{framework.PathHeader: "/healthz"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Headers = tt.headers
+			if got := p.PlanRequest(context.Background(), request).Fires; got != tt.wantFires {
+				t.Errorf("Fires = %v, want %v", got, tt.wantFires)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuremodel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bodyfieldtoheader"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	AzureModelExtractorPluginType = "azure-model-extractor"
+
+	// deploymentsPathPrefix is the prefix of the Azure OpenAI paths, e.g.
+	// /openai/deployments/{deployment-id}/chat/completions.
+	deploymentsPathPrefix = "/openai/deployments/"
+	modelField            = "model"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &AzureModelExtractorPlugin{}
+
+// AzureModelExtractorConfig defines the JSON configuration structure for the plugin.
+type AzureModelExtractorConfig struct {
+	// DeploymentModels maps deployment ids to the names of the models they serve. Deployments that are not
+	// mapped serve the model named after the deployment id.
+	DeploymentModels map[string]string `json:"deployment_models"`
+}
+
+// AzureModelExtractorPluginFactory defines the factory function for NewAzureModelExtractorPlugin.
+func AzureModelExtractorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config AzureModelExtractorConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", AzureModelExtractorPluginType, err)
+		}
+	}
+
+	plugin, err := NewAzureModelExtractorPlugin(config.DeploymentModels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", AzureModelExtractorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewAzureModelExtractorPlugin initializes a new AzureModelExtractorPlugin and returns its pointer.
+func NewAzureModelExtractorPlugin(deploymentModels map[string]string) (*AzureModelExtractorPlugin, error) {
+	for deployment, model := range deploymentModels {
+		if deployment == "" || model == "" {
+			return nil, errors.New("deployment_models must map deployment ids to model names in AzureModelExtractor plugin")
+		}
+	}
+
+	return &AzureModelExtractorPlugin{
+		typedName: plugin.TypedName{
+			Type: AzureModelExtractorPluginType,
+			Name: AzureModelExtractorPluginType,
+		},
+		deploymentModels: deploymentModels,
+	}, nil
+}
+
+// AzureModelExtractorPlugin routes requests of the Azure OpenAI API, whose model is identified by the
+// deployment id in the path, e.g. /openai/deployments/{deployment-id}/chat/completions, rather than by the
+// model field of the body, which Azure ignores and clients often omit. It sets the model of the deployment
+// as the X-Gateway-Model-Name header and as the model field of the body, so that the plugins and backends
+// reading the model from the body agree with the deployment.
+type AzureModelExtractorPlugin struct {
+	typedName        plugin.TypedName
+	deploymentModels map[string]string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *AzureModelExtractorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *AzureModelExtractorPlugin) WithName(name string) *AzureModelExtractorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the model of the deployment in the path of Azure OpenAI requests.
+func (p *AzureModelExtractorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	path, _, _ := strings.Cut(request.GetHeader(framework.PathHeader), "?")
+	rest, ok := strings.CutPrefix(path, deploymentsPathPrefix)
+	if !ok {
+		return nil
+	}
+	rawDeployment, _, _ := strings.Cut(rest, "/")
+	deployment, err := url.PathUnescape(rawDeployment)
+	if err != nil || deployment == "" {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("invalid Azure OpenAI deployment in path '%s'", path)}
+	}
+
+	model := deployment
+	if mapped, ok := p.deploymentModels[deployment]; ok {
+		model = mapped
+	}
+
+	request.SetHeader(bodyfieldtoheader.ModelHeader, model)
+	if request.Body[modelField] != model {
+		request.SetBodyField(modelField, model)
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("extracted model from Azure OpenAI deployment", "deployment", deployment, "model", model)
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azuremodel
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bodyfieldtoheader"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestAzureModelExtractorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "default config",
+			rawParams: nil,
+		},
+		{
+			name:      "valid deployment models",
+			rawParams: json.RawMessage(`{"deployment_models":{"gpt4-prod":"gpt-4"}}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "empty model",
+			rawParams: json.RawMessage(`{"deployment_models":{"gpt4-prod":""}}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := AzureModelExtractorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestAzureModelExtractorPlugin(t *testing.T) {
+	tests := []struct {
+		name        string
+		path        string
+		body        map[string]any
+		wantCode    string
+		wantHeaders map[string]string
+		wantBody    map[string]any
+	}{
+		{
+			name:        "deployment without model in body",
+			path:        "/openai/deployments/llama-3/chat/completions?api-version=2024-02-01",
+			body:        map[string]any{"messages": []any{}},
+			wantHeaders: map[string]string{bodyfieldtoheader.ModelHeader: "llama-3"},
+			wantBody:    map[string]any{"messages": []any{}, "model": "llama-3"},
+		},
+		{
+			name:        "mapped deployment overrides the model in body",
+			path:        "/openai/deployments/gpt4-prod/chat/completions",
+			body:        map[string]any{"model": "other"},
+			wantHeaders: map[string]string{bodyfieldtoheader.ModelHeader: "gpt-4"},
+			wantBody:    map[string]any{"model": "gpt-4"},
+		},
+		{
+			name:        "escaped deployment",
+			path:        "/openai/deployments/my%20model/completions",
+			body:        map[string]any{},
+			wantHeaders: map[string]string{bodyfieldtoheader.ModelHeader: "my model"},
+			wantBody:    map[string]any{"model": "my model"},
+		},
+		{
+			name:        "OpenAI path is ignored",
+			path:        "/v1/chat/completions",
+			body:        map[string]any{"model": "m"},
+			wantHeaders: map[string]string{},
+			wantBody:    map[string]any{"model": "m"},
+		},
+		{
+			name:     "missing deployment",
+			path:     "/openai/deployments//chat/completions",
+			body:     map[string]any{},
+			wantCode: errcommon.BadRequest,
+		},
+	}
+	p, err := NewAzureModelExtractorPlugin(map[string]string{"gpt4-prod": "gpt-4"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Headers[framework.PathHeader] = tt.path
+			request.Body = tt.body
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if errcommon.CanonicalCode(err) != tt.wantCode {
+					t.Fatalf("expected %s, got %v", tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantHeaders, request.MutatedHeaders()); diff != "" {
+				t.Errorf("unexpected headers (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package b3propagation
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	B3TracePropagationPluginType = "b3-trace-propagation"
+
+	TraceIDHeader      = "X-B3-TraceId"
+	SpanIDHeader       = "X-B3-SpanId"
+	ParentSpanIDHeader = "X-B3-ParentSpanId"
+	SampledHeader      = "X-B3-Sampled"
+	FlagsHeader        = "X-B3-Flags"
+
+	// shortTraceIDLength is the length of 64-bit B3 trace ids, which are padded to 128 bits.
+	shortTraceIDLength = 16
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &B3TracePropagationPlugin{}
+
+// B3TracePropagationPluginFactory defines the factory function for NewB3TracePropagationPlugin.
+func B3TracePropagationPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewB3TracePropagationPlugin().WithName(name), nil
+}
+
+// NewB3TracePropagationPlugin initializes a new B3TracePropagationPlugin and returns its pointer.
+func NewB3TracePropagationPlugin() *B3TracePropagationPlugin {
+	return &B3TracePropagationPlugin{
+		typedName: plugin.TypedName{
+			Type: B3TracePropagationPluginType,
+			Name: B3TracePropagationPluginType,
+		},
+		tracer: otel.Tracer("gateway-api-inference-extension/bbr/b3-propagation"),
+	}
+}
+
+// B3TracePropagationPlugin joins BBR to the traces of clients that use Zipkin B3 tracing. It converts the
+// X-B3-* request headers into an OpenTelemetry span context, records a BBR span as a child of the client
+// span, and forwards the B3 headers to the backend with the BBR span as the parent, so the trace spans
+// client, BBR and backend. Only the multi-header B3 encoding is supported.
+type B3TracePropagationPlugin struct {
+	typedName plugin.TypedName
+	tracer    trace.Tracer
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *B3TracePropagationPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *B3TracePropagationPlugin) WithName(name string) *B3TracePropagationPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest continues the B3 trace of the request, if any, and forwards it to the backend.
+func (p *B3TracePropagationPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	remote, ok := extractSpanContext(request)
+	if !ok {
+		return nil
+	}
+
+	ctx = trace.ContextWithRemoteSpanContext(ctx, remote)
+	_, span := p.tracer.Start(ctx, "gateway.bbr.b3-propagation", trace.WithSpanKind(trace.SpanKindInternal))
+	defer span.End()
+
+	current := span.SpanContext()
+	request.SetHeader(TraceIDHeader, current.TraceID().String())
+	request.SetHeader(SpanIDHeader, current.SpanID().String())
+	if current.SpanID() != remote.SpanID() {
+		request.SetHeader(ParentSpanIDHeader, remote.SpanID().String())
+	}
+	if current.IsSampled() {
+		request.SetHeader(SampledHeader, "1")
+	} else {
+		request.SetHeader(SampledHeader, "0")
+	}
+
+	log.FromContext(ctx).V(logutil.DEBUG).Info("propagating B3 trace", "traceID", current.TraceID(), "spanID", current.SpanID())
+	return nil
+}
+
+// extractSpanContext returns the remote span context carried by the X-B3-* headers of the request.
+func extractSpanContext(request *framework.InferenceRequest) (trace.SpanContext, bool) {
+	rawTraceID := strings.ToLower(request.GetHeader(TraceIDHeader))
+	if len(rawTraceID) == shortTraceIDLength {
+		rawTraceID = strings.Repeat("0", shortTraceIDLength) + rawTraceID
+	}
+	traceID, err := trace.TraceIDFromHex(rawTraceID)
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	spanID, err := trace.SpanIDFromHex(strings.ToLower(request.GetHeader(SpanIDHeader)))
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+
+	var flags trace.TraceFlags
+	// the debug flag implies sampling
+	if sampled := request.GetHeader(SampledHeader); sampled == "1" || sampled == "true" || request.GetHeader(FlagsHeader) == "1" {
+		flags = trace.FlagsSampled
+	}
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: flags,
+		Remote:     true,
+	})
+	return spanContext, spanContext.IsValid()
+}
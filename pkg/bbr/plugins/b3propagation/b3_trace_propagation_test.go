@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package b3propagation
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+const (
+	clientTraceID = "463ac35c9f6413ad48485a3953bb6124"
+	clientSpanID  = "a2fb4a1d1a96d312"
+)
+
+func TestB3TracePropagationPluginFactory(t *testing.T) {
+	p, err := B3TracePropagationPluginFactory("my-plugin", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.TypedName().Name; got != "my-plugin" {
+		t.Errorf("Name = %q, want %q", got, "my-plugin")
+	}
+}
+
+func TestB3TracePropagationPlugin(t *testing.T) {
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		wantRecord  bool
+		wantTraceID string
+		wantSampled string
+	}{
+		{
+			name:        "sampled trace",
+			headers:     map[string]string{"x-b3-traceid": clientTraceID, "x-b3-spanid": clientSpanID, "x-b3-sampled": "1"},
+			wantRecord:  true,
+			wantTraceID: clientTraceID,
+			wantSampled: "1",
+		},
+		{
+			name:        "64-bit trace id is padded",
+			headers:     map[string]string{"x-b3-traceid": "48485a3953bb6124", "x-b3-spanid": clientSpanID, "x-b3-sampled": "1"},
+			wantRecord:  true,
+			wantTraceID: "000000000000000048485a3953bb6124",
+			wantSampled: "1",
+		},
+		{
+			name:        "unsampled trace is propagated but not recorded",
+			headers:     map[string]string{"x-b3-traceid": clientTraceID, "x-b3-spanid": clientSpanID, "x-b3-sampled": "0"},
+			wantTraceID: clientTraceID,
+			wantSampled: "0",
+		},
+		{
+			name:        "debug flag implies sampling",
+			headers:     map[string]string{"x-b3-traceid": clientTraceID, "x-b3-spanid": clientSpanID, "x-b3-flags": "1"},
+			wantRecord:  true,
+			wantTraceID: clientTraceID,
+			wantSampled: "1",
+		},
+		{
+			name:    "no B3 headers",
+			headers: map[string]string{},
+		},
+		{
+			name:    "invalid span id",
+			headers: map[string]string{"x-b3-traceid": clientTraceID, "x-b3-spanid": "not-hex"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			p := NewB3TracePropagationPlugin()
+			p.tracer = provider.Tracer("test")
+
+			request := framework.NewInferenceRequest()
+			for key, value := range tt.headers {
+				request.Headers[key] = value
+			}
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantTraceID == "" {
+				if len(request.MutatedHeaders()) != 0 {
+					t.Errorf("mutated headers = %v, want none", request.MutatedHeaders())
+				}
+				return
+			}
+
+			spanID := request.GetHeader(SpanIDHeader)
+			if spanID == clientSpanID {
+				t.Errorf("%s was not replaced by the BBR span id", SpanIDHeader)
+			}
+			spans := recorder.Ended()
+			if !tt.wantRecord {
+				if len(spans) != 0 {
+					t.Errorf("got %d recorded spans, want none", len(spans))
+				}
+			} else if len(spans) != 1 {
+				t.Fatalf("got %d recorded spans, want 1", len(spans))
+			} else {
+				if got := spans[0].Parent().SpanID().String(); got != clientSpanID {
+					t.Errorf("parent span id = %q, want %q", got, clientSpanID)
+				}
+				if got := spans[0].SpanContext().SpanID().String(); got != spanID {
+					t.Errorf("%s = %q, want the BBR span id %q", SpanIDHeader, spanID, got)
+				}
+			}
+
+			wantHeaders := map[string]string{
+				TraceIDHeader:      tt.wantTraceID,
+				ParentSpanIDHeader: clientSpanID,
+				SampledHeader:      tt.wantSampled,
+			}
+			for key, want := range wantHeaders {
+				if got := request.GetHeader(key); got != want {
+					t.Errorf("%s = %q, want %q", key, got, want)
+				}
+			}
+		})
+	}
+}
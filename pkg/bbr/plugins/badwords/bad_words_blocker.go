@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package badwords
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	BadWordsBlockerPluginType = "bad-words-blocker"
+	// BadWordsEnvVar is the environment variable with the comma-separated list of blocked words, which
+	// are added to the configured ones.
+	BadWordsEnvVar = "BAD_WORDS"
+
+	userRole = "user"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &BadWordsBlockerPlugin{}
+
+// BadWordsBlockerConfig defines the JSON configuration structure for the plugin.
+type BadWordsBlockerConfig struct {
+	// Words are the blocked words or phrases, in addition to the ones of the BAD_WORDS environment variable.
+	Words []string `json:"words"`
+}
+
+// BadWordsBlockerPluginFactory defines the factory function for NewBadWordsBlockerPlugin.
+func BadWordsBlockerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := BadWordsBlockerConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", BadWordsBlockerPluginType, err)
+		}
+	}
+
+	words := append(config.Words, strings.Split(os.Getenv(BadWordsEnvVar), ",")...)
+	plugin, err := NewBadWordsBlockerPlugin(words)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BadWordsBlockerPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewBadWordsBlockerPlugin initializes a new BadWordsBlockerPlugin and returns its pointer.
+// Empty words are ignored.
+func NewBadWordsBlockerPlugin(words []string) (*BadWordsBlockerPlugin, error) {
+	blocked := make([]string, 0, len(words))
+	for _, word := range words {
+		if normalized := normalize(word); normalized != "" {
+			blocked = append(blocked, normalized)
+		}
+	}
+	if len(blocked) == 0 {
+		return nil, errors.New("at least one word is required in BadWordsBlocker plugin")
+	}
+
+	return &BadWordsBlockerPlugin{
+		typedName: plugin.TypedName{
+			Type: BadWordsBlockerPluginType,
+			Name: BadWordsBlockerPluginType,
+		},
+		words: blocked,
+	}, nil
+}
+
+// BadWordsBlockerPlugin rejects requests whose user messages, or completion prompt, contain a blocked word
+// or phrase with HTTP 400. Words are matched case-insensitively on whole words, after Unicode compatibility
+// normalization and removal of diacritics, so that e.g. full-width letters or accents do not evade the list.
+type BadWordsBlockerPlugin struct {
+	typedName plugin.TypedName
+	// words are the normalized blocked words and phrases.
+	words []string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *BadWordsBlockerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *BadWordsBlockerPlugin) WithName(name string) *BadWordsBlockerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if any of the user's messages contains a blocked word.
+func (p *BadWordsBlockerPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	for _, text := range userTexts(request.Body) {
+		// pad with spaces, so that only whole words and phrases match
+		normalized := " " + normalize(text) + " "
+		for _, word := range p.words {
+			if strings.Contains(normalized, " "+word+" ") {
+				log.FromContext(ctx).V(logutil.VERBOSE).Info("blocked a request containing a bad word")
+				return contentPolicyViolation()
+			}
+		}
+	}
+	return nil
+}
+
+// contentPolicyViolation returns the HTTP 400 response rejecting a request containing blocked words.
+func contentPolicyViolation() error {
+	body, _ := json.Marshal(map[string]string{
+		"error":  "content_policy_violation",
+		"detail": "the request contains blocked words",
+	})
+	return errcommon.ImmediateResponse{
+		Code:    errcommon.BadRequest,
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    body,
+	}
+}
+
+// normalize returns the lower-case words of the text separated by single spaces, after NFKD normalization
+// and removal of the combining marks.
+func normalize(text string) string {
+	decomposed := norm.NFKD.String(text)
+	var b strings.Builder
+	for _, r := range decomposed {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return strings.Join(strings.FieldsFunc(b.String(), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+// userTexts returns the texts of the user messages of chat completion requests, or the prompt of completion requests.
+func userTexts(body map[string]any) []string {
+	return messagetext.Request(body, userRole)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package badwords
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestBadWordsBlockerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		env       string
+		wantErr   bool
+	}{
+		{name: "configured words", rawParams: json.RawMessage(`{"words":["darn"]}`)},
+		{name: "environment words", env: "darn, heck"},
+		{name: "no words", rawParams: nil, wantErr: true},
+		{name: "only empty words", rawParams: json.RawMessage(`{"words":[" ", "!!"]}`), env: ",", wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), env: "darn", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(BadWordsEnvVar, tt.env)
+			p, err := BadWordsBlockerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestBadWordsBlockerPluginFactoryEnvironment(t *testing.T) {
+	t.Setenv(BadWordsEnvVar, "heck,gosh darn")
+	p, err := BadWordsBlockerPluginFactory("my-plugin", json.RawMessage(`{"words":["darn"]}`), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := p.(*BadWordsBlockerPlugin).words, []string{"darn", "heck", "gosh darn"}; !slices.Equal(got, want) {
+		t.Errorf("words = %q, want %q", got, want)
+	}
+}
+
+func TestBadWordsBlockerPlugin(t *testing.T) {
+	userMessage := func(content any) map[string]any {
+		return map[string]any{"messages": []any{map[string]any{"role": "user", "content": content}}}
+	}
+	tests := []struct {
+		name     string
+		body     map[string]any
+		wantCode string
+	}{
+		{
+			name:     "exact word",
+			body:     userMessage("well darn it"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "different case",
+			body:     userMessage("Well DARN it"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "full-width letters",
+			body:     userMessage("well ｄａｒｎ it"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "diacritics",
+			body:     userMessage("well dárn it"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "decomposed diacritics",
+			body:     userMessage("well da\u0301rn it"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "punctuation around the word",
+			body:     userMessage("Well... darn!"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "phrase across whitespace",
+			body:     userMessage("what the  Blazes\nwas that"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name: "text part",
+			body: userMessage([]any{
+				map[string]any{"type": "text", "text": "Darn."},
+			}),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "completion prompt",
+			body:     map[string]any{"prompt": "darn"},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name: "word inside another word is allowed",
+			body: userMessage("a darnation of socks"),
+		},
+		{
+			name: "word in the system prompt is allowed",
+			body: map[string]any{"messages": []any{
+				map[string]any{"role": "system", "content": "Never say darn."},
+				map[string]any{"role": "user", "content": "What is the capital of France?"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewBadWordsBlockerPlugin([]string{"Darn", "the blazes"})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			err = p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				var immediate errcommon.ImmediateResponse
+				if !errors.As(err, &immediate) {
+					t.Fatalf("expected an immediate response, got %v", err)
+				}
+				var body map[string]string
+				if err := json.Unmarshal(immediate.Body, &body); err != nil {
+					t.Fatalf("body should be JSON, got %s: %v", immediate.Body, err)
+				}
+				if got := body["error"]; got != "content_policy_violation" {
+					t.Errorf("error = %q, want %q", got, "content_policy_violation")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -45,6 +45,12 @@ type AdaptersStore interface {
 	configMapUpdateOrAddIfNotExist(configmap *corev1.ConfigMap) error
 	configMapDelete(configmap *corev1.ConfigMap)
 	getBaseModel(modelName string) string
+	// registerAlias maps an alias to a canonical model name, which may be another alias.
+	// It returns an error if the mapping would create a cycle of aliases.
+	registerAlias(alias string, canonical string) error
+	// resolveAlias follows the aliases of the given model name, up to maxDepth of them, and returns the name
+	// reached. A name that is not an alias is returned as is.
+	resolveAlias(modelName string, maxDepth int) string
 }
 
 // NewAdaptersStore creates a new adapters store.
@@ -53,6 +59,7 @@ func NewAdaptersStore() AdaptersStore {
 		loraAdapterToBaseModel: map[string]string{},
 		configmapAdapters:      map[types.NamespacedName]sets.Set[string]{},
 		baseModels:             map[string]int{},
+		aliases:                map[string]string{},
 		lock:                   sync.RWMutex{},
 	}
 }
@@ -61,6 +68,7 @@ type adaptersStoreImpl struct {
 	loraAdapterToBaseModel map[string]string                         // a mapping between a lora adapter and its corresponding base model
 	configmapAdapters      map[types.NamespacedName]sets.Set[string] // map from configmap namespaced name to its adapters
 	baseModels             map[string]int                            // base model with a counter of its configmaps
+	aliases                map[string]string                         // a mapping between an alias and its canonical model name
 	lock                   sync.RWMutex
 }
 
@@ -147,6 +155,38 @@ func (adaptersStore *adaptersStoreImpl) getBaseModel(modelName string) string {
 	return ""
 }
 
+func (adaptersStore *adaptersStoreImpl) registerAlias(alias string, canonical string) error {
+	trimmedAlias, trimmedCanonical := strings.TrimSpace(alias), strings.TrimSpace(canonical)
+	if trimmedAlias == "" || trimmedCanonical == "" {
+		return errors.New("alias and canonical model name cannot be empty")
+	}
+
+	adaptersStore.lock.Lock()
+	defer adaptersStore.lock.Unlock()
+	// the registered aliases have no cycles, so following the aliases of the canonical name terminates
+	for name, ok := trimmedCanonical, true; ok; name, ok = adaptersStore.aliases[name] {
+		if name == trimmedAlias {
+			return fmt.Errorf("alias '%s' of '%s' creates a cycle of aliases", trimmedAlias, trimmedCanonical)
+		}
+	}
+	adaptersStore.aliases[trimmedAlias] = trimmedCanonical
+	return nil
+}
+
+func (adaptersStore *adaptersStoreImpl) resolveAlias(modelName string, maxDepth int) string {
+	name := strings.TrimSpace(modelName)
+	adaptersStore.lock.RLock()
+	defer adaptersStore.lock.RUnlock()
+	for range maxDepth {
+		canonical, ok := adaptersStore.aliases[name]
+		if !ok {
+			break
+		}
+		name = canonical
+	}
+	return name
+}
+
 // parseConfigMap returns a tuple consisting (base model, set of adapters, error)
 // error is set in case the configmap data section is not in the expected format.
 func (adaptersStore *adaptersStoreImpl) parseConfigMap(configmap *corev1.ConfigMap) (string, sets.Set[string], error) {
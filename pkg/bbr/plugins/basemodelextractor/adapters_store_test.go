@@ -235,3 +235,57 @@ func TestConfigMapDelete_BaseModelCount(t *testing.T) {
 		}
 	}
 }
+
+func TestRegisterAlias(t *testing.T) {
+	as := NewAdaptersStore()
+	if err := as.registerAlias("gpt4", baseModel); err != nil {
+		t.Fatalf("registerAlias() error = %v", err)
+	}
+	if err := as.registerAlias("gpt4-turbo", "gpt4"); err != nil {
+		t.Fatalf("registerAlias() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		alias     string
+		canonical string
+	}{
+		{"empty alias", " ", baseModel},
+		{"empty canonical", "gpt5", ""},
+		{"alias of itself", "gpt5", "gpt5"},
+		{"cycle of aliases", baseModel, "gpt4-turbo"},
+	}
+	for _, tt := range tests {
+		if err := as.registerAlias(tt.alias, tt.canonical); err == nil {
+			t.Errorf("%s: registerAlias(%q, %q) expected error, got nil", tt.name, tt.alias, tt.canonical)
+		}
+	}
+}
+
+func TestResolveAlias(t *testing.T) {
+	as := NewAdaptersStore()
+	for alias, canonical := range map[string]string{"a": "b", "b": "c", "c": baseModel} {
+		if err := as.registerAlias(alias, canonical); err != nil {
+			t.Fatalf("registerAlias() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		maxDepth int
+		want     string
+	}{
+		{"not an alias", baseModel, 5, baseModel},
+		{"single alias", "c", 5, baseModel},
+		{"alias of alias", "a", 5, baseModel},
+		{"trimmed alias", " a ", 5, baseModel},
+		{"capped at max depth", "a", 2, "c"},
+		{"zero max depth", "a", 0, "a"},
+	}
+	for _, tt := range tests {
+		if got := as.resolveAlias(tt.input, tt.maxDepth); got != tt.want {
+			t.Errorf("%s: resolveAlias(%q, %d) = %q, want %q", tt.name, tt.input, tt.maxDepth, got, tt.want)
+		}
+	}
+}
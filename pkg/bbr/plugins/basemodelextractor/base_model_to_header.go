@@ -19,7 +19,11 @@ package basemodelextractor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -27,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bodyfieldtoheader"
 	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 )
@@ -34,25 +39,57 @@ import (
 const (
 	BaseModelToHeaderPluginType = "base-model-to-header"
 	BaseModelHeader             = "X-Gateway-Base-Model-Name"
+	FallbackHeader              = "X-Gateway-Fallback"
+	OriginalModelHeader         = "X-Gateway-Original-Model"
 	modelField                  = "model"
+
+	defaultMaxAliasDepth = 5
 )
 
 // compile-time type validation
 var _ framework.RequestProcessor = &BaseModelToHeaderPlugin{}
+var _ framework.RequestPlanner = &BaseModelToHeaderPlugin{}
 
 type BaseModelToHeaderPlugin struct {
 	typedName     plugin.TypedName
 	AdaptersStore AdaptersStore
+	// maxAliasDepth is the maximal number of aliases followed to resolve the model of a request.
+	maxAliasDepth int
+	// fallbacks are the models tried in order when the model of a request is unknown.
+	fallbacks []string
+}
+
+type baseModelToHeaderConfig struct {
+	// Aliases map friendly model names to canonical model names, which may be aliases themselves.
+	Aliases       map[string]string `json:"aliases"`
+	MaxAliasDepth int               `json:"max_alias_depth"`
+	Fallbacks     []string          `json:"fallbacks"`
 }
 
 // BaseModelToHeaderPluginFactory defines the factory function for BaseModelToHeaderPlugin
-func BaseModelToHeaderPluginFactory(name string, _ json.RawMessage, handle framework.Handle) (framework.BBRPlugin, error) {
+func BaseModelToHeaderPluginFactory(name string, rawParameters json.RawMessage, handle framework.Handle) (framework.BBRPlugin, error) {
+	config := baseModelToHeaderConfig{MaxAliasDepth: defaultMaxAliasDepth}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", BaseModelToHeaderPluginType, err)
+		}
+	}
+	if config.MaxAliasDepth <= 0 {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BaseModelToHeaderPluginType,
+			errors.New("max_alias_depth must be positive in BaseModelToHeader plugin"))
+	}
+
 	plugin, err := NewBaseModelToHeaderPlugin(handle.ReconcilerBuilder, handle.ClientReader())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create plugin '%s' - %w", BaseModelToHeaderPluginType, err)
 	}
+	for _, alias := range slices.Sorted(maps.Keys(config.Aliases)) {
+		if err := plugin.RegisterAlias(alias, config.Aliases[alias]); err != nil {
+			return nil, fmt.Errorf("failed to create plugin '%s' - %w", BaseModelToHeaderPluginType, err)
+		}
+	}
 
-	return plugin.WithName(name), nil
+	return plugin.WithMaxAliasDepth(config.MaxAliasDepth).WithFallbacks(config.Fallbacks).WithName(name), nil
 }
 
 // NewBaseModelToHeaderPlugin returns a *BaseModelToHeaderPlugin with an initialized AdaptersStore.
@@ -70,6 +107,7 @@ func NewBaseModelToHeaderPlugin(reconcilerBuilder func() *builder.Builder, clien
 	return &BaseModelToHeaderPlugin{
 		typedName:     plugin.TypedName{Type: BaseModelToHeaderPluginType, Name: BaseModelToHeaderPluginType},
 		AdaptersStore: adaptersStore,
+		maxAliasDepth: defaultMaxAliasDepth,
 	}, nil
 }
 
@@ -84,6 +122,30 @@ func (p *BaseModelToHeaderPlugin) WithName(name string) *BaseModelToHeaderPlugin
 	return p
 }
 
+// WithMaxAliasDepth sets the maximal number of aliases followed to resolve the model of a request.
+func (p *BaseModelToHeaderPlugin) WithMaxAliasDepth(depth int) *BaseModelToHeaderPlugin {
+	p.maxAliasDepth = depth
+	return p
+}
+
+// WithFallbacks sets the models tried in order when the model of a request is neither a LoRA adapter nor a
+// base model, e.g. because its backend is unavailable. The first fallback with a base model, with its aliases
+// resolved, replaces the model of the request, in the body and in the model header set by the
+// body-field-to-header plugin.
+func (p *BaseModelToHeaderPlugin) WithFallbacks(fallbacks []string) *BaseModelToHeaderPlugin {
+	p.fallbacks = fallbacks
+	return p
+}
+
+// RegisterAlias maps an alias, e.g. a friendly model name published by operators, to a canonical model name,
+// which may be another alias. Aliases are resolved before the base model lookup, so an alias shadows a model
+// of the same name, and the model of a request for an alias with a base model is replaced with its canonical
+// name, which the backends serve. Fallbacks are resolved the same way. It returns an error if the mapping would
+// create a cycle of aliases.
+func (p *BaseModelToHeaderPlugin) RegisterAlias(alias string, canonical string) error {
+	return p.AdaptersStore.registerAlias(alias, canonical)
+}
+
 // ProcessRequest sets base model name on the header
 func (p *BaseModelToHeaderPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
 	if request == nil || request.Headers == nil || request.Body == nil {
@@ -101,12 +163,66 @@ func (p *BaseModelToHeaderPlugin) ProcessRequest(ctx context.Context, _ *framewo
 
 	targetModel := fmt.Sprintf("%v", rawFieldValue) // convert any type to string
 
-	// Look up base model using configured AdaptersStore
+	// Resolve the aliases of the target model, and look up base model using configured AdaptersStore
 	// If baseModel is empty, it means the model is neither a LoRA adapter nor a registered base model
-	baseModel := p.AdaptersStore.getBaseModel(targetModel)
+	resolvedModel, baseModel, fallback := p.resolve(targetModel)
+	if baseModel != "" && resolvedModel != strings.TrimSpace(targetModel) {
+		// Route the request to the canonical model. The model header was set from the original model when
+		// body-field-to-header ran before this plugin.
+		request.SetBodyField(modelField, resolvedModel)
+		request.SetHeader(bodyfieldtoheader.ModelHeader, resolvedModel)
+	}
+	if fallback {
+		// Record that the request was routed to a fallback, and the original model of the request
+		request.SetHeader(FallbackHeader, "true")
+		request.SetHeader(OriginalModelHeader, targetModel)
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("target model is unknown, falling back", "targetModel", targetModel, "fallback", resolvedModel)
+	}
 
 	// Set model headers for routing (empty string is valid)
 	request.SetHeader(BaseModelHeader, baseModel)
-	log.FromContext(ctx).V(logutil.VERBOSE).Info("updated base model header based on the request target model", "targetModel", targetModel, "baseModel", baseModel)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("updated base model header based on the request target model", "targetModel", targetModel,
+		"resolvedModel", resolvedModel, "baseModel", baseModel)
 	return nil
 }
+
+// resolve returns the model the request for the target model is routed to, with its aliases resolved, and its
+// base model. When the target model has no base model, the first fallback with a base model is returned, and
+// whether it is a fallback. The base model is empty when neither the target model nor a fallback has one.
+func (p *BaseModelToHeaderPlugin) resolve(targetModel string) (string, string, bool) {
+	resolvedModel := p.AdaptersStore.resolveAlias(targetModel, p.maxAliasDepth)
+	if baseModel := p.AdaptersStore.getBaseModel(resolvedModel); baseModel != "" {
+		return resolvedModel, baseModel, false
+	}
+	for _, fallback := range p.fallbacks {
+		resolvedFallback := p.AdaptersStore.resolveAlias(fallback, p.maxAliasDepth)
+		if baseModel := p.AdaptersStore.getBaseModel(resolvedFallback); baseModel != "" {
+			return resolvedFallback, baseModel, true
+		}
+	}
+	return resolvedModel, "", false
+}
+
+// PlanRequest predicts that the plugin always sets the base model header, which is empty when the model is
+// unknown, and that it replaces an alias with its canonical model, and an unknown model with a fallback when
+// one is configured.
+func (p *BaseModelToHeaderPlugin) PlanRequest(_ context.Context, request *framework.InferenceRequest) framework.RequestPlan {
+	plan := framework.RequestPlan{RequiresBody: true}
+	if request == nil || request.Body == nil {
+		return plan
+	}
+	plan.Fires = true
+	plan.Headers = []string{BaseModelHeader}
+	if rawFieldValue, exists := request.Body[modelField]; exists {
+		targetModel := fmt.Sprintf("%v", rawFieldValue)
+		resolvedModel, baseModel, fallback := p.resolve(targetModel)
+		if baseModel != "" && resolvedModel != strings.TrimSpace(targetModel) {
+			plan.Headers = append(plan.Headers, bodyfieldtoheader.ModelHeader)
+			plan.BodyMutated = true
+		}
+		if fallback {
+			plan.Headers = append(plan.Headers, FallbackHeader, OriginalModelHeader)
+		}
+	}
+	return plan
+}
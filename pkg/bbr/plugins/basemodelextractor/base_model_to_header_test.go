@@ -29,6 +29,8 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/handlers"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/bodyfieldtoheader"
 	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
 )
 
@@ -104,6 +106,36 @@ func TestBaseModelToHeaderPluginFactory(t *testing.T) {
 			rawParams:  json.RawMessage(``),
 			wantName:   "my-plugin",
 		},
+		{
+			name:       "aliases",
+			pluginName: "my-plugin",
+			rawParams:  json.RawMessage(`{"aliases": {"gpt4-turbo": "gpt4", "gpt4": "llama-3-8b"}, "max_alias_depth": 3}`),
+			wantName:   "my-plugin",
+		},
+		{
+			name:       "fallbacks",
+			pluginName: "my-plugin",
+			rawParams:  json.RawMessage(`{"fallbacks": ["llama-3-8b", "llama-3-70b"]}`),
+			wantName:   "my-plugin",
+		},
+		{
+			name:       "cycle of aliases",
+			pluginName: "my-plugin",
+			rawParams:  json.RawMessage(`{"aliases": {"a": "b", "b": "a"}}`),
+			wantErr:    true,
+		},
+		{
+			name:       "zero max alias depth",
+			pluginName: "my-plugin",
+			rawParams:  json.RawMessage(`{"max_alias_depth": 0}`),
+			wantErr:    true,
+		},
+		{
+			name:       "invalid parameters",
+			pluginName: "my-plugin",
+			rawParams:  json.RawMessage(`{"aliases": []}`),
+			wantErr:    true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -162,13 +194,20 @@ func TestBaseModelToHeaderPlugin_ProcessRequest(t *testing.T) {
 	p := &BaseModelToHeaderPlugin{
 		typedName:     plugin.TypedName{Type: BaseModelToHeaderPluginType, Name: "test"},
 		AdaptersStore: store,
+		maxAliasDepth: 2,
+	}
+	for alias, canonical := range map[string]string{"finance": testAdapter, "finance-latest": "finance", "finance-stable": "finance-latest"} {
+		if err := p.RegisterAlias(alias, canonical); err != nil {
+			t.Fatalf("failed to register alias: %v", err)
+		}
 	}
 
 	tests := []struct {
-		name       string
-		request    *framework.InferenceRequest
-		wantErr    bool
-		wantHeader string
+		name            string
+		request         *framework.InferenceRequest
+		wantErr         bool
+		wantHeader      string
+		wantEmptyHeader bool
 	}{
 		{
 			name: "base model maps to itself",
@@ -188,6 +227,33 @@ func TestBaseModelToHeaderPlugin_ProcessRequest(t *testing.T) {
 			}(),
 			wantHeader: testBaseModel,
 		},
+		{
+			name: "alias maps to base model of canonical model",
+			request: func() *framework.InferenceRequest {
+				r := framework.NewInferenceRequest()
+				r.Body["model"] = "finance"
+				return r
+			}(),
+			wantHeader: testBaseModel,
+		},
+		{
+			name: "alias of alias maps to base model of canonical model",
+			request: func() *framework.InferenceRequest {
+				r := framework.NewInferenceRequest()
+				r.Body["model"] = "finance-latest"
+				return r
+			}(),
+			wantHeader: testBaseModel,
+		},
+		{
+			name: "aliases deeper than max alias depth are not resolved",
+			request: func() *framework.InferenceRequest {
+				r := framework.NewInferenceRequest()
+				r.Body["model"] = "finance-stable"
+				return r
+			}(),
+			wantEmptyHeader: true,
+		},
 		{
 			name: "unknown model returns empty string",
 			request: func() *framework.InferenceRequest {
@@ -282,6 +348,11 @@ func TestBaseModelToHeaderPlugin_ProcessRequest(t *testing.T) {
 					t.Errorf("Headers[%q] = %q, want %q", BaseModelHeader, got, tt.wantHeader)
 				}
 			}
+			if tt.wantEmptyHeader {
+				if got, ok := tt.request.Headers[BaseModelHeader]; !ok || got != "" {
+					t.Errorf("Headers[%q] = %q, %v; want empty", BaseModelHeader, got, ok)
+				}
+			}
 		})
 	}
 }
@@ -322,3 +393,121 @@ func TestBaseModelToHeaderPlugin_ProcessRequest_MutatedHeaders(t *testing.T) {
 		t.Errorf("MutatedHeaders()[%q] = %q, %v; want %q, true", BaseModelHeader, got, ok, testBaseModel)
 	}
 }
+
+// TestBaseModelToHeaderPlugin_ProcessRequest_Fallbacks tests that an unknown model is replaced with the first
+// fallback with a base model.
+func TestBaseModelToHeaderPlugin_ProcessRequest_Fallbacks(t *testing.T) {
+	store := NewAdaptersStore()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cm",
+			Namespace: "default",
+		},
+		Data: map[string]string{
+			"baseModel": testBaseModel,
+			"adapters":  "- " + testAdapter,
+		},
+	}
+	if err := store.configMapUpdateOrAddIfNotExist(cm); err != nil {
+		t.Fatalf("failed to setup adapters store: %v", err)
+	}
+
+	tests := []struct {
+		name              string
+		fallbacks         []string
+		model             string
+		wantModel         string
+		wantBaseModel     string
+		wantFallback      bool
+		wantOriginalModel string
+	}{
+		{
+			name:          "known model is not replaced",
+			fallbacks:     []string{testAdapter},
+			model:         testBaseModel,
+			wantModel:     testBaseModel,
+			wantBaseModel: testBaseModel,
+		},
+		{
+			name:              "unknown model is replaced with first known fallback",
+			fallbacks:         []string{"unknown-fallback", testAdapter, testBaseModel},
+			model:             "unavailable-model",
+			wantModel:         testAdapter,
+			wantBaseModel:     testBaseModel,
+			wantFallback:      true,
+			wantOriginalModel: "unavailable-model",
+		},
+		{
+			name:          "alias is replaced with canonical model",
+			model:         "finance",
+			wantModel:     testAdapter,
+			wantBaseModel: testBaseModel,
+		},
+		{
+			name:              "unknown model is replaced with canonical model of fallback alias",
+			fallbacks:         []string{"finance"},
+			model:             "unavailable-model",
+			wantModel:         testAdapter,
+			wantBaseModel:     testBaseModel,
+			wantFallback:      true,
+			wantOriginalModel: "unavailable-model",
+		},
+		{
+			name:      "unknown model without known fallback",
+			fallbacks: []string{"unknown-fallback"},
+			model:     "unavailable-model",
+			wantModel: "unavailable-model",
+		},
+		{
+			name:      "unknown model without fallbacks",
+			model:     "unavailable-model",
+			wantModel: "unavailable-model",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := (&BaseModelToHeaderPlugin{
+				typedName:     plugin.TypedName{Type: BaseModelToHeaderPluginType, Name: "test"},
+				AdaptersStore: store,
+				maxAliasDepth: defaultMaxAliasDepth,
+			}).WithFallbacks(tt.fallbacks)
+			if err := p.RegisterAlias("finance", testAdapter); err != nil {
+				t.Fatalf("failed to register alias: %v", err)
+			}
+
+			// body-field-to-header runs before this plugin in the default chain
+			modelToHeader, err := bodyfieldtoheader.NewBodyFieldToHeaderPlugin(modelField, bodyfieldtoheader.ModelHeader)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			chain := handlers.NewPluginsChain([]framework.RequestProcessor{modelToHeader, p})
+
+			request := framework.NewInferenceRequest()
+			request.Body[modelField] = tt.model
+			plan := p.PlanRequest(context.Background(), request)
+			if err := chain.ExecuteRequestPlugins(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := request.Body[modelField]; got != tt.wantModel {
+				t.Errorf("Body[%q] = %v, want %q", modelField, got, tt.wantModel)
+			}
+			if got := request.GetHeader(bodyfieldtoheader.ModelHeader); got != tt.wantModel {
+				t.Errorf("Headers[%q] = %q, want %q", bodyfieldtoheader.ModelHeader, got, tt.wantModel)
+			}
+			if got := request.Headers[BaseModelHeader]; got != tt.wantBaseModel {
+				t.Errorf("Headers[%q] = %q, want %q", BaseModelHeader, got, tt.wantBaseModel)
+			}
+			if got, ok := request.Headers[FallbackHeader]; ok != tt.wantFallback || (ok && got != "true") {
+				t.Errorf("Headers[%q] = %q, %v; want fallback %v", FallbackHeader, got, ok, tt.wantFallback)
+			}
+			if got := request.Headers[OriginalModelHeader]; got != tt.wantOriginalModel {
+				t.Errorf("Headers[%q] = %q, want %q", OriginalModelHeader, got, tt.wantOriginalModel)
+			}
+			wantBodyMutated := tt.wantModel != tt.model
+			if request.BodyMutated() != wantBodyMutated || plan.BodyMutated != wantBodyMutated {
+				t.Errorf("BodyMutated() = %v, planned %v; want %v", request.BodyMutated(), plan.BodyMutated, wantBodyMutated)
+			}
+		})
+	}
+}
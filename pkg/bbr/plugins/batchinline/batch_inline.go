@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchinline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	BatchInlinePluginType = "batch-inline"
+
+	filesPath           = "/v1/files"
+	authorizationHeader = "authorization"
+
+	defaultEndpoint         = "/v1/chat/completions"
+	defaultCompletionWindow = "24h"
+	defaultTimeoutSeconds   = 30
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &BatchInlinePlugin{}
+
+// BatchInlineConfig defines the JSON configuration structure for the plugin.
+type BatchInlineConfig struct {
+	// APIBaseURL is the base URL of the OpenAI compatible API the input file is uploaded to and the batch
+	// is created at, e.g. "http://batch-backend.default.svc:8000".
+	APIBaseURL string `json:"api_base_url"`
+	// CompletionWindow is the completion window of the created batches, unless set in the inline request. Defaults to "24h".
+	CompletionWindow string `json:"completion_window"`
+	// TimeoutSeconds bounds the upload of the input file and the creation of the batch. Defaults to 30.
+	TimeoutSeconds int `json:"timeout_seconds"`
+}
+
+// BatchInlinePluginFactory defines the factory function for NewBatchInlinePlugin.
+func BatchInlinePluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := BatchInlineConfig{
+		CompletionWindow: defaultCompletionWindow,
+		TimeoutSeconds:   defaultTimeoutSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", BatchInlinePluginType, err)
+		}
+	}
+
+	plugin, err := NewBatchInlinePlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BatchInlinePluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewBatchInlinePlugin initializes a new BatchInlinePlugin and returns its pointer.
+func NewBatchInlinePlugin(config BatchInlineConfig) (*BatchInlinePlugin, error) {
+	if config.APIBaseURL == "" {
+		return nil, errors.New("api_base_url is required in BatchInline plugin")
+	}
+	if _, err := url.ParseRequestURI(config.APIBaseURL); err != nil {
+		return nil, fmt.Errorf("invalid api_base_url in BatchInline plugin - %w", err)
+	}
+	if config.CompletionWindow == "" {
+		return nil, errors.New("completion_window is required in BatchInline plugin")
+	}
+	if config.TimeoutSeconds <= 0 {
+		return nil, errors.New("timeout_seconds must be positive in BatchInline plugin")
+	}
+
+	timeout := time.Duration(config.TimeoutSeconds) * time.Second
+	return &BatchInlinePlugin{
+		typedName: plugin.TypedName{
+			Type: BatchInlinePluginType,
+			Name: BatchInlinePluginType,
+		},
+		baseURL:          strings.TrimSuffix(config.APIBaseURL, "/"),
+		completionWindow: config.CompletionWindow,
+		timeout:          timeout,
+		client:           &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// BatchInlinePlugin hides the two step flow of the OpenAI Batch API, which requires uploading a JSONL
+// input file before creating a batch that references it, from clients. Batch requests whose body is a
+// single JSON object with the inline requests, e.g. {"endpoint":"/v1/chat/completions","requests":[{"body":{...}}]},
+// are converted to a JSONL input file, which is uploaded to the Files API before the batch is created.
+// The client is answered directly with the created batch object, whose id it polls as usual.
+// Batch requests referencing an input file are forwarded unchanged.
+type BatchInlinePlugin struct {
+	typedName        plugin.TypedName
+	baseURL          string
+	completionWindow string
+	timeout          time.Duration
+	client           *http.Client
+}
+
+// inlineBatch is the inline format of batch requests.
+type inlineBatch struct {
+	Endpoint         string            `json:"endpoint"`
+	CompletionWindow string            `json:"completion_window"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	Requests         []inlineRequest   `json:"requests"`
+}
+
+// inlineRequest is a request of an inline batch, whose custom_id defaults to its position in the batch.
+type inlineRequest struct {
+	CustomID string         `json:"custom_id"`
+	Body     map[string]any `json:"body"`
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *BatchInlinePlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *BatchInlinePlugin) WithName(name string) *BatchInlinePlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest submits inline batch requests through the Files and Batch APIs and answers them with the created batch.
+func (p *BatchInlinePlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if !request.IsBatchRequest() {
+		return nil
+	}
+
+	raw, err := framework.ReadCycleStateKey[[]byte](cycleState, framework.RequestBodyBytesKey)
+	if err != nil {
+		return nil // the raw body is not available
+	}
+	var batch inlineBatch
+	if err := json.Unmarshal(raw, &batch); err != nil || batch.Requests == nil {
+		return nil // not an inline batch, e.g. a batch referencing an uploaded input file
+	}
+
+	input, err := p.inputFile(&batch)
+	if err != nil {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: err.Error()}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	authorization := request.GetHeader(authorizationHeader)
+	fileID, err := p.uploadFile(ctx, authorization, input)
+	if err != nil {
+		return errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: fmt.Sprintf("failed to upload the batch input file: %v", err)}
+	}
+	created, err := p.createBatch(ctx, authorization, fileID, &batch)
+	if err != nil {
+		return errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: fmt.Sprintf("failed to create the batch: %v", err)}
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("submitted an inline batch", "inputFileID", fileID, "requests", len(batch.Requests))
+
+	return errcommon.ImmediateResponse{
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    created,
+	}
+}
+
+// inputFile returns the JSONL input file of the inline batch, and fills in the defaults of the batch.
+func (p *BatchInlinePlugin) inputFile(batch *inlineBatch) ([]byte, error) {
+	if len(batch.Requests) == 0 {
+		return nil, errors.New("the inline batch has no requests")
+	}
+	if batch.Endpoint == "" {
+		batch.Endpoint = defaultEndpoint
+	}
+	if batch.CompletionWindow == "" {
+		batch.CompletionWindow = p.completionWindow
+	}
+
+	var input bytes.Buffer
+	for i, r := range batch.Requests {
+		if r.Body == nil {
+			return nil, fmt.Errorf("request %d of the inline batch has no body", i+1)
+		}
+		customID := r.CustomID
+		if customID == "" {
+			customID = "request-" + strconv.Itoa(i+1)
+		}
+		line, err := json.Marshal(map[string]any{
+			"custom_id": customID,
+			"method":    http.MethodPost,
+			"url":       batch.Endpoint,
+			"body":      r.Body,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("request %d of the inline batch is invalid: %w", i+1, err)
+		}
+		input.Write(line)
+		input.WriteByte('\n')
+	}
+	return input.Bytes(), nil
+}
+
+// uploadFile uploads the input file to the Files API, and returns the id of the uploaded file.
+func (p *BatchInlinePlugin) uploadFile(ctx context.Context, authorization string, input []byte) (string, error) {
+	var form bytes.Buffer
+	writer := multipart.NewWriter(&form)
+	if err := writer.WriteField("purpose", "batch"); err != nil {
+		return "", err
+	}
+	file, err := writer.CreateFormFile("file", "batch.jsonl")
+	if err != nil {
+		return "", err
+	}
+	if _, err := file.Write(input); err != nil {
+		return "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	raw, err := p.post(ctx, p.baseURL+filesPath, writer.FormDataContentType(), authorization, form.Bytes())
+	if err != nil {
+		return "", err
+	}
+	var uploaded struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(raw, &uploaded); err != nil || uploaded.ID == "" {
+		return "", errors.New("the Files API returned no file id")
+	}
+	return uploaded.ID, nil
+}
+
+// createBatch creates a batch of the uploaded input file, and returns the created batch object.
+func (p *BatchInlinePlugin) createBatch(ctx context.Context, authorization, fileID string, batch *inlineBatch) ([]byte, error) {
+	create := map[string]any{
+		"input_file_id":     fileID,
+		"endpoint":          batch.Endpoint,
+		"completion_window": batch.CompletionWindow,
+	}
+	if batch.Metadata != nil {
+		create["metadata"] = batch.Metadata
+	}
+	body, err := json.Marshal(create)
+	if err != nil {
+		return nil, err
+	}
+	return p.post(ctx, p.baseURL+framework.BatchesPath, "application/json", authorization, body)
+}
+
+// post posts the body to the endpoint with the client's authorization, and returns the response body.
+func (p *BatchInlinePlugin) post(ctx context.Context, endpoint, contentType, authorization string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return raw, nil
+}
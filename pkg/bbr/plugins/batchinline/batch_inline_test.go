@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchinline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestBatchInlinePluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"api_base_url":"http://batch.default.svc:8000"}`)},
+		{name: "full config", rawParams: json.RawMessage(`{"api_base_url":"http://batch.default.svc:8000","completion_window":"48h","timeout_seconds":10}`)},
+		{name: "missing base URL", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "invalid base URL", rawParams: json.RawMessage(`{"api_base_url":"not a url"}`), wantErr: true},
+		{name: "empty completion window", rawParams: json.RawMessage(`{"api_base_url":"http://batch","completion_window":""}`), wantErr: true},
+		{name: "non-positive timeout", rawParams: json.RawMessage(`{"api_base_url":"http://batch","timeout_seconds":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := BatchInlinePluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+// fakeBatchAPI is a fake of the Files and Batch APIs, recording the uploaded input file and the created batch.
+type fakeBatchAPI struct {
+	uploaded      string
+	created       map[string]any
+	authorization string
+	failBatches   bool
+}
+
+func (f *fakeBatchAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.authorization = r.Header.Get("Authorization")
+	switch r.URL.Path {
+	case "/v1/files":
+		if r.FormValue("purpose") != "batch" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		raw, _ := io.ReadAll(file)
+		f.uploaded = string(raw)
+		_, _ = w.Write([]byte(`{"id":"file-123","object":"file","purpose":"batch"}`))
+	case "/v1/batches":
+		if f.failBatches {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&f.created)
+		_, _ = w.Write([]byte(`{"id":"batch-456","object":"batch","status":"validating","input_file_id":"file-123"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newBatchRequest(body string) (*framework.InferenceRequest, *framework.CycleState) {
+	request := framework.NewInferenceRequest()
+	request.Headers[framework.PathHeader] = framework.BatchesPath
+	request.Headers["authorization"] = "Bearer token"
+	cycleState := framework.NewCycleState()
+	cycleState.Write(framework.RequestBodyBytesKey, []byte(body))
+	return request, cycleState
+}
+
+func TestBatchInlinePlugin(t *testing.T) {
+	api := &fakeBatchAPI{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	p, err := NewBatchInlinePlugin(BatchInlineConfig{APIBaseURL: server.URL + "/", CompletionWindow: "24h", TimeoutSeconds: 5})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	request, cycleState := newBatchRequest(`{"metadata":{"team":"search"},"requests":[` +
+		`{"custom_id":"a","body":{"model":"m","messages":[{"role":"user","content":"Hi"}]}},` +
+		`{"body":{"model":"m","messages":[{"role":"user","content":"Bye"}]}}]}`)
+	err = p.ProcessRequest(context.Background(), cycleState, request)
+
+	var immediate errcommon.ImmediateResponse
+	if !errors.As(err, &immediate) {
+		t.Fatalf("expected an immediate response, got %v", err)
+	}
+	if got, want := string(immediate.Body), `{"id":"batch-456","object":"batch","status":"validating","input_file_id":"file-123"}`; got != want {
+		t.Errorf("response body = %s, want %s", got, want)
+	}
+
+	wantUploaded := `{"body":{"messages":[{"content":"Hi","role":"user"}],"model":"m"},"custom_id":"a","method":"POST","url":"/v1/chat/completions"}` + "\n" +
+		`{"body":{"messages":[{"content":"Bye","role":"user"}],"model":"m"},"custom_id":"request-2","method":"POST","url":"/v1/chat/completions"}` + "\n"
+	if diff := cmp.Diff(wantUploaded, api.uploaded); diff != "" {
+		t.Errorf("uploaded input file mismatch (-want +got):\n%s", diff)
+	}
+	wantCreated := map[string]any{
+		"input_file_id":     "file-123",
+		"endpoint":          "/v1/chat/completions",
+		"completion_window": "24h",
+		"metadata":          map[string]any{"team": "search"},
+	}
+	if diff := cmp.Diff(wantCreated, api.created); diff != "" {
+		t.Errorf("created batch mismatch (-want +got):\n%s", diff)
+	}
+	if api.authorization != "Bearer token" {
+		t.Errorf("Authorization = %q, want %q", api.authorization, "Bearer token")
+	}
+}
+
+func TestBatchInlinePluginPassThrough(t *testing.T) {
+	p, err := NewBatchInlinePlugin(BatchInlineConfig{APIBaseURL: "http://127.0.0.1:1", CompletionWindow: "24h", TimeoutSeconds: 5})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		wantCode string
+	}{
+		{name: "batch referencing an input file", path: framework.BatchesPath, body: `{"input_file_id":"file-1","endpoint":"/v1/chat/completions","completion_window":"24h"}`},
+		{name: "non-batch request", path: "/v1/chat/completions", body: `{"requests":[{"body":{}}]}`},
+		{name: "empty inline batch", path: framework.BatchesPath, body: `{"requests":[]}`, wantCode: errcommon.BadRequest},
+		{name: "inline request without body", path: framework.BatchesPath, body: `{"requests":[{"custom_id":"a"}]}`, wantCode: errcommon.BadRequest},
+		{name: "unreachable API", path: framework.BatchesPath, body: `{"requests":[{"body":{"model":"m"}}]}`, wantCode: errcommon.ServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, cycleState := newBatchRequest(tt.body)
+			request.Headers[framework.PathHeader] = tt.path
+
+			err := p.ProcessRequest(context.Background(), cycleState, request)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+				t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestBatchInlinePluginBatchCreationFailure(t *testing.T) {
+	server := httptest.NewServer(&fakeBatchAPI{failBatches: true})
+	defer server.Close()
+
+	p, err := NewBatchInlinePlugin(BatchInlineConfig{APIBaseURL: server.URL, CompletionWindow: "24h", TimeoutSeconds: 5})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	request, cycleState := newBatchRequest(`{"requests":[{"body":{"model":"m"}}]}`)
+	if got := errcommon.CanonicalCode(p.ProcessRequest(context.Background(), cycleState, request)); got != errcommon.ServiceUnavailable {
+		t.Errorf("CanonicalCode = %q, want %q", got, errcommon.ServiceUnavailable)
+	}
+}
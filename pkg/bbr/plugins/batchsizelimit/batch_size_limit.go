@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchsizelimit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	BatchSizeLimitPluginType = "batch-size-limit"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &BatchSizeLimitPlugin{}
+
+// BatchSizeLimitConfig defines the JSON configuration structure for the plugin.
+type BatchSizeLimitConfig struct {
+	// MaxItems is the maximal number of requests in a batch.
+	MaxItems int `json:"max_items"`
+}
+
+// BatchSizeLimitPluginFactory defines the factory function for NewBatchSizeLimitPlugin.
+func BatchSizeLimitPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := BatchSizeLimitConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", BatchSizeLimitPluginType, err)
+		}
+	}
+
+	plugin, err := NewBatchSizeLimitPlugin(config.MaxItems)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BatchSizeLimitPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewBatchSizeLimitPlugin initializes a new BatchSizeLimitPlugin and returns its pointer.
+func NewBatchSizeLimitPlugin(maxItems int) (*BatchSizeLimitPlugin, error) {
+	if maxItems <= 0 {
+		return nil, errors.New("max_items must be positive in BatchSizeLimit plugin")
+	}
+
+	return &BatchSizeLimitPlugin{
+		typedName: plugin.TypedName{
+			Type: BatchSizeLimitPluginType,
+			Name: BatchSizeLimitPluginType,
+		},
+		maxItems: maxItems,
+	}, nil
+}
+
+// BatchSizeLimitPlugin limits the number of requests in the newline-delimited JSON body of batch
+// requests. Batches with a line that is not a valid JSON object are rejected with HTTP 400, and
+// batches with more than the configured number of items are rejected with HTTP 413.
+// Requests to other endpoints are ignored.
+type BatchSizeLimitPlugin struct {
+	typedName plugin.TypedName
+	maxItems  int
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *BatchSizeLimitPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *BatchSizeLimitPlugin) WithName(name string) *BatchSizeLimitPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the items of batch requests and rejects batches exceeding the limit.
+func (p *BatchSizeLimitPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if !request.IsBatchRequest() {
+		return nil
+	}
+
+	body, err := framework.ReadCycleStateKey[[]byte](cycleState, framework.RequestBodyBytesKey)
+	if err != nil {
+		return nil // the raw body is not available
+	}
+
+	items := 0
+	for i, line := range bytes.Split(body, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		if line[0] != '{' || !json.Valid(line) {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("line %d of the batch is not a valid JSON object", i+1)}
+		}
+		items++
+		if items > p.maxItems {
+			log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected batch exceeding the size limit", "maxItems", p.maxItems)
+			return errcommon.Error{Code: errcommon.PayloadTooLarge, Msg: fmt.Sprintf("the batch exceeds the limit of %d items", p.maxItems)}
+		}
+	}
+	return nil
+}
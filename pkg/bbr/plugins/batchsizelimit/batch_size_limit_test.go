@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batchsizelimit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestBatchSizeLimitPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"max_items":100}`)},
+		{name: "missing max items", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := BatchSizeLimitPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestBatchSizeLimitPlugin(t *testing.T) {
+	p, err := NewBatchSizeLimitPlugin(2)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		body     string
+		wantCode string
+	}{
+		{
+			name: "within the limit",
+			path: framework.BatchesPath,
+			body: "{\"custom_id\":\"1\"}\n\n{\"custom_id\":\"2\"}\n",
+		},
+		{
+			name:     "exceeding the limit",
+			path:     framework.BatchesPath,
+			body:     "{\"custom_id\":\"1\"}\n{\"custom_id\":\"2\"}\n{\"custom_id\":\"3\"}\n",
+			wantCode: errcommon.PayloadTooLarge,
+		},
+		{
+			name:     "invalid JSON line",
+			path:     framework.BatchesPath,
+			body:     "{\"custom_id\":\"1\"}\n{invalid\n",
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "line is not an object",
+			path:     framework.BatchesPath,
+			body:     "[1,2]\n",
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name: "other endpoints are ignored",
+			path: "/v1/chat/completions",
+			body: "{\"custom_id\":\"1\"}\n{\"custom_id\":\"2\"}\n{\"custom_id\":\"3\"}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycleState := framework.NewCycleState()
+			cycleState.Write(framework.RequestBodyBytesKey, []byte(tt.body))
+			request := framework.NewInferenceRequest()
+			request.Headers[framework.PathHeader] = tt.path
+
+			err := p.ProcessRequest(context.Background(), cycleState, request)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+				t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+			}
+		})
+	}
+}
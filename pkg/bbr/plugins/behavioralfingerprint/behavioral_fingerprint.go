@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package behavioralfingerprint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	BehavioralFingerprintPluginType = "behavioral-fingerprint"
+	APIKeyHeader                    = "X-API-Key"
+	FingerprintHeader               = "X-BBR-Behavioral-Fingerprint"
+	AnomalyDetectedHeader           = "X-BBR-Anomaly-Detected"
+
+	defaultWindowSize       = 50
+	defaultMinSamples       = 10
+	defaultAnomalyThreshold = 0.1
+
+	// defaultTemperature is the temperature of the requests that do not set it, as in the OpenAI API.
+	defaultTemperature = 1.0
+	// idleTimeout is the duration after which the profile of a client that sent no requests is forgotten.
+	idleTimeout = time.Hour
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &BehavioralFingerprintPlugin{}
+
+// BehavioralFingerprintConfig defines the JSON configuration structure for the plugin.
+type BehavioralFingerprintConfig struct {
+	// WindowSize is the number of most recent requests of a client its profile is computed from. Defaults to 50.
+	WindowSize int `json:"window_size"`
+	// MinSamples is the number of requests a profile needs before deviations from it are detected. Defaults to 10.
+	MinSamples int `json:"min_samples"`
+	// AnomalyThreshold is the cosine distance between a request and the profile of its client above
+	// which the request is flagged as anomalous, between 0 and 1. Defaults to 0.1.
+	AnomalyThreshold float64 `json:"anomaly_threshold"`
+}
+
+// BehavioralFingerprintPluginFactory defines the factory function for NewBehavioralFingerprintPlugin.
+func BehavioralFingerprintPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := BehavioralFingerprintConfig{
+		WindowSize:       defaultWindowSize,
+		MinSamples:       defaultMinSamples,
+		AnomalyThreshold: defaultAnomalyThreshold,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", BehavioralFingerprintPluginType, err)
+		}
+	}
+
+	plugin, err := NewBehavioralFingerprintPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BehavioralFingerprintPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewBehavioralFingerprintPlugin initializes a new BehavioralFingerprintPlugin and returns its pointer.
+func NewBehavioralFingerprintPlugin(config BehavioralFingerprintConfig) (*BehavioralFingerprintPlugin, error) {
+	if config.WindowSize <= 0 {
+		return nil, errors.New("window_size must be positive in BehavioralFingerprint plugin")
+	}
+	if config.MinSamples <= 0 || config.MinSamples > config.WindowSize {
+		return nil, errors.New("min_samples must be positive and at most window_size in BehavioralFingerprint plugin")
+	}
+	if config.AnomalyThreshold <= 0 || config.AnomalyThreshold > 1 {
+		return nil, errors.New("anomaly_threshold must be in the range (0, 1] in BehavioralFingerprint plugin")
+	}
+
+	return &BehavioralFingerprintPlugin{
+		typedName: plugin.TypedName{
+			Type: BehavioralFingerprintPluginType,
+			Name: BehavioralFingerprintPluginType,
+		},
+		windowSize: config.WindowSize,
+		minSamples: config.MinSamples,
+		threshold:  config.AnomalyThreshold,
+		profiles:   map[string]*profile{},
+		now:        time.Now,
+	}, nil
+}
+
+// BehavioralFingerprintPlugin profiles the behavior of each client (identified by its X-API-Key header)
+// over its most recent requests: their prompt length, temperature and the interval between them.
+// The profile is summarized in a fingerprint set in the X-BBR-Behavioral-Fingerprint header, which
+// stays stable as long as the behavior of the client does, e.g. to correlate clients sharing a key.
+// Requests deviating significantly from the profile of their client are flagged with the
+// X-BBR-Anomaly-Detected header, e.g. when a leaked key is used by someone else.
+type BehavioralFingerprintPlugin struct {
+	typedName  plugin.TypedName
+	windowSize int
+	minSamples int
+	threshold  float64
+
+	lock      sync.Mutex
+	profiles  map[string]*profile
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// features is the behavior of a single request, scaled so the features have comparable magnitudes.
+type features [3]float64
+
+// profile holds the features of the most recent requests of a client in a ring buffer.
+type profile struct {
+	samples  []features
+	next     int
+	lastSeen time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *BehavioralFingerprintPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *BehavioralFingerprintPlugin) WithName(name string) *BehavioralFingerprintPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest compares the request with the profile of its client, adds it to the profile, and sets
+// the fingerprint and anomaly headers.
+func (p *BehavioralFingerprintPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	apiKey := request.GetHeader(APIKeyHeader)
+	fingerprint, distance, anomalous := p.observe(apiKey, request.Body)
+
+	request.SetHeader(FingerprintHeader, fingerprint)
+	if anomalous {
+		request.SetHeader(AnomalyDetectedHeader, "true")
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("request deviates from the behavioral profile of its client", "distance", distance, "threshold", p.threshold)
+	}
+	return nil
+}
+
+// observe compares the request with the profile of the client and adds it to the profile. It returns the
+// updated fingerprint, the cosine distance of the request from the profile, and whether it is anomalous.
+func (p *BehavioralFingerprintPlugin) observe(apiKey string, body map[string]any) (string, float64, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+	p.sweep(now)
+
+	prof, ok := p.profiles[apiKey]
+	if !ok {
+		prof = &profile{samples: make([]features, 0, p.windowSize)}
+		p.profiles[apiKey] = prof
+	}
+
+	var interval time.Duration
+	if ok {
+		interval = now.Sub(prof.lastSeen)
+	}
+	prof.lastSeen = now
+	current := extract(body, interval)
+
+	distance := 0.0
+	anomalous := false
+	if len(prof.samples) >= p.minSamples {
+		distance = cosineDistance(current, prof.mean())
+		anomalous = distance > p.threshold
+	}
+
+	if len(prof.samples) < p.windowSize {
+		prof.samples = append(prof.samples, current)
+	} else {
+		prof.samples[prof.next] = current
+		prof.next = (prof.next + 1) % p.windowSize
+	}
+	return fingerprint(prof.mean()), distance, anomalous
+}
+
+// sweep forgets the profiles of the idle clients, at most once per idle timeout.
+func (p *BehavioralFingerprintPlugin) sweep(now time.Time) {
+	if now.Sub(p.lastSweep) < idleTimeout {
+		return
+	}
+	p.lastSweep = now
+	for apiKey, prof := range p.profiles {
+		if now.Sub(prof.lastSeen) >= idleTimeout {
+			delete(p.profiles, apiKey)
+		}
+	}
+}
+
+// mean returns the mean features of the samples of the profile.
+func (prof *profile) mean() features {
+	var mean features
+	if len(prof.samples) == 0 {
+		return mean
+	}
+	for _, sample := range prof.samples {
+		for i, value := range sample {
+			mean[i] += value
+		}
+	}
+	for i := range mean {
+		mean[i] /= float64(len(prof.samples))
+	}
+	return mean
+}
+
+// extract returns the features of a request sent the given interval after the previous request of its client.
+// Prompt lengths and intervals span orders of magnitude, so they are compared on a logarithmic scale.
+func extract(body map[string]any, interval time.Duration) features {
+	temperature, ok := body["temperature"].(float64)
+	if !ok {
+		temperature = defaultTemperature
+	}
+	return features{
+		math.Log1p(float64(promptLength(body))),
+		temperature,
+		math.Log1p(interval.Seconds()),
+	}
+}
+
+// promptLength returns the number of characters of the prompt or of the text contents of the messages.
+func promptLength(body map[string]any) int {
+	switch prompt := body["prompt"].(type) {
+	case string:
+		return len(prompt)
+	case []any:
+		length := 0
+		for _, p := range prompt {
+			if s, ok := p.(string); ok {
+				length += len(s)
+			}
+		}
+		return length
+	}
+
+	messages, _ := body["messages"].([]any)
+	length := 0
+	for _, raw := range messages {
+		message, _ := raw.(map[string]any)
+		switch content := message["content"].(type) {
+		case string:
+			length += len(content)
+		case []any:
+			for _, part := range content {
+				if textPart, ok := part.(map[string]any); ok {
+					text, _ := textPart["text"].(string)
+					length += len(text)
+				}
+			}
+		}
+	}
+	return length
+}
+
+// cosineDistance returns 1 minus the cosine similarity of the features, or 0 if either is zero.
+func cosineDistance(a, b features) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return 1 - dot/math.Sqrt(normA*normB)
+}
+
+// fingerprint hashes the mean features of a profile, rounded so that small fluctuations of the
+// behavior do not change it.
+func fingerprint(mean features) string {
+	var rounded strings.Builder
+	for _, value := range mean {
+		fmt.Fprintf(&rounded, "%.0f|", value*2) // half units of the scaled features
+	}
+	return fmt.Sprintf("%016x", xxhash.Sum64String(rounded.String()))
+}
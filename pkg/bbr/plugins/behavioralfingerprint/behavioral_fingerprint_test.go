@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package behavioralfingerprint
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestBehavioralFingerprintPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "default config", rawParams: nil},
+		{name: "custom config", rawParams: json.RawMessage(`{"window_size":20,"min_samples":5,"anomaly_threshold":0.3}`)},
+		{name: "non-positive window size", rawParams: json.RawMessage(`{"window_size":0}`), wantErr: true},
+		{name: "min samples above window size", rawParams: json.RawMessage(`{"window_size":5,"min_samples":10}`), wantErr: true},
+		{name: "threshold above 1", rawParams: json.RawMessage(`{"anomaly_threshold":1.5}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := BehavioralFingerprintPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestBehavioralFingerprintPlugin(t *testing.T) {
+	p, err := NewBehavioralFingerprintPlugin(BehavioralFingerprintConfig{WindowSize: 10, MinSamples: 5, AnomalyThreshold: 0.1})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	send := func(apiKey, prompt string, temperature float64) *framework.InferenceRequest {
+		t.Helper()
+		request := framework.NewInferenceRequest()
+		request.SetHeader("x-api-key", apiKey)
+		request.Body["prompt"] = prompt
+		request.Body["temperature"] = temperature
+		if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+			t.Fatalf("ProcessRequest() unexpected error: %v", err)
+		}
+		return request
+	}
+
+	var fingerprints []string
+	for i := range 12 {
+		request := send("alice", "hello world", 0.7)
+		if got := request.GetHeader(AnomalyDetectedHeader); got != "" {
+			t.Errorf("request %d: %s = %q, want unset", i, AnomalyDetectedHeader, got)
+		}
+		fingerprints = append(fingerprints, request.GetHeader(FingerprintHeader))
+		now = now.Add(10 * time.Second)
+	}
+	// once the window only holds requests of the same behavior, the fingerprint is stable
+	if fingerprints[10] == "" || fingerprints[10] != fingerprints[11] {
+		t.Errorf("fingerprints of the same behavior differ: %q, %q", fingerprints[10], fingerprints[11])
+	}
+
+	// a burst of long prompts with a high temperature deviates from the profile
+	now = now.Add(-10*time.Second + 10*time.Millisecond)
+	request := send("alice", strings.Repeat("x", 10000), 2.0)
+	if got := request.GetHeader(AnomalyDetectedHeader); got != "true" {
+		t.Errorf("deviating request: %s = %q, want %q", AnomalyDetectedHeader, got, "true")
+	}
+
+	// the same behavior is not anomalous for a client without a profile
+	request = send("bob", strings.Repeat("x", 10000), 2.0)
+	if got := request.GetHeader(AnomalyDetectedHeader); got != "" {
+		t.Errorf("new client: %s = %q, want unset", AnomalyDetectedHeader, got)
+	}
+	if got := request.GetHeader(FingerprintHeader); got == "" || got == fingerprints[11] {
+		t.Errorf("new client: %s = %q, want a different fingerprint", FingerprintHeader, got)
+	}
+}
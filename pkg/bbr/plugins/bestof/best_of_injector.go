@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bestof
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	BestOfInjectorPluginType = "best-of-injector"
+	modelField               = "model"
+	bestOfField              = "best_of"
+	nField                   = "n"
+	promptField              = "prompt"
+	messagesField            = "messages"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &BestOfInjectorPlugin{}
+
+// BestOfInjectorConfig defines the JSON configuration structure for the plugin.
+type BestOfInjectorConfig struct {
+	// BestOfOverrides maps a completion model to the best_of injected in its requests, e.g. {"davinci-002": 3}.
+	BestOfOverrides map[string]int `json:"best_of_overrides"`
+}
+
+// BestOfInjectorPluginFactory defines the factory function for NewBestOfInjectorPlugin.
+func BestOfInjectorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config BestOfInjectorConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", BestOfInjectorPluginType, err)
+		}
+	}
+
+	plugin, err := NewBestOfInjectorPlugin(config.BestOfOverrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BestOfInjectorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewBestOfInjectorPlugin initializes a new BestOfInjectorPlugin and returns its pointer.
+func NewBestOfInjectorPlugin(bestOfOverrides map[string]int) (*BestOfInjectorPlugin, error) {
+	if len(bestOfOverrides) == 0 {
+		return nil, errors.New("best_of_overrides is required in BestOfInjector plugin")
+	}
+	for model, bestOf := range bestOfOverrides {
+		if bestOf <= 0 {
+			return nil, fmt.Errorf("best_of of model %q must be positive in BestOfInjector plugin", model)
+		}
+	}
+
+	return &BestOfInjectorPlugin{
+		typedName: plugin.TypedName{
+			Type: BestOfInjectorPluginType,
+			Name: BestOfInjectorPluginType,
+		},
+		overrides: bestOfOverrides,
+	}, nil
+}
+
+// BestOfInjectorPlugin injects best_of in the completion requests of the models whose output improves
+// with candidate selection, when the client did not set it. The injected best_of is raised to n when
+// the request asks for more completions, since best_of must be greater than or equal to n.
+// best_of is only supported by the completions API, so chat completion requests setting it, and
+// completion requests setting it below n, are rejected with HTTP 400.
+type BestOfInjectorPlugin struct {
+	typedName plugin.TypedName
+	overrides map[string]int
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *BestOfInjectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *BestOfInjectorPlugin) WithName(name string) *BestOfInjectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the best_of of the request, and injects it for the configured completion models.
+func (p *BestOfInjectorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	rawBestOf, hasBestOf := request.Body[bestOfField]
+	hasBestOf = hasBestOf && rawBestOf != nil
+	if _, ok := request.Body[messagesField]; ok {
+		if hasBestOf {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: "best_of is not supported for chat completions"}
+		}
+		return nil
+	}
+	if _, ok := request.Body[promptField]; !ok {
+		return nil // not a completion request
+	}
+
+	n, ok := integer(request.Body[nField])
+	if !ok {
+		n = 1
+	}
+
+	if hasBestOf {
+		bestOf, ok := integer(rawBestOf)
+		if !ok {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: "best_of must be an integer"}
+		}
+		if bestOf < n {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("best_of (%d) must be greater than or equal to n (%d)", bestOf, n)}
+		}
+		return nil
+	}
+
+	model, _ := request.Body[modelField].(string)
+	bestOf, ok := p.overrides[model]
+	if !ok {
+		return nil
+	}
+	bestOf = max(bestOf, n)
+	request.SetBodyField(bestOfField, bestOf)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("injected best_of", "model", model, "bestOf", bestOf)
+	return nil
+}
+
+// integer returns the value of a JSON number field, if it is an integer.
+func integer(value any) (int, bool) {
+	number, ok := value.(float64)
+	if !ok || number != math.Trunc(number) {
+		return 0, false
+	}
+	return int(number), true
+}
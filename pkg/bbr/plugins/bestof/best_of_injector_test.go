@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bestof
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestBestOfInjectorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"best_of_overrides":{"davinci-002":3}}`)},
+		{name: "missing overrides", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "non-positive best_of", rawParams: json.RawMessage(`{"best_of_overrides":{"davinci-002":0}}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := BestOfInjectorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestBestOfInjectorPlugin(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]any
+		wantCode   string
+		wantBestOf any
+	}{
+		{name: "injected in completions", body: map[string]any{"model": "davinci-002", "prompt": "hi"}, wantBestOf: 3},
+		{name: "raised to n", body: map[string]any{"model": "davinci-002", "prompt": "hi", "n": 5.0}, wantBestOf: 5},
+		{name: "client best_of is kept", body: map[string]any{"model": "davinci-002", "prompt": "hi", "best_of": 2.0}, wantBestOf: 2.0},
+		{name: "other model", body: map[string]any{"model": "babbage-002", "prompt": "hi"}},
+		{name: "chat completions without best_of", body: map[string]any{"model": "davinci-002", "messages": []any{}}},
+		{name: "chat completions with best_of", body: map[string]any{"model": "gpt-4", "messages": []any{}, "best_of": 2.0}, wantCode: errcommon.BadRequest},
+		{name: "best_of below n", body: map[string]any{"model": "babbage-002", "prompt": "hi", "n": 3.0, "best_of": 2.0}, wantCode: errcommon.BadRequest},
+		{name: "non-integer best_of", body: map[string]any{"model": "babbage-002", "prompt": "hi", "best_of": 1.5}, wantCode: errcommon.BadRequest},
+	}
+	p, err := NewBestOfInjectorPlugin(map[string]int{"davinci-002": 3})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Body["best_of"]; got != tt.wantBestOf {
+				t.Errorf("best_of = %v, want %v", got, tt.wantBestOf)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bestof
+
+import (
+	"context"
+	"encoding/json"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	StreamBestOfConflictPluginType = "stream-best-of-conflict"
+	streamField                    = "stream"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor = &StreamBestOfConflictPlugin{}
+	_ framework.RequestPlanner   = &StreamBestOfConflictPlugin{}
+)
+
+// StreamBestOfConflictPluginFactory defines the factory function for NewStreamBestOfConflictPlugin.
+func StreamBestOfConflictPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewStreamBestOfConflictPlugin().WithName(name), nil
+}
+
+// NewStreamBestOfConflictPlugin initializes a new StreamBestOfConflictPlugin and returns its pointer.
+func NewStreamBestOfConflictPlugin() *StreamBestOfConflictPlugin {
+	return &StreamBestOfConflictPlugin{
+		typedName: plugin.TypedName{
+			Type: StreamBestOfConflictPluginType,
+			Name: StreamBestOfConflictPluginType,
+		},
+	}
+}
+
+// StreamBestOfConflictPlugin rejects requests setting both stream to true and best_of above 1 with
+// HTTP 400, since the candidates can only be compared once they are fully generated. It should run
+// before the plugins mutating the body, e.g. the best-of-injector plugin, so that only the
+// combinations sent by the client are rejected.
+type StreamBestOfConflictPlugin struct {
+	typedName plugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *StreamBestOfConflictPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *StreamBestOfConflictPlugin) WithName(name string) *StreamBestOfConflictPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if it is streamed with best_of above 1.
+func (p *StreamBestOfConflictPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	if conflicts(request.Body) {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected a streamed request with best_of", "bestOf", request.Body[bestOfField])
+		return streamBestOfConflict()
+	}
+	return nil
+}
+
+// PlanRequest predicts that the plugin rejects the request if it is streamed with best_of above 1.
+func (p *StreamBestOfConflictPlugin) PlanRequest(_ context.Context, request *framework.InferenceRequest) framework.RequestPlan {
+	plan := framework.RequestPlan{RequiresBody: true}
+	if request == nil || request.Body == nil {
+		return plan
+	}
+	plan.Fires = conflicts(request.Body)
+	return plan
+}
+
+// conflicts returns whether the request body is streamed with best_of above 1.
+func conflicts(body map[string]any) bool {
+	stream, _ := body[streamField].(bool)
+	bestOf, _ := body[bestOfField].(float64)
+	return stream && bestOf > 1
+}
+
+// streamBestOfConflict returns the HTTP 400 response rejecting a streamed request with best_of, with an error body
+// in the OpenAI format.
+func streamBestOfConflict() error {
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message": "stream and best_of cannot both be set",
+			"type":    "invalid_request_error",
+		},
+	})
+	return errcommon.ImmediateResponse{
+		Code:    errcommon.BadRequest,
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    body,
+	}
+}
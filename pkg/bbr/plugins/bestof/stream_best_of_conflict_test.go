@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bestof
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestStreamBestOfConflictPlugin(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     map[string]any
+		wantCode string
+	}{
+		{name: "stream with best_of", body: map[string]any{"stream": true, "best_of": 3.0}, wantCode: errcommon.BadRequest},
+		{name: "stream with best_of of 1", body: map[string]any{"stream": true, "best_of": 1.0}},
+		{name: "best_of without stream", body: map[string]any{"stream": false, "best_of": 3.0}},
+		{name: "stream without best_of", body: map[string]any{"stream": true}},
+		{name: "neither", body: map[string]any{"prompt": "hi"}},
+	}
+	p := NewStreamBestOfConflictPlugin()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				var immediate errcommon.ImmediateResponse
+				if !errors.As(err, &immediate) {
+					t.Fatalf("expected an immediate response, got %v", err)
+				}
+				var body map[string]map[string]any
+				if err := json.Unmarshal(immediate.Body, &body); err != nil {
+					t.Fatalf("body should be JSON, got %s: %v", immediate.Body, err)
+				}
+				if got := body["error"]["type"]; got != "invalid_request_error" {
+					t.Errorf("error type = %v, want %q", got, "invalid_request_error")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
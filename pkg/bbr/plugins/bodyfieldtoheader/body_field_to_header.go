@@ -38,6 +38,7 @@ const (
 
 // compile-time type validation
 var _ framework.RequestProcessor = &BodyFieldToHeaderPlugin{}
+var _ framework.RequestPlanner = &BodyFieldToHeaderPlugin{}
 
 // BodyFieldToHeaderConfig defines the JSON configuration structure for the plugin.
 type BodyFieldToHeaderConfig struct {
@@ -127,3 +128,16 @@ func (p *BodyFieldToHeaderPlugin) ProcessRequest(ctx context.Context, _ *framewo
 
 	return nil
 }
+
+// PlanRequest predicts that the plugin sets its header when the body field is present and not empty.
+func (p *BodyFieldToHeaderPlugin) PlanRequest(_ context.Context, request *framework.InferenceRequest) framework.RequestPlan {
+	plan := framework.RequestPlan{RequiresBody: true}
+	if request == nil || request.Body == nil {
+		return plan
+	}
+	if rawFieldValue, exists := request.Body[p.fieldName]; exists && fmt.Sprintf("%v", rawFieldValue) != "" {
+		plan.Fires = true
+		plan.Headers = []string{p.headerName}
+	}
+	return plan
+}
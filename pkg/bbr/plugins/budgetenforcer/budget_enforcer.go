@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgetenforcer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/costestimator"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	BudgetEnforcerPluginType = "budget-enforcer"
+
+	defaultTenantHeader = "X-Tenant-Id"
+	modelField          = "model"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &BudgetEnforcerPlugin{}
+	_ framework.ResponseProcessor = &BudgetEnforcerPlugin{}
+)
+
+// BudgetEnforcerConfig defines the JSON configuration structure for the plugin.
+type BudgetEnforcerConfig struct {
+	// TenantHeader is the request header carrying the tenant ID, e.g. set by the jwks-validator plugin.
+	// Defaults to "X-Tenant-Id".
+	TenantHeader string `json:"tenant_header"`
+	// Prices is the price table charging the tokens used by the responses, as in the cost-estimator plugin.
+	Prices costestimator.PriceTable `json:"prices"`
+	// Budgets maps the tenants to their budget, in USD.
+	Budgets map[string]float64 `json:"budgets"`
+	// ConfigMapNamespace and ConfigMapName identify an optional ConfigMap mapping the tenants to their budget.
+	// The budgets are reloaded from it whenever it changes, replacing the budgets of the configuration.
+	ConfigMapNamespace string `json:"configmap_namespace"`
+	ConfigMapName      string `json:"configmap_name"`
+}
+
+// BudgetEnforcerPluginFactory defines the factory function for NewBudgetEnforcerPlugin.
+func BudgetEnforcerPluginFactory(name string, rawParameters json.RawMessage, handle framework.Handle) (framework.BBRPlugin, error) {
+	config := BudgetEnforcerConfig{TenantHeader: defaultTenantHeader}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", BudgetEnforcerPluginType, err)
+		}
+	}
+	if err := validateBudgets(config.Budgets); err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BudgetEnforcerPluginType, err)
+	}
+	if (config.ConfigMapName == "") != (config.ConfigMapNamespace == "") {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BudgetEnforcerPluginType,
+			errors.New("configmap_namespace and configmap_name must be set together in BudgetEnforcer plugin"))
+	}
+
+	store := NewMemoryBudgetStore()
+	if err := store.SetBudgets(context.Background(), config.Budgets); err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BudgetEnforcerPluginType, err)
+	}
+	plugin, err := NewBudgetEnforcerPlugin(config.TenantHeader, config.Prices, store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", BudgetEnforcerPluginType, err)
+	}
+
+	if config.ConfigMapName != "" {
+		if handle == nil {
+			return nil, fmt.Errorf("failed to create '%s' plugin - %w", BudgetEnforcerPluginType,
+				errors.New("reloading the budgets from a ConfigMap requires a handle"))
+		}
+		if err := registerBudgetsReconciler(handle.ReconcilerBuilder, handle.ClientReader(), name,
+			config.ConfigMapNamespace, config.ConfigMapName, store); err != nil {
+			return nil, fmt.Errorf("failed to register budgets reconciler for plugin '%s' - %w", BudgetEnforcerPluginType, err)
+		}
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewBudgetEnforcerPlugin initializes a new BudgetEnforcerPlugin, enforcing the budgets of the given store,
+// and returns its pointer.
+func NewBudgetEnforcerPlugin(tenantHeader string, prices costestimator.PriceTable, store BudgetStore) (*BudgetEnforcerPlugin, error) {
+	if tenantHeader == "" {
+		return nil, errors.New("tenantHeader cannot be empty in BudgetEnforcer plugin")
+	}
+	if len(prices) == 0 {
+		return nil, errors.New("prices cannot be empty in BudgetEnforcer plugin")
+	}
+	if err := prices.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid prices in BudgetEnforcer plugin - %w", err)
+	}
+	if store == nil {
+		return nil, errors.New("store cannot be nil in BudgetEnforcer plugin")
+	}
+
+	return &BudgetEnforcerPlugin{
+		typedName: plugin.TypedName{
+			Type: BudgetEnforcerPluginType,
+			Name: BudgetEnforcerPluginType,
+		},
+		tenantHeader: tenantHeader,
+		prices:       prices,
+		store:        store,
+	}, nil
+}
+
+// BudgetEnforcerPlugin rejects with HTTP 402 the requests whose cost, estimated by the cost-estimator plugin
+// in the X-Gateway-Estimated-Cost-USD header, exceeds the remaining budget of their tenant. The actual cost
+// of the tokens used by each response is then deducted from the budget of its tenant. Requests without a
+// tenant, or of tenants without a budget, are not limited. When the store is unavailable, requests are let
+// through.
+type BudgetEnforcerPlugin struct {
+	typedName    plugin.TypedName
+	tenantHeader string
+	prices       costestimator.PriceTable
+	store        BudgetStore
+}
+
+// budgetedRequest is the state kept between the request and the response of a tenant with a budget.
+type budgetedRequest struct {
+	tenant string
+	model  string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *BudgetEnforcerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *BudgetEnforcerPlugin) WithName(name string) *BudgetEnforcerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if its estimated cost exceeds the remaining budget of its tenant.
+func (p *BudgetEnforcerPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	tenant := request.GetHeader(p.tenantHeader)
+	if tenant == "" {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	remaining, hasBudget, err := p.store.Remaining(ctx, tenant)
+	if err != nil {
+		logger.Error(err, "Failed to read the remaining budget, letting the request through", "tenant", tenant)
+		return nil
+	}
+	if !hasBudget {
+		return nil
+	}
+
+	// a request without an estimated cost, e.g. for a model without a price, is only rejected once the budget is spent
+	estimatedCost, _ := strconv.ParseFloat(request.GetHeader(costestimator.EstimatedCostHeader), 64)
+	if remaining <= 0 || estimatedCost > remaining {
+		logger.V(logutil.VERBOSE).Info("rejected request exceeding the budget of its tenant", "tenant", tenant,
+			"estimatedCost", estimatedCost, "remaining", remaining)
+		return budgetExceeded(tenant, estimatedCost, remaining)
+	}
+
+	model, _ := request.Body[modelField].(string)
+	cycleState.Write(p.typedName.String(), budgetedRequest{tenant: tenant, model: model})
+	return nil
+}
+
+// ProcessResponse deducts the cost of the tokens used by the response from the budget of its tenant.
+func (p *BudgetEnforcerPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+
+	budgeted, err := framework.ReadCycleStateKey[budgetedRequest](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request is not charged to a budget
+	}
+
+	usage, ok := response.Body["usage"].(map[string]any)
+	if !ok {
+		return nil // e.g. a streamed chunk without usage
+	}
+	cycleState.Delete(p.typedName.String())
+
+	price, ok := p.prices.Lookup(budgeted.model)
+	if !ok {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("model has no price, not charging the response", "model", budgeted.model)
+		return nil
+	}
+	promptTokens, _ := usage["prompt_tokens"].(float64)
+	completionTokens, _ := usage["completion_tokens"].(float64)
+	cost := price.Cost(promptTokens, completionTokens)
+
+	if err := p.store.Deduct(ctx, budgeted.tenant, cost); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to deduct the cost of the response", "tenant", budgeted.tenant, "cost", cost)
+		return nil
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("deducted the cost of the response", "tenant", budgeted.tenant, "cost", cost)
+	return nil
+}
+
+// budgetExceeded returns the HTTP 402 response rejecting a request exceeding the budget of its tenant, with an
+// error body in the OpenAI format.
+func budgetExceeded(tenant string, estimatedCost float64, remaining float64) error {
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message":        fmt.Sprintf("tenant '%s' exceeded its budget", tenant),
+			"type":           "insufficient_quota",
+			"code":           "budget_exceeded",
+			"estimated_cost": estimatedCost,
+			"remaining":      max(remaining, 0),
+		},
+	})
+	return errcommon.ImmediateResponse{
+		Code:    errcommon.PaymentRequired,
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    body,
+	}
+}
+
+// parseBudgets parses the data of a budgets ConfigMap, mapping the tenants to their budget in USD.
+func parseBudgets(data map[string]string) (map[string]float64, error) {
+	budgets := make(map[string]float64, len(data))
+	for tenant, value := range data {
+		budget, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid budget of tenant '%s' - %w", tenant, err)
+		}
+		budgets[tenant] = budget
+	}
+	return budgets, validateBudgets(budgets)
+}
+
+// validateBudgets checks that the budgets are non-negative numbers.
+func validateBudgets(budgets map[string]float64) error {
+	for tenant, budget := range budgets {
+		if budget < 0 || math.IsNaN(budget) {
+			return fmt.Errorf("budget of tenant '%s' must be a non-negative number", tenant)
+		}
+	}
+	return nil
+}
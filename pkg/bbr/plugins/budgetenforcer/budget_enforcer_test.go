@@ -0,0 +1,232 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgetenforcer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/costestimator"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestBudgetEnforcerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"prices": {"llama": {"input_per_1k": 0.001}}, "budgets": {"acme": 10}}`),
+		},
+		{
+			name:      "custom tenant header",
+			rawParams: json.RawMessage(`{"tenant_header": "X-Org", "prices": {"*": {"input_per_1k": 0.001}}}`),
+		},
+		{
+			name:      "missing prices",
+			rawParams: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "empty tenant header",
+			rawParams: json.RawMessage(`{"tenant_header": "", "prices": {"llama": {"input_per_1k": 0.001}}}`),
+			wantErr:   true,
+		},
+		{
+			name:      "negative budget",
+			rawParams: json.RawMessage(`{"prices": {"llama": {"input_per_1k": 0.001}}, "budgets": {"acme": -1}}`),
+			wantErr:   true,
+		},
+		{
+			name:      "configmap name without namespace",
+			rawParams: json.RawMessage(`{"prices": {"llama": {"input_per_1k": 0.001}}, "configmap_name": "budgets"}`),
+			wantErr:   true,
+		},
+		{
+			name:      "configmap without handle",
+			rawParams: json.RawMessage(`{"prices": {"llama": {"input_per_1k": 0.001}}, "configmap_namespace": "default", "configmap_name": "budgets"}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := BudgetEnforcerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+			if got := p.TypedName().Type; got != BudgetEnforcerPluginType {
+				t.Errorf("Type = %q, want %q", got, BudgetEnforcerPluginType)
+			}
+		})
+	}
+}
+
+// process runs a request of the tenant with the given estimated cost through the plugin, and a response with
+// the given usage when the request is forwarded. It returns the immediate response of the plugin, if any.
+func process(t *testing.T, p *BudgetEnforcerPlugin, tenant string, estimatedCost string, usage map[string]any) *errcommon.ImmediateResponse {
+	t.Helper()
+	cycleState := framework.NewCycleState()
+	request := framework.NewInferenceRequest()
+	request.Headers["x-tenant-id"] = tenant
+	if estimatedCost != "" {
+		request.Headers[costestimator.EstimatedCostHeader] = estimatedCost
+	}
+	request.Body = map[string]any{"model": "llama", "prompt": "hello"}
+	err := p.ProcessRequest(context.Background(), cycleState, request)
+	var immediate errcommon.ImmediateResponse
+	if errors.As(err, &immediate) {
+		return &immediate
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	response := framework.NewInferenceResponse()
+	response.Body = map[string]any{"id": "cmpl-1", "usage": usage}
+	if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return nil
+}
+
+func TestBudgetEnforcerPlugin(t *testing.T) {
+	store := NewMemoryBudgetStore()
+	if err := store.SetBudgets(context.Background(), map[string]float64{"acme": 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p, err := NewBudgetEnforcerPlugin(defaultTenantHeader, costestimator.PriceTable{
+		"llama": {InputPer1K: 0.1, OutputPer1K: 0.2},
+	}, store)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	// requests without a tenant, or of tenants without a budget, are not limited
+	if immediate := process(t, p, "", "100", nil); immediate != nil {
+		t.Error("request without a tenant should be forwarded")
+	}
+	if immediate := process(t, p, "globex", "100", nil); immediate != nil {
+		t.Error("request of a tenant without a budget should be forwarded")
+	}
+
+	// 2000 prompt tokens and 1000 completion tokens cost 0.2 + 0.2 USD
+	if immediate := process(t, p, "acme", "0.2", map[string]any{"prompt_tokens": 2000.0, "completion_tokens": 1000.0}); immediate != nil {
+		t.Fatal("request within the budget should be forwarded")
+	}
+	if remaining, _, _ := store.Remaining(context.Background(), "acme"); math.Abs(remaining-0.6) > 1e-9 {
+		t.Errorf("remaining budget = %v, want 0.6", remaining)
+	}
+
+	immediate := process(t, p, "acme", "0.7", nil)
+	if immediate == nil {
+		t.Fatal("request exceeding the remaining budget should be rejected")
+	}
+	if got := errcommon.CanonicalCode(*immediate); got != errcommon.PaymentRequired {
+		t.Errorf("CanonicalCode = %q, want %q", got, errcommon.PaymentRequired)
+	}
+	var body map[string]map[string]any
+	if err := json.Unmarshal(immediate.Body, &body); err != nil {
+		t.Fatalf("body should be JSON, got %s: %v", immediate.Body, err)
+	}
+	if got := body["error"]["code"]; got != "budget_exceeded" {
+		t.Errorf("error code = %v, want %q", got, "budget_exceeded")
+	}
+
+	if immediate := process(t, p, "acme", "0.5", map[string]any{"prompt_tokens": 0.0, "completion_tokens": 4000.0}); immediate != nil {
+		t.Fatal("request within the budget should be forwarded")
+	}
+	// the budget is overspent, so even requests without an estimated cost are rejected
+	if immediate := process(t, p, "acme", "", nil); immediate == nil {
+		t.Error("request of a tenant that spent its budget should be rejected")
+	}
+
+	// reloading the budgets keeps the cost already spent
+	if err := store.SetBudgets(context.Background(), map[string]float64{"acme": 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if immediate := process(t, p, "acme", "0.5", nil); immediate != nil {
+		t.Error("request within the reloaded budget should be forwarded")
+	}
+}
+
+func TestParseBudgets(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    map[string]float64
+		wantErr bool
+	}{
+		{
+			name: "valid budgets",
+			data: map[string]string{"acme": "10", "globex": "2.5"},
+			want: map[string]float64{"acme": 10, "globex": 2.5},
+		},
+		{
+			name:    "not a number",
+			data:    map[string]string{"acme": "ten"},
+			wantErr: true,
+		},
+		{
+			name:    "negative budget",
+			data:    map[string]string{"acme": "-1"},
+			wantErr: true,
+		},
+		{
+			name:    "NaN budget",
+			data:    map[string]string{"acme": "NaN"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseBudgets(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for tenant, want := range tt.want {
+				if got[tenant] != want {
+					t.Errorf("budget of %q = %v, want %v", tenant, got[tenant], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgetenforcer
+
+import (
+	"context"
+	"sync"
+)
+
+// BudgetStore keeps the budgets of the tenants and the costs they spent, in USD, e.g. in memory or in a
+// database shared by the BBR replicas.
+type BudgetStore interface {
+	// Remaining returns the remaining budget of the tenant, and whether the tenant has a budget.
+	Remaining(ctx context.Context, tenant string) (float64, bool, error)
+	// Deduct deducts the cost from the remaining budget of the tenant.
+	Deduct(ctx context.Context, tenant string, cost float64) error
+	// SetBudgets replaces the budgets of the tenants, keeping the costs they already spent.
+	// Tenants missing from the budgets no longer have a budget.
+	SetBudgets(ctx context.Context, budgets map[string]float64) error
+}
+
+// compile-time type validation
+var _ BudgetStore = &MemoryBudgetStore{}
+
+// MemoryBudgetStore is the default in-process BudgetStore. The costs spent by the tenants are lost when BBR
+// restarts.
+type MemoryBudgetStore struct {
+	// budgets maps a tenant to its budget
+	budgets sync.Map
+	// spent maps a tenant to the cost it spent
+	spent sync.Map
+}
+
+// NewMemoryBudgetStore returns an empty MemoryBudgetStore.
+func NewMemoryBudgetStore() *MemoryBudgetStore {
+	return &MemoryBudgetStore{}
+}
+
+// Remaining returns the remaining budget of the tenant, and whether the tenant has a budget.
+func (s *MemoryBudgetStore) Remaining(_ context.Context, tenant string) (float64, bool, error) {
+	budget, ok := s.budgets.Load(tenant)
+	if !ok {
+		return 0, false, nil
+	}
+	spent := 0.0
+	if value, ok := s.spent.Load(tenant); ok {
+		spent = value.(float64)
+	}
+	return budget.(float64) - spent, true, nil
+}
+
+// Deduct atomically adds the cost to the cost spent by the tenant.
+func (s *MemoryBudgetStore) Deduct(_ context.Context, tenant string, cost float64) error {
+	for {
+		spent, loaded := s.spent.LoadOrStore(tenant, cost)
+		if !loaded || s.spent.CompareAndSwap(tenant, spent, spent.(float64)+cost) {
+			return nil
+		}
+	}
+}
+
+// SetBudgets replaces the budgets of the tenants, keeping the costs they already spent.
+func (s *MemoryBudgetStore) SetBudgets(_ context.Context, budgets map[string]float64) error {
+	for tenant, budget := range budgets {
+		s.budgets.Store(tenant, budget)
+	}
+	s.budgets.Range(func(tenant, _ any) bool {
+		if _, ok := budgets[tenant.(string)]; !ok {
+			s.budgets.Delete(tenant)
+		}
+		return true
+	})
+	return nil
+}
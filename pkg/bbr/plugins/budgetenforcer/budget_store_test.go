@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgetenforcer
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestMemoryBudgetStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryBudgetStore()
+	if err := store.SetBudgets(ctx, map[string]float64{"acme": 100, "globex": 5}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := store.Deduct(ctx, "acme", 1); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if remaining, ok, _ := store.Remaining(ctx, "acme"); !ok || remaining != 50 {
+		t.Errorf("Remaining(acme) = %v, %v; want 50, true", remaining, ok)
+	}
+	if remaining, ok, _ := store.Remaining(ctx, "globex"); !ok || remaining != 5 {
+		t.Errorf("Remaining(globex) = %v, %v; want 5, true", remaining, ok)
+	}
+
+	// tenants missing from the new budgets no longer have a budget, and spent costs are kept
+	if err := store.SetBudgets(ctx, map[string]float64{"acme": 60}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if remaining, ok, _ := store.Remaining(ctx, "acme"); !ok || remaining != 10 {
+		t.Errorf("Remaining(acme) = %v, %v; want 10, true", remaining, ok)
+	}
+	if _, ok, _ := store.Remaining(ctx, "globex"); ok {
+		t.Error("globex should no longer have a budget")
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budgetenforcer
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// BudgetsReconciler watches the ConfigMap of the budgets, and replaces the budgets of the BudgetStore with
+// the budgets parsed from its data, mapping tenants to their budget in USD.
+type BudgetsReconciler struct {
+	client.Reader
+	ConfigMap   types.NamespacedName
+	BudgetStore BudgetStore
+}
+
+// registerBudgetsReconciler reloads the budgets of the store whenever the given ConfigMap changes.
+func registerBudgetsReconciler(reconcilerBuilder func() *builder.Builder, clientReader client.Reader, name string,
+	namespace string, configMapName string, store BudgetStore) error {
+	configMap := types.NamespacedName{Namespace: namespace, Name: configMapName}
+	reconciler := &BudgetsReconciler{
+		Reader:      clientReader,
+		ConfigMap:   configMap,
+		BudgetStore: store,
+	}
+	isBudgetsConfigMap := predicate.NewPredicateFuncs(func(object client.Object) bool {
+		return object.GetNamespace() == configMap.Namespace && object.GetName() == configMap.Name
+	})
+
+	// controllers are named after the plugin instance, since they must be unique, e.g. with the configmap
+	// controller of the base-model-to-header plugin
+	return reconcilerBuilder().Named(name).For(&corev1.ConfigMap{}).WithEventFilter(isBudgetsConfigMap).Complete(reconciler)
+}
+
+func (r *BudgetsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Info("Reconciling budgets ConfigMap")
+
+	configmap := &corev1.ConfigMap{}
+	err := r.Get(ctx, req.NamespacedName, configmap)
+	if errors.IsNotFound(err) || (err == nil && !configmap.DeletionTimestamp.IsZero()) {
+		// keep enforcing the last budgets, rather than lifting them all when the ConfigMap is deleted
+		logger.Info("budgets ConfigMap was deleted, keeping the current budgets")
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("unable to get ConfigMap - %w", err)
+	}
+
+	budgets, err := parseBudgets(configmap.Data)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("invalid budgets in ConfigMap - %w", err)
+	}
+	if err := r.BudgetStore.SetBudgets(ctx, budgets); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to set the budgets - %w", err)
+	}
+	logger.Info("reloaded budgets", "tenants", len(budgets))
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celtransform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"google.golang.org/protobuf/types/known/structpb"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	CELTransformPluginType = "cel-transform"
+	bodyVariable           = "body"
+)
+
+var (
+	// bodyType is the CEL type of the request body and of the result of each transform.
+	bodyType = cel.MapType(cel.StringType, cel.DynType)
+
+	// structType is the native type the result of a transform is converted to, which converts numbers
+	// to float64 as when the body is parsed from JSON.
+	structType = reflect.TypeOf(&structpb.Struct{})
+
+	// programs caches the compiled programs by the hash of their expression, and is shared by all
+	// the plugin instances.
+	programs     = map[uint64]cel.Program{}
+	programsLock sync.Mutex
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &CELTransformPlugin{}
+
+// CELTransformConfig defines the JSON configuration structure for the plugin.
+type CELTransformConfig struct {
+	// Transforms are CEL expressions applied in order to the request body, available as the 'body'
+	// variable. Each expression returns the transformed body, e.g. "body.with('model', body.model + '-v2')".
+	Transforms []string `json:"transforms"`
+}
+
+// CELTransformPluginFactory defines the factory function for NewCELTransformPlugin.
+func CELTransformPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := CELTransformConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", CELTransformPluginType, err)
+		}
+	}
+
+	plugin, err := NewCELTransformPlugin(config.Transforms)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", CELTransformPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewCELTransformPlugin initializes a new CELTransformPlugin and returns its pointer.
+// It fails if any of the transforms does not compile or does not return a map.
+func NewCELTransformPlugin(transforms []string) (*CELTransformPlugin, error) {
+	if len(transforms) == 0 {
+		return nil, errors.New("transforms are required in CELTransform plugin")
+	}
+
+	env, err := newEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	compiled := make([]cel.Program, 0, len(transforms))
+	for _, expression := range transforms {
+		program, err := compile(env, expression)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile transform '%s' in CELTransform plugin - %w", expression, err)
+		}
+		compiled = append(compiled, program)
+	}
+
+	return &CELTransformPlugin{
+		typedName: plugin.TypedName{
+			Type: CELTransformPluginType,
+			Name: CELTransformPluginType,
+		},
+		transforms: transforms,
+		programs:   compiled,
+	}, nil
+}
+
+// CELTransformPlugin transforms the request body with a pipeline of CEL expressions, so operators can
+// define simple body transformations without writing Go code. In addition to the standard CEL functions,
+// the expressions can use the map member function with(key, value), which returns a copy of the map
+// with the given field set.
+type CELTransformPlugin struct {
+	typedName  plugin.TypedName
+	transforms []string
+	programs   []cel.Program
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *CELTransformPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *CELTransformPlugin) WithName(name string) *CELTransformPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest applies the transforms to the request body. If a transform fails to evaluate, the
+// request body is forwarded unchanged.
+func (p *CELTransformPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	body := request.Body
+	for i, program := range p.programs {
+		transformed, err := evaluate(program, body)
+		if err != nil {
+			log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to evaluate CEL transform, forwarding the body unchanged", "transform", p.transforms[i])
+			return nil
+		}
+		body = transformed
+	}
+
+	if !reflect.DeepEqual(body, request.Body) {
+		request.SetBody(body)
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("transformed the request body", "transforms", len(p.transforms))
+	}
+	return nil
+}
+
+// evaluate runs the program on the given body and returns the transformed body.
+func evaluate(program cel.Program, body map[string]any) (map[string]any, error) {
+	val, _, err := program.Eval(map[string]any{bodyVariable: body})
+	if err != nil {
+		return nil, err
+	}
+	native, err := val.ConvertToNative(structType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the transform result - %w", err)
+	}
+	return native.(*structpb.Struct).AsMap(), nil
+}
+
+// compile returns the cached program of the expression, or compiles it if it is not cached.
+func compile(env *cel.Env, expression string) (cel.Program, error) {
+	key := xxhash.Sum64String(expression)
+
+	programsLock.Lock()
+	defer programsLock.Unlock()
+
+	if program, ok := programs[key]; ok {
+		return program, nil
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if kind := ast.OutputType().Kind(); kind != types.MapKind && kind != types.DynKind {
+		return nil, fmt.Errorf("the transform must return a map, not %s", ast.OutputType())
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+	programs[key] = program
+	return program, nil
+}
+
+// newEnv returns the CEL environment of the transforms.
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(bodyVariable, bodyType),
+		cel.Function("with",
+			cel.MemberOverload("map_with_string_dyn",
+				[]*cel.Type{bodyType, cel.StringType, cel.DynType}, bodyType,
+				cel.FunctionBinding(withField))),
+	)
+}
+
+// withField returns a copy of the map in args[0] with the field args[1] set to args[2].
+func withField(args ...ref.Val) ref.Val {
+	m, ok := args[0].(traits.Mapper)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(args[0])
+	}
+	fields := map[ref.Val]ref.Val{}
+	for it := m.Iterator(); it.HasNext() == types.True; {
+		key := it.Next()
+		fields[key] = m.Get(key)
+	}
+	fields[args[1]] = args[2]
+	return types.NewRefValMap(types.DefaultTypeAdapter, fields)
+}
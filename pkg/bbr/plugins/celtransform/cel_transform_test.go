@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celtransform
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestCELTransformPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"transforms":["body.with('model', body.model + '-v2')"]}`)},
+		{name: "missing transforms", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "transform does not compile", rawParams: json.RawMessage(`{"transforms":["body.with("]}`), wantErr: true},
+		{name: "transform does not return a map", rawParams: json.RawMessage(`{"transforms":["body.model + '-v2'"]}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := CELTransformPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestCELTransformPlugin(t *testing.T) {
+	tests := []struct {
+		name        string
+		transforms  []string
+		body        map[string]any
+		wantBody    map[string]any
+		wantMutated bool
+	}{
+		{
+			name:        "single transform",
+			transforms:  []string{"body.with('model', body.model + '-v2')"},
+			body:        map[string]any{"model": "llama", "prompt": "hi"},
+			wantBody:    map[string]any{"model": "llama-v2", "prompt": "hi"},
+			wantMutated: true,
+		},
+		{
+			name: "pipeline of transforms",
+			transforms: []string{
+				"body.with('model', body.model + '-v2')",
+				"has(body.temperature) ? body : body.with('temperature', 0.5)",
+				"body.with('max_tokens', body.max_tokens * 2.0)",
+			},
+			body:        map[string]any{"model": "llama", "max_tokens": 100.0},
+			wantBody:    map[string]any{"model": "llama-v2", "max_tokens": 200.0, "temperature": 0.5},
+			wantMutated: true,
+		},
+		{
+			name:       "identity transform",
+			transforms: []string{"body"},
+			body:       map[string]any{"model": "llama", "messages": []any{map[string]any{"role": "user", "content": "hi"}}},
+			wantBody:   map[string]any{"model": "llama", "messages": []any{map[string]any{"role": "user", "content": "hi"}}},
+		},
+		{
+			name:       "failed evaluation leaves the body unchanged",
+			transforms: []string{"body.with('model', body.model + '-v2')"},
+			body:       map[string]any{"prompt": "hi"},
+			wantBody:   map[string]any{"prompt": "hi"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewCELTransformPlugin(tt.transforms)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+			if got := request.BodyMutated(); got != tt.wantMutated {
+				t.Errorf("BodyMutated() = %v, want %v", got, tt.wantMutated)
+			}
+		})
+	}
+}
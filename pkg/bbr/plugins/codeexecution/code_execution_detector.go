@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codeexecution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	CodeExecutionDetectorPluginType = "code-execution-detector"
+	modelField                      = "model"
+
+	// CodeDetectedHeader is set to "true" on requests whose prompt contains code execution.
+	CodeDetectedHeader = "X-BBR-Code-Detected"
+)
+
+// DefaultPatterns are the regular expressions matched against the prompt when none are configured.
+var DefaultPatterns = []string{
+	"```(python|py|bash|sh|shell)\\b",
+	`\bimport\s+subprocess\b`,
+	`\bos\.system\s*\(`,
+	`\bexec\s*\(`,
+	`\beval\s*\(`,
+}
+
+// compile-time type validation
+var _ framework.RequestProcessor = &CodeExecutionDetectorPlugin{}
+
+// CodeExecutionDetectorConfig defines the JSON configuration structure for the plugin.
+type CodeExecutionDetectorConfig struct {
+	// Patterns are the regular expressions that detect code execution in the prompt. Defaults to DefaultPatterns.
+	Patterns []string `json:"patterns"`
+}
+
+// CodeExecutionDetectorPluginFactory defines the factory function for NewCodeExecutionDetectorPlugin.
+func CodeExecutionDetectorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := CodeExecutionDetectorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", CodeExecutionDetectorPluginType, err)
+		}
+	}
+
+	plugin, err := NewCodeExecutionDetectorPlugin(config.Patterns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", CodeExecutionDetectorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewCodeExecutionDetectorPlugin initializes a new CodeExecutionDetectorPlugin and returns its pointer.
+// Empty patterns default to DefaultPatterns.
+func NewCodeExecutionDetectorPlugin(patterns []string) (*CodeExecutionDetectorPlugin, error) {
+	if len(patterns) == 0 {
+		patterns = DefaultPatterns
+	}
+
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q in CodeExecutionDetector plugin - %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+
+	return &CodeExecutionDetectorPlugin{
+		typedName: plugin.TypedName{
+			Type: CodeExecutionDetectorPluginType,
+			Name: CodeExecutionDetectorPluginType,
+		},
+		patterns: compiled,
+	}, nil
+}
+
+// CodeExecutionDetectorPlugin flags requests whose prompt asks for code execution, e.g. with Python or
+// shell snippets, by setting the X-BBR-Code-Detected header, so that stricter policies such as the
+// CodeExecutionRateLimitPlugin can be applied to them. Client provided values of the header are removed.
+type CodeExecutionDetectorPlugin struct {
+	typedName plugin.TypedName
+	patterns  []*regexp.Regexp
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *CodeExecutionDetectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *CodeExecutionDetectorPlugin) WithName(name string) *CodeExecutionDetectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest scans the prompt of the request for code execution.
+func (p *CodeExecutionDetectorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	if !p.detect(promptTexts(request.Body)) {
+		for key := range request.Headers {
+			if strings.EqualFold(key, CodeDetectedHeader) {
+				request.RemoveHeader(key)
+			}
+		}
+		return nil
+	}
+
+	model, _ := request.Body[modelField].(string)
+	metrics.RecordCodeExecutionDetected(model)
+	request.SetHeader(CodeDetectedHeader, "true")
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("detected code execution in the prompt", "model", model)
+	return nil
+}
+
+// detect returns whether any of the texts matches any of the patterns.
+func (p *CodeExecutionDetectorPlugin) detect(texts []string) bool {
+	for _, text := range texts {
+		for _, re := range p.patterns {
+			if re.MatchString(text) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// promptTexts returns the texts of the messages of chat completion requests, or the prompt of completion requests.
+func promptTexts(body map[string]any) []string {
+	return messagetext.Request(body)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codeexecution
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestCodeExecutionDetectorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "custom patterns", rawParams: json.RawMessage(`{"patterns":["\\bsubprocess\\b"]}`)},
+		{name: "invalid pattern", rawParams: json.RawMessage(`{"patterns":["("]}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := CodeExecutionDetectorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestCodeExecutionDetectorPlugin(t *testing.T) {
+	p, err := NewCodeExecutionDetectorPlugin(nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		body         map[string]any
+		headers      map[string]string
+		wantDetected bool
+		wantRemoved  bool
+	}{
+		{
+			name:         "python code block",
+			body:         map[string]any{"messages": []any{map[string]any{"role": "user", "content": "run this:\n```python\nprint(1)\n```"}}},
+			wantDetected: true,
+		},
+		{
+			name: "subprocess import in a text part",
+			body: map[string]any{"messages": []any{map[string]any{"role": "user", "content": []any{
+				map[string]any{"type": "text", "text": "import subprocess; subprocess.run(['ls'])"},
+			}}}},
+			wantDetected: true,
+		},
+		{
+			name:         "exec call in a completion prompt",
+			body:         map[string]any{"prompt": "exec(open('x').read())"},
+			wantDetected: true,
+		},
+		{
+			name: "plain prompt",
+			body: map[string]any{"messages": []any{map[string]any{"role": "user", "content": "Explain how to execute a plan."}}},
+		},
+		{
+			name:        "client provided header is removed",
+			body:        map[string]any{"prompt": "hello"},
+			headers:     map[string]string{"x-bbr-code-detected": "true"},
+			wantRemoved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+			for key, value := range tt.headers {
+				request.Headers[key] = value
+			}
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.GetHeader(CodeDetectedHeader) == "true"; got != tt.wantDetected {
+				t.Errorf("%s set = %v, want %v", CodeDetectedHeader, got, tt.wantDetected)
+			}
+			if got := len(request.RemovedHeaders()) > 0; got != tt.wantRemoved {
+				t.Errorf("header removed = %v, want %v", got, tt.wantRemoved)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codeexecution
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	CodeExecutionRateLimitPluginType = "code-execution-rate-limit"
+	APIKeyHeader                     = "X-API-Key"
+
+	window = time.Minute
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &CodeExecutionRateLimitPlugin{}
+
+// CodeExecutionRateLimitConfig defines the JSON configuration structure for the plugin.
+type CodeExecutionRateLimitConfig struct {
+	// MaxRequestsPerMinute is the number of requests with code execution a single client may send per minute.
+	MaxRequestsPerMinute int `json:"max_requests_per_minute"`
+}
+
+// CodeExecutionRateLimitPluginFactory defines the factory function for NewCodeExecutionRateLimitPlugin.
+func CodeExecutionRateLimitPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config CodeExecutionRateLimitConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", CodeExecutionRateLimitPluginType, err)
+		}
+	}
+
+	plugin, err := NewCodeExecutionRateLimitPlugin(config.MaxRequestsPerMinute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", CodeExecutionRateLimitPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewCodeExecutionRateLimitPlugin initializes a new CodeExecutionRateLimitPlugin and returns its pointer.
+func NewCodeExecutionRateLimitPlugin(maxRequestsPerMinute int) (*CodeExecutionRateLimitPlugin, error) {
+	if maxRequestsPerMinute <= 0 {
+		return nil, errors.New("max_requests_per_minute must be positive in CodeExecutionRateLimit plugin")
+	}
+
+	return &CodeExecutionRateLimitPlugin{
+		typedName: plugin.TypedName{
+			Type: CodeExecutionRateLimitPluginType,
+			Name: CodeExecutionRateLimitPluginType,
+		},
+		budget:  maxRequestsPerMinute,
+		clients: map[string][]time.Time{},
+		now:     time.Now,
+	}, nil
+}
+
+// CodeExecutionRateLimitPlugin limits the number of requests flagged by the CodeExecutionDetectorPlugin
+// that each client (identified by its X-API-Key header) may send in a sliding window of one minute.
+// It must run after the detector. Requests exceeding the budget are rejected with HTTP 429.
+type CodeExecutionRateLimitPlugin struct {
+	typedName plugin.TypedName
+	budget    int
+
+	lock      sync.Mutex
+	clients   map[string][]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *CodeExecutionRateLimitPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *CodeExecutionRateLimitPlugin) WithName(name string) *CodeExecutionRateLimitPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if it contains code execution and the client exceeded its budget.
+func (p *CodeExecutionRateLimitPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	if request.GetHeader(CodeDetectedHeader) != "true" {
+		return nil
+	}
+
+	if !p.allow(request.GetHeader(APIKeyHeader)) {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("code execution budget exceeded", "budget", p.budget)
+		return errcommon.Error{Code: errcommon.ResourceExhausted, Msg: fmt.Sprintf("code execution budget of %d requests per minute exceeded", p.budget)}
+	}
+
+	return nil
+}
+
+// allow records a request for the client if it fits in the remaining budget.
+func (p *CodeExecutionRateLimitPlugin) allow(apiKey string) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+	p.sweep(now)
+
+	requests := p.clients[apiKey]
+	// drop the requests that left the sliding window
+	first := 0
+	for first < len(requests) && now.Sub(requests[first]) >= window {
+		first++
+	}
+	requests = requests[first:]
+
+	if len(requests) >= p.budget {
+		p.clients[apiKey] = requests
+		return false
+	}
+
+	p.clients[apiKey] = append(requests, now)
+	return true
+}
+
+// sweep forgets the clients that sent no requests during the last window, at most once per window.
+func (p *CodeExecutionRateLimitPlugin) sweep(now time.Time) {
+	if now.Sub(p.lastSweep) < window {
+		return
+	}
+	p.lastSweep = now
+	for apiKey, requests := range p.clients {
+		if len(requests) == 0 || now.Sub(requests[len(requests)-1]) >= window {
+			delete(p.clients, apiKey)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package codeexecution
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestCodeExecutionRateLimitPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"max_requests_per_minute":10}`)},
+		{name: "missing budget", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := CodeExecutionRateLimitPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestCodeExecutionRateLimitPlugin_ProcessRequest(t *testing.T) {
+	p, err := NewCodeExecutionRateLimitPlugin(2)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	steps := []struct {
+		name     string
+		apiKey   string
+		detected bool
+		advance  time.Duration
+		wantErr  bool
+	}{
+		{name: "first request within budget", apiKey: "a", detected: true},
+		{name: "request without code is not counted", apiKey: "a"},
+		{name: "second request exhausts budget", apiKey: "a", detected: true},
+		{name: "third request is rejected", apiKey: "a", detected: true, wantErr: true},
+		{name: "request without code is still allowed", apiKey: "a"},
+		{name: "other client has its own budget", apiKey: "b", detected: true},
+		{name: "budget is restored after the window", apiKey: "a", detected: true, advance: time.Minute},
+	}
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		request := framework.NewInferenceRequest()
+		request.Headers["x-api-key"] = step.apiKey
+		if step.detected {
+			request.SetHeader(CodeDetectedHeader, "true")
+		}
+
+		err := p.ProcessRequest(context.Background(), nil, request)
+		if step.wantErr {
+			if got := errcommon.CanonicalCode(err); got != errcommon.ResourceExhausted {
+				t.Errorf("%s: CanonicalCode = %q, want %q", step.name, got, errcommon.ResourceExhausted)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contentnegotiation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ContentTypeNegotiationPluginType = "content-type-negotiation"
+	streamField                      = "stream"
+
+	acceptHeader      = "Accept"
+	contentTypeHeader = "Content-Type"
+
+	jsonContentType        = "application/json"
+	eventStreamContentType = "text/event-stream"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &ContentTypeNegotiationPlugin{}
+	_ framework.ResponseProcessor = &ContentTypeNegotiationPlugin{}
+)
+
+// ContentTypeNegotiationPluginFactory defines the factory function for NewContentTypeNegotiationPlugin.
+func ContentTypeNegotiationPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewContentTypeNegotiationPlugin().WithName(name), nil
+}
+
+// NewContentTypeNegotiationPlugin initializes a new ContentTypeNegotiationPlugin and returns its pointer.
+func NewContentTypeNegotiationPlugin() *ContentTypeNegotiationPlugin {
+	return &ContentTypeNegotiationPlugin{
+		typedName: plugin.TypedName{
+			Type: ContentTypeNegotiationPluginType,
+			Name: ContentTypeNegotiationPluginType,
+		},
+	}
+}
+
+// ContentTypeNegotiationPlugin rejects requests with HTTP 406 when their Accept header does not accept
+// the content type of the response, i.e. text/event-stream for streaming requests and application/json
+// otherwise, instead of sending the client a response it can not consume. Responses without a
+// Content-Type header get the negotiated content type.
+type ContentTypeNegotiationPlugin struct {
+	typedName plugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ContentTypeNegotiationPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ContentTypeNegotiationPlugin) WithName(name string) *ContentTypeNegotiationPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest checks that the client accepts the content type of the response.
+func (p *ContentTypeNegotiationPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	contentType := jsonContentType
+	if stream, _ := request.Body[streamField].(bool); stream {
+		contentType = eventStreamContentType
+	}
+
+	if accept := request.GetHeader(acceptHeader); accept != "" && quality(accept, contentType) <= 0 {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("the response content type is not acceptable", "accept", accept, "contentType", contentType)
+		return errcommon.Error{Code: errcommon.NotAcceptable, Msg: fmt.Sprintf("the response content type %s is not acceptable", contentType)}
+	}
+
+	if cycleState != nil {
+		cycleState.Write(p.typedName.String(), contentType)
+	}
+	return nil
+}
+
+// ProcessResponse sets the negotiated content type on responses that lack one.
+func (p *ContentTypeNegotiationPlugin) ProcessResponse(_ context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Headers == nil || cycleState == nil {
+		return nil
+	}
+	if response.GetHeader(contentTypeHeader) != "" {
+		return nil
+	}
+
+	contentType, err := framework.ReadCycleStateKey[string](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not negotiated by the plugin
+	}
+	response.SetHeader(contentTypeHeader, contentType)
+	return nil
+}
+
+// quality returns the quality the Accept header assigns to the given media type, following RFC 9110:
+// the most specific matching media range applies, and ranges without a q parameter have quality 1.
+// Zero means the media type is not acceptable.
+func quality(accept, mediaType string) float64 {
+	mainType, _, _ := strings.Cut(mediaType, "/")
+
+	best, bestSpecificity := 0.0, 0
+	for _, mediaRange := range strings.Split(accept, ",") {
+		rangeType, params, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		specificity := 0
+		switch {
+		case rangeType == mediaType:
+			specificity = 3
+		case rangeType == mainType+"/*":
+			specificity = 2
+		case rangeType == "*/*":
+			specificity = 1
+		}
+		if specificity <= bestSpecificity {
+			continue
+		}
+
+		q := 1.0
+		if rawQ, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(rawQ, 64); err != nil {
+				continue
+			}
+		}
+		best, bestSpecificity = q, specificity
+	}
+	return best
+}
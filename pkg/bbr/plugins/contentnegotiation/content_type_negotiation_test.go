@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contentnegotiation
+
+import (
+	"context"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestContentTypeNegotiationPluginFactory(t *testing.T) {
+	p, err := ContentTypeNegotiationPluginFactory("my-plugin", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.TypedName().Name; got != "my-plugin" {
+		t.Errorf("Name = %q, want %q", got, "my-plugin")
+	}
+}
+
+func TestContentTypeNegotiationPlugin(t *testing.T) {
+	tests := []struct {
+		name            string
+		accept          string
+		stream          bool
+		responseType    string
+		wantCode        string
+		wantContentType string
+	}{
+		{name: "no accept header", wantContentType: "application/json"},
+		{name: "json is accepted", accept: "application/json", wantContentType: "application/json"},
+		{name: "wildcard is accepted", accept: "text/html, */*;q=0.1", wantContentType: "application/json"},
+		{name: "html only is not acceptable", accept: "text/html", wantCode: errcommon.NotAcceptable},
+		{name: "json with zero quality is not acceptable", accept: "application/json;q=0, */*", wantCode: errcommon.NotAcceptable},
+		{name: "event stream for streaming requests", accept: "text/*", stream: true, wantContentType: "text/event-stream"},
+		{name: "json only is not acceptable for streaming requests", accept: "application/json", stream: true, wantCode: errcommon.NotAcceptable},
+		{name: "response content type is kept", accept: "application/*", responseType: "application/json; charset=utf-8", wantContentType: "application/json; charset=utf-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewContentTypeNegotiationPlugin()
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			if tt.accept != "" {
+				request.Headers["accept"] = tt.accept
+			}
+			request.Body["stream"] = tt.stream
+
+			err := p.ProcessRequest(context.Background(), cycleState, request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := framework.NewInferenceResponse()
+			if tt.responseType != "" {
+				response.Headers["content-type"] = tt.responseType
+			}
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := response.GetHeader("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Type = %q, want %q", got, tt.wantContentType)
+			}
+		})
+	}
+}
+
+func TestQuality(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   float64
+	}{
+		{accept: "application/json", want: 1},
+		{accept: "application/json;q=0.5", want: 0.5},
+		{accept: "*/*;q=0.2, application/*;q=0.7", want: 0.7},
+		{accept: "application/json;q=0.3, */*", want: 0.3},
+		{accept: "text/html", want: 0},
+		{accept: "invalid;;", want: 0},
+	}
+	for _, tt := range tests {
+		if got := quality(tt.accept, "application/json"); got != tt.want {
+			t.Errorf("quality(%q) = %v, want %v", tt.accept, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversationbudget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/credential"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ConversationBudgetPluginType = "conversation-budget"
+	ConversationIDHeader         = "X-Conversation-Id"
+
+	defaultTTLSeconds       = 24 * 60 * 60
+	defaultMaxConversations = 100000
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &ConversationBudgetPlugin{}
+	_ framework.ResponseProcessor = &ConversationBudgetPlugin{}
+)
+
+// ConversationBudgetConfig defines the JSON configuration structure for the plugin.
+type ConversationBudgetConfig struct {
+	// MaxCost is the budget of a conversation, in the same currency as the token costs.
+	MaxCost float64 `json:"max_cost"`
+	// PromptTokenCost is the cost of a single prompt token.
+	PromptTokenCost float64 `json:"prompt_token_cost"`
+	// CompletionTokenCost is the cost of a single completion token.
+	CompletionTokenCost float64 `json:"completion_token_cost"`
+	// TTLSeconds is how long the cost of an idle conversation is kept. Defaults to 24 hours.
+	TTLSeconds int `json:"ttl_seconds"`
+	// MaxConversations bounds the number of conversations kept in memory. Defaults to 100000.
+	MaxConversations int `json:"max_conversations"`
+}
+
+// ConversationBudgetPluginFactory defines the factory function for NewConversationBudgetPlugin.
+func ConversationBudgetPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ConversationBudgetConfig{
+		TTLSeconds:       defaultTTLSeconds,
+		MaxConversations: defaultMaxConversations,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ConversationBudgetPluginType, err)
+		}
+	}
+
+	plugin, err := NewConversationBudgetPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ConversationBudgetPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewConversationBudgetPlugin initializes a new ConversationBudgetPlugin and returns its pointer.
+func NewConversationBudgetPlugin(config ConversationBudgetConfig) (*ConversationBudgetPlugin, error) {
+	if config.MaxCost <= 0 {
+		return nil, errors.New("max_cost must be positive in ConversationBudget plugin")
+	}
+	if config.PromptTokenCost < 0 || config.CompletionTokenCost < 0 {
+		return nil, errors.New("token costs must not be negative in ConversationBudget plugin")
+	}
+	if config.PromptTokenCost == 0 && config.CompletionTokenCost == 0 {
+		return nil, errors.New("at least one of prompt_token_cost and completion_token_cost is required in ConversationBudget plugin")
+	}
+	if config.TTLSeconds <= 0 || config.MaxConversations <= 0 {
+		return nil, errors.New("ttl_seconds and max_conversations must be positive in ConversationBudget plugin")
+	}
+
+	return &ConversationBudgetPlugin{
+		typedName: plugin.TypedName{
+			Type: ConversationBudgetPluginType,
+			Name: ConversationBudgetPluginType,
+		},
+		maxCost:             config.MaxCost,
+		promptTokenCost:     config.PromptTokenCost,
+		completionTokenCost: config.CompletionTokenCost,
+		costs:               expirable.NewLRU[string, float64](config.MaxConversations, nil, time.Duration(config.TTLSeconds)*time.Second),
+	}, nil
+}
+
+// ConversationBudgetPlugin caps the total token cost of a conversation, identified by the
+// X-Conversation-Id header and the credentials of the request, so that clients can't exhaust the budget
+// of the conversations of other clients. The cost of each response is added to its conversation, and
+// requests of conversations that exhausted their budget are rejected with HTTP 402.
+type ConversationBudgetPlugin struct {
+	typedName           plugin.TypedName
+	maxCost             float64
+	promptTokenCost     float64
+	completionTokenCost float64
+
+	// costs maps a conversation, the credential hash and id of the conversation, to its cumulative cost.
+	costs *expirable.LRU[string, float64]
+	// lock makes the read-modify-write of a conversation cost atomic.
+	lock sync.Mutex
+}
+
+// conversation identifies the conversation of a request, for ProcessResponse.
+type conversation struct {
+	// key is the key of the conversation cost, scoped by the credentials of the request.
+	key string
+	id  string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ConversationBudgetPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ConversationBudgetPlugin) WithName(name string) *ConversationBudgetPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects requests of conversations that exhausted their budget.
+func (p *ConversationBudgetPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	conversationID := request.GetHeader(ConversationIDHeader)
+	if conversationID == "" {
+		return nil
+	}
+
+	key := credential.Hash(request) + "/" + conversationID
+	p.lock.Lock()
+	cost, _ := p.costs.Get(key)
+	p.lock.Unlock()
+
+	if cost >= p.maxCost {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected request of a conversation that exhausted its budget", "conversationId", conversationID, "cost", cost)
+		return errcommon.Error{Code: errcommon.PaymentRequired, Msg: fmt.Sprintf("conversation '%s' exceeded its budget", conversationID)}
+	}
+
+	cycleState.Write(p.typedName.String(), conversation{key: key, id: conversationID})
+	return nil
+}
+
+// ProcessResponse adds the cost of the response to its conversation.
+func (p *ConversationBudgetPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+
+	c, err := framework.ReadCycleStateKey[conversation](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request is not part of a conversation
+	}
+
+	usage, ok := response.Body["usage"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	promptTokens, _ := usage["prompt_tokens"].(float64)
+	completionTokens, _ := usage["completion_tokens"].(float64)
+	responseCost := promptTokens*p.promptTokenCost + completionTokens*p.completionTokenCost
+
+	p.lock.Lock()
+	cost, _ := p.costs.Get(c.key)
+	cost += responseCost
+	p.costs.Add(c.key, cost)
+	p.lock.Unlock()
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("updated conversation cost", "conversationId", c.id, "cost", cost, "maxCost", p.maxCost)
+	return nil
+}
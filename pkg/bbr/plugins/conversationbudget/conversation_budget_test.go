@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversationbudget
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestConversationBudgetPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"max_cost":1,"prompt_token_cost":0.00001,"completion_token_cost":0.00003}`)},
+		{name: "missing max cost", rawParams: json.RawMessage(`{"prompt_token_cost":0.00001}`), wantErr: true},
+		{name: "missing token costs", rawParams: json.RawMessage(`{"max_cost":1}`), wantErr: true},
+		{name: "negative token cost", rawParams: json.RawMessage(`{"max_cost":1,"prompt_token_cost":-1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ConversationBudgetPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestConversationBudgetPlugin(t *testing.T) {
+	p, err := NewConversationBudgetPlugin(ConversationBudgetConfig{
+		MaxCost:             1,
+		PromptTokenCost:     0.001,
+		CompletionTokenCost: 0.002,
+		TTLSeconds:          60,
+		MaxConversations:    10,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	// roundTrip runs a request of the conversation through the plugin, with a response costing 0.4.
+	roundTripWithKey := func(apiKey, conversationID string) error {
+		cycleState := framework.NewCycleState()
+		request := framework.NewInferenceRequest()
+		request.Headers["x-api-key"] = apiKey
+		if conversationID != "" {
+			request.Headers["x-conversation-id"] = conversationID
+		}
+		if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+			return err
+		}
+		response := framework.NewInferenceResponse()
+		response.Body["usage"] = map[string]any{"prompt_tokens": 200.0, "completion_tokens": 100.0}
+		return p.ProcessResponse(context.Background(), cycleState, response)
+	}
+	roundTrip := func(conversationID string) error {
+		return roundTripWithKey("key-1", conversationID)
+	}
+
+	// 0.4 + 0.4 + 0.4 exceeds the budget of 1 after the third response
+	for i := range 3 {
+		if err := roundTrip("conversation-a"); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+	err = roundTrip("conversation-a")
+	if got := errcommon.CanonicalCode(err); got != errcommon.PaymentRequired {
+		t.Fatalf("CanonicalCode = %q, want %q", got, errcommon.PaymentRequired)
+	}
+
+	if err := roundTrip("conversation-b"); err != nil {
+		t.Errorf("other conversations should not be affected: %v", err)
+	}
+	if err := roundTripWithKey("key-2", "conversation-a"); err != nil {
+		t.Errorf("conversations of other clients should not be affected: %v", err)
+	}
+	for range 5 {
+		if err := roundTrip(""); err != nil {
+			t.Errorf("requests without a conversation should not be limited: %v", err)
+		}
+	}
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversationsummarizer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/conversationbudget"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ConversationSummarizerPluginType = "conversation-summarizer"
+	messagesField                    = "messages"
+	systemRole                       = "system"
+	toolRole                         = "tool"
+
+	// charsPerToken is the rough number of characters per token used to estimate the token count.
+	charsPerToken = 4
+
+	defaultBufferTokens      = 1024
+	defaultSummarizeMessages = 10
+	defaultTimeoutSeconds    = 30
+	defaultCacheTTLSeconds   = 3600
+	maxCachedSummaries       = 10000
+	summaryPrefix            = "Summary of the earlier conversation: "
+	summarizationInstruction = "Summarize the following conversation concisely, keeping the facts, decisions and open questions needed to continue it."
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ConversationSummarizerPlugin{}
+
+// ConversationSummarizerConfig defines the JSON configuration structure for the plugin.
+type ConversationSummarizerConfig struct {
+	// Endpoint is the URL of the OpenAI compatible chat completions endpoint that summarizes the messages,
+	// e.g. "http://summarizer.default.svc:8000/v1/chat/completions".
+	Endpoint string `json:"endpoint"`
+	// Model is the model of the summarization requests.
+	Model string `json:"model"`
+	// MaxContextTokens is the context window of the served models, in tokens.
+	MaxContextTokens int `json:"max_context_tokens"`
+	// BufferTokens is the number of tokens kept free for the completion. Defaults to 1024.
+	BufferTokens int `json:"buffer_tokens"`
+	// SummarizeMessages is the number of oldest non-system messages replaced by the summary. Defaults to 10.
+	SummarizeMessages int `json:"summarize_messages"`
+	// TimeoutSeconds bounds the summarization request. Defaults to 30.
+	TimeoutSeconds int `json:"timeout_seconds"`
+	// CacheTTLSeconds is the duration the summaries of a conversation are cached. Defaults to 3600.
+	CacheTTLSeconds int `json:"cache_ttl_seconds"`
+}
+
+// ConversationSummarizerPluginFactory defines the factory function for NewConversationSummarizerPlugin.
+func ConversationSummarizerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ConversationSummarizerConfig{
+		BufferTokens:      defaultBufferTokens,
+		SummarizeMessages: defaultSummarizeMessages,
+		TimeoutSeconds:    defaultTimeoutSeconds,
+		CacheTTLSeconds:   defaultCacheTTLSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ConversationSummarizerPluginType, err)
+		}
+	}
+
+	plugin, err := NewConversationSummarizerPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ConversationSummarizerPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewConversationSummarizerPlugin initializes a new ConversationSummarizerPlugin and returns its pointer.
+func NewConversationSummarizerPlugin(config ConversationSummarizerConfig) (*ConversationSummarizerPlugin, error) {
+	if config.Endpoint == "" {
+		return nil, errors.New("endpoint is required in ConversationSummarizer plugin")
+	}
+	if _, err := url.ParseRequestURI(config.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid endpoint in ConversationSummarizer plugin - %w", err)
+	}
+	if config.BufferTokens < 0 || config.MaxContextTokens <= config.BufferTokens {
+		return nil, errors.New("max_context_tokens must be larger than buffer_tokens, which must not be negative, in ConversationSummarizer plugin")
+	}
+	if config.SummarizeMessages < 2 {
+		return nil, errors.New("summarize_messages must be at least 2 in ConversationSummarizer plugin")
+	}
+	if config.TimeoutSeconds <= 0 {
+		return nil, errors.New("timeout_seconds must be positive in ConversationSummarizer plugin")
+	}
+	if config.CacheTTLSeconds <= 0 {
+		return nil, errors.New("cache_ttl_seconds must be positive in ConversationSummarizer plugin")
+	}
+
+	return &ConversationSummarizerPlugin{
+		typedName: plugin.TypedName{
+			Type: ConversationSummarizerPluginType,
+			Name: ConversationSummarizerPluginType,
+		},
+		endpoint:          config.Endpoint,
+		model:             config.Model,
+		thresholdTokens:   config.MaxContextTokens - config.BufferTokens,
+		summarizeMessages: config.SummarizeMessages,
+		client:            &http.Client{Timeout: time.Duration(config.TimeoutSeconds) * time.Second},
+		summaries:         expirable.NewLRU[string, string](maxCachedSummaries, nil, time.Duration(config.CacheTTLSeconds)*time.Second),
+	}, nil
+}
+
+// ConversationSummarizerPlugin keeps long conversations within the context window of the models. When the
+// estimated token count of the messages exceeds the context window minus a buffer, the oldest non-system
+// messages are sent to a summarization endpoint and replaced with a system message holding their summary.
+// An assistant message calling tools is never separated from the tool messages holding the results.
+// Summaries are cached by conversation (identified by its X-Conversation-Id header), so each prefix of a
+// conversation is summarized once. If the summarization fails, the request is forwarded unchanged.
+type ConversationSummarizerPlugin struct {
+	typedName         plugin.TypedName
+	endpoint          string
+	model             string
+	thresholdTokens   int
+	summarizeMessages int
+	client            *http.Client
+	// summaries maps a conversation and the hash of its summarized messages to their summary
+	summaries *expirable.LRU[string, string]
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ConversationSummarizerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ConversationSummarizerPlugin) WithName(name string) *ConversationSummarizerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest replaces the oldest messages of long conversations with their summary.
+func (p *ConversationSummarizerPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	messages, ok := request.Body[messagesField].([]any)
+	if !ok || estimateTokens(messages) <= p.thresholdTokens {
+		return nil
+	}
+
+	// keep the leading system messages, and at least the last message
+	first := 0
+	for first < len(messages) && role(messages[first]) == systemRole {
+		first++
+	}
+	last := min(first+p.summarizeMessages, len(messages)-1)
+	// the tool results are summarized with the assistant message calling the tools
+	for last < len(messages) && role(messages[last]) == toolRole {
+		last++
+	}
+	if last == len(messages) {
+		// the conversation ends with tool results, which are kept with the assistant message calling the tools
+		for last > first && role(messages[last-1]) == toolRole {
+			last--
+		}
+		last = max(last-1, first)
+	}
+	if last-first < 2 {
+		return nil // not enough messages to summarize
+	}
+	oldest := messages[first:last]
+
+	logger := log.FromContext(ctx)
+	summary, err := p.summary(ctx, request.GetHeader(conversationbudget.ConversationIDHeader), oldest)
+	if err != nil {
+		logger.V(logutil.DEFAULT).Error(err, "failed to summarize the conversation, forwarding it unchanged")
+		return nil
+	}
+
+	summarized := make([]any, 0, len(messages)-len(oldest)+1)
+	summarized = append(summarized, messages[:first]...)
+	summarized = append(summarized, map[string]any{"role": systemRole, "content": summaryPrefix + summary})
+	summarized = append(summarized, messages[last:]...)
+	request.SetBodyField(messagesField, summarized)
+	logger.V(logutil.VERBOSE).Info("summarized the oldest messages of the conversation", "messages", len(oldest))
+	return nil
+}
+
+// summary returns the summary of the messages, from the cache of the conversation or the summarization endpoint.
+func (p *ConversationSummarizerPlugin) summary(ctx context.Context, conversationID string, messages []any) (string, error) {
+	transcript := transcript(messages)
+
+	var key string
+	if conversationID != "" {
+		key = fmt.Sprintf("%s/%x", conversationID, xxhash.Sum64String(transcript))
+		if summary, ok := p.summaries.Get(key); ok {
+			return summary, nil
+		}
+	}
+
+	summary, err := p.summarize(ctx, transcript)
+	if err != nil {
+		return "", err
+	}
+	if key != "" {
+		p.summaries.Add(key, summary)
+	}
+	return summary, nil
+}
+
+// summarize sends the transcript to the summarization endpoint and returns the generated summary.
+func (p *ConversationSummarizerPlugin) summarize(ctx context.Context, transcript string) (string, error) {
+	body := map[string]any{
+		"messages": []any{
+			map[string]any{"role": systemRole, "content": summarizationInstruction},
+			map[string]any{"role": "user", "content": transcript},
+		},
+	}
+	if p.model != "" {
+		body["model"] = p.model
+	}
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(raw))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	raw, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var completion struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+	}
+	if err := json.Unmarshal(raw, &completion); err != nil {
+		return "", err
+	}
+	if len(completion.Choices) == 0 || completion.Choices[0].Message.Content == "" {
+		return "", errors.New("empty summary")
+	}
+	return completion.Choices[0].Message.Content, nil
+}
+
+// transcript renders the messages as "role: content" lines.
+func transcript(messages []any) string {
+	var out strings.Builder
+	for _, raw := range messages {
+		fmt.Fprintf(&out, "%s: %s\n", role(raw), text(raw))
+	}
+	return out.String()
+}
+
+// estimateTokens estimates the number of tokens of the messages from the length of their text.
+func estimateTokens(messages []any) int {
+	chars := 0
+	for _, raw := range messages {
+		chars += len(text(raw))
+	}
+	return chars / charsPerToken
+}
+
+// role returns the role of a message.
+func role(raw any) string {
+	message, _ := raw.(map[string]any)
+	role, _ := message["role"].(string)
+	return role
+}
+
+// text returns the text content of a message, joining the text parts of multi-part contents.
+func text(raw any) string {
+	message, _ := raw.(map[string]any)
+	switch content := message["content"].(type) {
+	case string:
+		return content
+	case []any:
+		var texts []string
+		for _, part := range content {
+			if textPart, ok := part.(map[string]any); ok {
+				if t, ok := textPart["text"].(string); ok {
+					texts = append(texts, t)
+				}
+			}
+		}
+		return strings.Join(texts, "\n")
+	}
+	return ""
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversationsummarizer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestConversationSummarizerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"endpoint":"http://summarizer:8000/v1/chat/completions","max_context_tokens":8192}`)},
+		{name: "missing endpoint", rawParams: json.RawMessage(`{"max_context_tokens":8192}`), wantErr: true},
+		{name: "invalid endpoint", rawParams: json.RawMessage(`{"endpoint":"summarizer","max_context_tokens":8192}`), wantErr: true},
+		{name: "context smaller than buffer", rawParams: json.RawMessage(`{"endpoint":"http://summarizer:8000","max_context_tokens":512}`), wantErr: true},
+		{name: "too few messages to summarize", rawParams: json.RawMessage(`{"endpoint":"http://summarizer:8000","max_context_tokens":8192,"summarize_messages":1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ConversationSummarizerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func message(role, content string) map[string]any {
+	return map[string]any{"role": role, "content": content}
+}
+
+func TestConversationSummarizerPlugin(t *testing.T) {
+	var calls atomic.Int32
+	var status atomic.Int32
+	status.Store(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["model"] != "summarizer" {
+			t.Errorf("summarization model = %v, want %q", body["model"], "summarizer")
+		}
+		w.WriteHeader(int(status.Load()))
+		_ = json.NewEncoder(w).Encode(map[string]any{"choices": []any{
+			map[string]any{"message": map[string]any{"role": "assistant", "content": "the user asked about A and B"}},
+		}})
+	}))
+	defer server.Close()
+
+	p, err := NewConversationSummarizerPlugin(ConversationSummarizerConfig{
+		Endpoint:          server.URL,
+		Model:             "summarizer",
+		MaxContextTokens:  100,
+		BufferTokens:      20,
+		SummarizeMessages: 2,
+		TimeoutSeconds:    5,
+		CacheTTLSeconds:   60,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	long := strings.Repeat("x", 100) // 25 tokens
+	longConversation := func() []any {
+		return []any{
+			message("system", "be nice"),
+			message("user", long),
+			message("assistant", long),
+			message("user", long),
+			message("assistant", long),
+		}
+	}
+	send := func(messages []any) *framework.InferenceRequest {
+		request := framework.NewInferenceRequest()
+		request.Headers["x-conversation-id"] = "conversation-1"
+		request.Body["messages"] = messages
+		if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return request
+	}
+
+	short := []any{message("user", "hi")}
+	if request := send(short); request.BodyMutated() || calls.Load() != 0 {
+		t.Errorf("short conversation was summarized")
+	}
+
+	want := []any{
+		message("system", "be nice"),
+		message("system", "Summary of the earlier conversation: the user asked about A and B"),
+		message("user", long),
+		message("assistant", long),
+	}
+	request := send(longConversation())
+	if diff := cmp.Diff(want, request.Body["messages"]); diff != "" {
+		t.Errorf("messages mismatch (-want +got):\n%s", diff)
+	}
+
+	// the summary of the same messages is cached
+	request = send(longConversation())
+	if diff := cmp.Diff(want, request.Body["messages"]); diff != "" {
+		t.Errorf("cached messages mismatch (-want +got):\n%s", diff)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("summarization calls = %d, want 1", got)
+	}
+
+	// tool results are not separated from the assistant message calling the tools
+	toolCall := map[string]any{"role": "assistant", "content": long, "tool_calls": []any{map[string]any{"id": "call-1"}}}
+	toolResult := map[string]any{"role": "tool", "content": long, "tool_call_id": "call-1"}
+	request = send([]any{message("user", long), toolCall, toolResult, message("user", long), message("assistant", long)})
+	want = []any{
+		message("system", "Summary of the earlier conversation: the user asked about A and B"),
+		message("user", long),
+		message("assistant", long),
+	}
+	if diff := cmp.Diff(want, request.Body["messages"]); diff != "" {
+		t.Errorf("messages with tool results mismatch (-want +got):\n%s", diff)
+	}
+	request = send([]any{message("user", long), message("user", long), toolCall, toolResult, toolResult})
+	want = []any{
+		message("system", "Summary of the earlier conversation: the user asked about A and B"),
+		toolCall,
+		toolResult,
+		toolResult,
+	}
+	if diff := cmp.Diff(want, request.Body["messages"]); diff != "" {
+		t.Errorf("messages ending with tool results mismatch (-want +got):\n%s", diff)
+	}
+	if request := send([]any{message("user", long), toolCall, toolResult, toolResult}); request.BodyMutated() {
+		t.Errorf("conversation was summarized without enough messages before the last tool call")
+	}
+
+	// a failed summarization forwards the conversation unchanged
+	status.Store(http.StatusInternalServerError)
+	conversation := longConversation()
+	conversation[1] = message("user", long+"y")
+	if request := send(conversation); request.BodyMutated() {
+		t.Errorf("conversation was modified after a failed summarization")
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costestimator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/tokenestimator"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	CostEstimatorPluginType = "cost-estimator"
+	EstimatedCostHeader     = "X-Gateway-Estimated-Cost-USD"
+
+	modelField = "model"
+	wildcard   = "*"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &CostEstimatorPlugin{}
+
+// Price is the price of a model, in USD per 1k tokens.
+type Price struct {
+	InputPer1K  float64 `json:"input_per_1k"`
+	OutputPer1K float64 `json:"output_per_1k"`
+}
+
+// Cost returns the cost, in USD, of the given numbers of input and output tokens.
+func (p Price) Cost(inputTokens, outputTokens float64) float64 {
+	return inputTokens/1000*p.InputPer1K + outputTokens/1000*p.OutputPer1K
+}
+
+// PriceTable maps model names to their price. A name ending with "*" is a wildcard matching the models
+// starting with its prefix, e.g. "gpt-4*" matches "gpt-4o". A model is priced by its exact entry if any,
+// otherwise by the wildcard with the longest matching prefix.
+type PriceTable map[string]Price
+
+// Validate checks that the prices are not negative, and that wildcards are at the end of the model names.
+func (t PriceTable) Validate() error {
+	for model, price := range t {
+		if strings.Contains(strings.TrimSuffix(model, wildcard), wildcard) {
+			return fmt.Errorf("wildcard must be at the end of model '%s'", model)
+		}
+		if price.InputPer1K < 0 || price.OutputPer1K < 0 {
+			return fmt.Errorf("price of model '%s' cannot be negative", model)
+		}
+	}
+	return nil
+}
+
+// Lookup returns the price of the model, and whether the model is priced.
+func (t PriceTable) Lookup(model string) (Price, bool) {
+	if price, ok := t[model]; ok {
+		return price, true
+	}
+	var found Price
+	longest := -1
+	for name, price := range t {
+		prefix, isWildcard := strings.CutSuffix(name, wildcard)
+		if isWildcard && len(prefix) > longest && strings.HasPrefix(model, prefix) {
+			found, longest = price, len(prefix)
+		}
+	}
+	return found, longest >= 0
+}
+
+// CostEstimatorConfig defines the JSON configuration structure for the plugin.
+type CostEstimatorConfig struct {
+	// Prices is the price table of the models, keyed by model names or wildcard model prefixes.
+	Prices PriceTable `json:"prices"`
+}
+
+// CostEstimatorPluginFactory defines the factory function for NewCostEstimatorPlugin.
+func CostEstimatorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := CostEstimatorConfig{}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", CostEstimatorPluginType, err)
+		}
+	}
+
+	plugin, err := NewCostEstimatorPlugin(config.Prices)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", CostEstimatorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewCostEstimatorPlugin initializes a new CostEstimatorPlugin and returns its pointer.
+func NewCostEstimatorPlugin(prices PriceTable) (*CostEstimatorPlugin, error) {
+	if len(prices) == 0 {
+		return nil, errors.New("prices cannot be empty in CostEstimator plugin")
+	}
+	if err := prices.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid prices in CostEstimator plugin - %w", err)
+	}
+
+	return &CostEstimatorPlugin{
+		typedName: plugin.TypedName{
+			Type: CostEstimatorPluginType,
+			Name: CostEstimatorPluginType,
+		},
+		estimator: &tokenestimator.TiktokenEstimator{},
+		prices:    prices,
+	}, nil
+}
+
+// CostEstimatorPlugin estimates the cost of the input tokens of a request, from the estimated tokens of its
+// prompt and the price of its model, and exposes it in the X-Gateway-Estimated-Cost-USD header, so that the
+// routing plugins and observability tools can make cost-aware decisions. Requests for models without a price
+// have no estimated cost.
+type CostEstimatorPlugin struct {
+	typedName plugin.TypedName
+	estimator tokenestimator.TokenEstimator
+	prices    PriceTable
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *CostEstimatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *CostEstimatorPlugin) WithName(name string) *CostEstimatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the estimated cost of the input tokens of the request.
+func (p *CostEstimatorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, _ := request.Body[modelField].(string)
+	price, ok := p.prices.Lookup(model)
+	if !ok {
+		// the estimated cost must not be set by the client
+		for key := range request.Headers {
+			if strings.EqualFold(key, EstimatedCostHeader) {
+				request.RemoveHeader(key)
+			}
+		}
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("model has no price, not estimating the cost", "model", model)
+		return nil
+	}
+
+	promptTokens, err := p.estimator.EstimateTokens(ctx, tokenestimator.PromptText(request.Body))
+	if err != nil {
+		return fmt.Errorf("failed to estimate the prompt tokens - %w", err)
+	}
+	cost := price.Cost(float64(promptTokens), 0)
+	request.SetHeader(EstimatedCostHeader, strconv.FormatFloat(cost, 'f', 6, 64))
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("estimated the input cost", "model", model, "promptTokens", promptTokens, "cost", cost)
+	return nil
+}
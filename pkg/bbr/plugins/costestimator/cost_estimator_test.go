@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costestimator
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+// wordEstimator estimates a token per word, to make the expected costs easy to compute.
+type wordEstimator struct{}
+
+func (e *wordEstimator) EstimateTokens(_ context.Context, promptText string) (int, error) {
+	return len(strings.Fields(promptText)), nil
+}
+
+func TestCostEstimatorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"prices": {"gpt-4*": {"input_per_1k": 0.03, "output_per_1k": 0.06}, "llama": {"input_per_1k": 0.001}}}`),
+		},
+		{
+			name:      "missing prices",
+			rawParams: nil,
+			wantErr:   true,
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "negative price",
+			rawParams: json.RawMessage(`{"prices": {"llama": {"input_per_1k": -1}}}`),
+			wantErr:   true,
+		},
+		{
+			name:      "wildcard in the middle of the model",
+			rawParams: json.RawMessage(`{"prices": {"gpt-*-turbo": {"input_per_1k": 0.01}}}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := CostEstimatorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+			if got := p.TypedName().Type; got != CostEstimatorPluginType {
+				t.Errorf("Type = %q, want %q", got, CostEstimatorPluginType)
+			}
+		})
+	}
+}
+
+func TestPriceTable_Lookup(t *testing.T) {
+	prices := PriceTable{
+		"*":           {InputPer1K: 1},
+		"gpt-4*":      {InputPer1K: 2},
+		"gpt-4o*":     {InputPer1K: 3},
+		"gpt-4o-mini": {InputPer1K: 4},
+	}
+	tests := []struct {
+		model     string
+		wantPrice float64
+	}{
+		{model: "gpt-4o-mini", wantPrice: 4},
+		{model: "gpt-4o-2024-08-06", wantPrice: 3},
+		{model: "gpt-4-turbo", wantPrice: 2},
+		{model: "llama", wantPrice: 1},
+		{model: "", wantPrice: 1},
+	}
+	for _, tt := range tests {
+		price, ok := prices.Lookup(tt.model)
+		if !ok || price.InputPer1K != tt.wantPrice {
+			t.Errorf("Lookup(%q) = %v, %v; want %v, true", tt.model, price.InputPer1K, ok, tt.wantPrice)
+		}
+	}
+
+	if _, ok := (PriceTable{"gpt-4*": {InputPer1K: 2}}).Lookup("llama"); ok {
+		t.Error("Lookup of a model without a price should not be found")
+	}
+}
+
+func TestCostEstimatorPlugin_ProcessRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		body        map[string]any
+		wantHeaders map[string]string
+		wantRemoved bool
+	}{
+		{
+			name:        "chat completion",
+			body:        map[string]any{"model": "gpt-4o", "messages": []any{map[string]any{"role": "user", "content": "one two three four"}}},
+			wantHeaders: map[string]string{EstimatedCostHeader: "0.000120"},
+		},
+		{
+			name:        "completion with exact price",
+			body:        map[string]any{"model": "llama", "prompt": "one two"},
+			wantHeaders: map[string]string{EstimatedCostHeader: "0.000002"},
+		},
+		{
+			name:        "model without a price",
+			body:        map[string]any{"model": "mistral", "prompt": "one two"},
+			wantHeaders: map[string]string{},
+		},
+		{
+			name:        "estimated cost sent by the client is removed",
+			headers:     map[string]string{"x-gateway-estimated-cost-usd": "0"},
+			body:        map[string]any{"model": "mistral", "prompt": "one two"},
+			wantHeaders: map[string]string{},
+			wantRemoved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewCostEstimatorPlugin(PriceTable{
+				"gpt-4*": {InputPer1K: 0.03, OutputPer1K: 0.06},
+				"llama":  {InputPer1K: 0.001},
+			})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			p.estimator = &wordEstimator{}
+
+			request := framework.NewInferenceRequest()
+			for k, v := range tt.headers {
+				request.Headers[k] = v
+			}
+			request.Body = tt.body
+			if err := p.ProcessRequest(context.Background(), nil, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantHeaders, request.MutatedHeaders()); diff != "" {
+				t.Errorf("unexpected mutated headers (-want +got):\n%s", diff)
+			}
+			if got := len(request.RemovedHeaders()) > 0; got != tt.wantRemoved {
+				t.Errorf("header removed = %v, want %v", got, tt.wantRemoved)
+			}
+		})
+	}
+}
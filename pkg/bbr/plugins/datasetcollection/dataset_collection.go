@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetcollection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	DatasetCollectionPluginType = "dataset-collection"
+
+	defaultSamplingRate = 1.0
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &DatasetCollectionPlugin{}
+	_ framework.ResponseProcessor = &DatasetCollectionPlugin{}
+)
+
+// DatasetCollectionConfig defines the JSON configuration structure for the plugin.
+type DatasetCollectionConfig struct {
+	// Directory is the directory the JSONL dataset files are written to. To collect the dataset
+	// into a GCS or S3 bucket, mount the bucket into the BBR container (e.g., with the GCS FUSE
+	// or Mountpoint for S3 CSI drivers) and point the directory to the mount path.
+	Directory string `json:"directory"`
+	// SamplingRate is the fraction of requests that are captured, between 0 and 1. Defaults to 1.
+	SamplingRate float64 `json:"sampling_rate"`
+	// CollectionFilter restricts the captured prompt/response pairs.
+	CollectionFilter CollectionFilter `json:"collection_filter"`
+}
+
+// CollectionFilter defines which prompt/response pairs are captured.
+type CollectionFilter struct {
+	// FinishReason only captures responses whose first choice has this finish reason, e.g. "stop".
+	// If empty, responses are captured regardless of their finish reason.
+	FinishReason string `json:"finish_reason"`
+}
+
+// DatasetCollectionPluginFactory defines the factory function for NewDatasetCollectionPlugin.
+func DatasetCollectionPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := DatasetCollectionConfig{SamplingRate: defaultSamplingRate}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", DatasetCollectionPluginType, err)
+		}
+	}
+
+	plugin, err := NewDatasetCollectionPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", DatasetCollectionPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewDatasetCollectionPlugin initializes a new DatasetCollectionPlugin and returns its pointer.
+func NewDatasetCollectionPlugin(config DatasetCollectionConfig) (*DatasetCollectionPlugin, error) {
+	if config.Directory == "" {
+		return nil, errors.New("directory is required in DatasetCollection plugin")
+	}
+	if config.SamplingRate < 0 || config.SamplingRate > 1 {
+		return nil, errors.New("sampling_rate must be between 0 and 1 in DatasetCollection plugin")
+	}
+	if err := os.MkdirAll(config.Directory, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create directory '%s' - %w", config.Directory, err)
+	}
+
+	return &DatasetCollectionPlugin{
+		typedName: plugin.TypedName{
+			Type: DatasetCollectionPluginType,
+			Name: DatasetCollectionPluginType,
+		},
+		directory:    config.Directory,
+		samplingRate: config.SamplingRate,
+		finishReason: config.CollectionFilter.FinishReason,
+		random:       rand.Float64,
+		now:          time.Now,
+	}, nil
+}
+
+// DatasetCollectionPlugin captures a sample of prompt/response pairs for fine-tuning dataset collection,
+// and appends them as JSON lines to a daily file in the configured directory.
+// The prompt is captured as the request plugins before this one left it, so the plugin should be placed
+// after any plugin that removes PII from the prompt.
+type DatasetCollectionPlugin struct {
+	typedName    plugin.TypedName
+	directory    string
+	samplingRate float64
+	finishReason string
+
+	lock   sync.Mutex
+	random func() float64
+	now    func() time.Time
+}
+
+// prompt is stored in the CycleState to correlate a response with its request.
+type prompt struct {
+	Model    string `json:"model,omitempty"`
+	Prompt   any    `json:"prompt,omitempty"`
+	Messages any    `json:"messages,omitempty"`
+}
+
+// record is a single line of the dataset.
+type record struct {
+	Timestamp time.Time `json:"timestamp"`
+	prompt
+	Response any `json:"response"`
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *DatasetCollectionPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *DatasetCollectionPlugin) WithName(name string) *DatasetCollectionPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest samples the request and captures its prompt.
+func (p *DatasetCollectionPlugin) ProcessRequest(_ context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	if p.random() >= p.samplingRate {
+		return nil
+	}
+
+	body := request.Body
+	if redacted, err := framework.ReadCycleStateKey[map[string]any](cycleState, framework.AuditBodyKey); err == nil {
+		body = redacted // the body without secrets
+	}
+
+	captured := prompt{Prompt: body["prompt"], Messages: body["messages"]}
+	if captured.Prompt == nil && captured.Messages == nil {
+		return nil // not a completion or chat completion request
+	}
+	captured.Model, _ = body["model"].(string)
+	cycleState.Write(p.typedName.String(), captured)
+
+	return nil
+}
+
+// ProcessResponse writes the captured prompt together with the response to the dataset.
+// Failing to write the dataset does not fail the response.
+func (p *DatasetCollectionPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+
+	captured, err := framework.ReadCycleStateKey[prompt](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not sampled
+	}
+
+	choices, ok := response.Body["choices"].([]any)
+	if !ok || len(choices) == 0 {
+		return nil
+	}
+	if p.finishReason != "" {
+		if choice, ok := choices[0].(map[string]any); !ok || choice["finish_reason"] != p.finishReason {
+			return nil
+		}
+	}
+
+	if err := p.write(record{Timestamp: p.now().UTC(), prompt: captured, Response: choices}); err != nil {
+		log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to write dataset record")
+		return nil
+	}
+	log.FromContext(ctx).V(logutil.TRACE).Info("captured prompt/response pair", "model", captured.Model)
+
+	return nil
+}
+
+// write appends the record as a JSON line to the dataset file of the current day.
+func (p *DatasetCollectionPlugin) write(r record) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	path := filepath.Join(p.directory, fmt.Sprintf("dataset-%s.jsonl", r.Timestamp.Format(time.DateOnly)))
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
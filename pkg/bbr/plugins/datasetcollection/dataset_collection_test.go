@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetcollection
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestDatasetCollectionPluginFactory(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"directory":"` + dir + `","sampling_rate":0.1,"collection_filter":{"finish_reason":"stop"}}`)},
+		{name: "missing directory", rawParams: json.RawMessage(`{"sampling_rate":0.1}`), wantErr: true},
+		{name: "invalid sampling rate", rawParams: json.RawMessage(`{"directory":"` + dir + `","sampling_rate":1.5}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := DatasetCollectionPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestDatasetCollectionPlugin(t *testing.T) {
+	tests := []struct {
+		name         string
+		sample       float64
+		finishReason string
+		wantRecords  int
+	}{
+		{name: "sampled", sample: 0.05, finishReason: "stop", wantRecords: 1},
+		{name: "not sampled", sample: 0.5, finishReason: "stop", wantRecords: 0},
+		{name: "filtered by finish reason", sample: 0.05, finishReason: "length", wantRecords: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			p, err := NewDatasetCollectionPlugin(DatasetCollectionConfig{
+				Directory:        dir,
+				SamplingRate:     0.1,
+				CollectionFilter: CollectionFilter{FinishReason: "stop"},
+			})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+			p.random = func() float64 { return tt.sample }
+			p.now = func() time.Time { return now }
+
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			request.Body["model"] = "llama"
+			request.Body["messages"] = []any{map[string]any{"role": "user", "content": "Hello [REDACTED]"}}
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := framework.NewInferenceResponse()
+			response.Body["choices"] = []any{map[string]any{
+				"index":         0,
+				"message":       map[string]any{"role": "assistant", "content": "Hi!"},
+				"finish_reason": tt.finishReason,
+			}}
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			raw, err := os.ReadFile(filepath.Join(dir, "dataset-2026-03-01.jsonl"))
+			if tt.wantRecords == 0 {
+				if !os.IsNotExist(err) {
+					t.Errorf("expected no dataset file, got err=%v content=%q", err, raw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to read dataset file: %v", err)
+			}
+			lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+			if len(lines) != tt.wantRecords {
+				t.Fatalf("got %d records, want %d", len(lines), tt.wantRecords)
+			}
+			got := map[string]any{}
+			if err := json.Unmarshal([]byte(lines[0]), &got); err != nil {
+				t.Fatalf("invalid JSON line %q: %v", lines[0], err)
+			}
+			for _, field := range []string{"timestamp", "model", "messages", "response"} {
+				if _, ok := got[field]; !ok {
+					t.Errorf("record %q is missing field %q", lines[0], field)
+				}
+			}
+		})
+	}
+}
+
+func TestDatasetCollectionPluginPrefersAuditBody(t *testing.T) {
+	p, err := NewDatasetCollectionPlugin(DatasetCollectionConfig{Directory: t.TempDir(), SamplingRate: 1})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	cycleState := framework.NewCycleState()
+	cycleState.Write(framework.AuditBodyKey, map[string]any{"model": "llama", "prompt": "key=[REDACTED]"})
+	request := framework.NewInferenceRequest()
+	request.Body["model"] = "llama"
+	request.Body["prompt"] = "key=sk-123"
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	captured, err := framework.ReadCycleStateKey[prompt](cycleState, p.TypedName().String())
+	if err != nil {
+		t.Fatalf("expected a captured prompt: %v", err)
+	}
+	if captured.Prompt != "key=[REDACTED]" {
+		t.Errorf("captured prompt = %v, want the redacted audit body prompt", captured.Prompt)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicmetadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	DynamicMetadataPluginType = "dynamic-metadata"
+	modelField                = "model"
+
+	// DefaultNamespace is the dynamic metadata namespace of the Envoy ext_proc filter.
+	DefaultNamespace    = "envoy.filters.http.ext_proc"
+	defaultTenantHeader = "X-Tenant-Id"
+	modelMetadataKey    = "model"
+	tenantIDMetadataKey = "tenant_id"
+	costTierMetadataKey = "cost_tier"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &DynamicMetadataPlugin{}
+
+// DynamicMetadataConfig defines the JSON configuration structure for the plugin.
+type DynamicMetadataConfig struct {
+	// Namespace is the dynamic metadata namespace. Defaults to "envoy.filters.http.ext_proc".
+	Namespace string `json:"namespace"`
+	// TenantHeader is the request header carrying the tenant ID. Defaults to "X-Tenant-Id".
+	TenantHeader string `json:"tenant_header"`
+	// CostTiers maps a model name to its cost tier, e.g. {"gpt-4": "premium"}.
+	CostTiers map[string]string `json:"cost_tiers"`
+	// DefaultCostTier is the cost tier of models that are not in CostTiers. Empty omits the cost tier.
+	DefaultCostTier string `json:"default_cost_tier"`
+}
+
+// DynamicMetadataPluginFactory defines the factory function for NewDynamicMetadataPlugin.
+func DynamicMetadataPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := DynamicMetadataConfig{
+		Namespace:    DefaultNamespace,
+		TenantHeader: defaultTenantHeader,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", DynamicMetadataPluginType, err)
+		}
+	}
+
+	return NewDynamicMetadataPlugin(config).WithName(name), nil
+}
+
+// NewDynamicMetadataPlugin initializes a new DynamicMetadataPlugin and returns its pointer.
+func NewDynamicMetadataPlugin(config DynamicMetadataConfig) *DynamicMetadataPlugin {
+	if config.Namespace == "" {
+		config.Namespace = DefaultNamespace
+	}
+	if config.TenantHeader == "" {
+		config.TenantHeader = defaultTenantHeader
+	}
+
+	return &DynamicMetadataPlugin{
+		typedName: plugin.TypedName{
+			Type: DynamicMetadataPluginType,
+			Name: DynamicMetadataPluginType,
+		},
+		namespace:       config.Namespace,
+		tenantHeader:    config.TenantHeader,
+		costTiers:       config.CostTiers,
+		defaultCostTier: config.DefaultCostTier,
+	}
+}
+
+// DynamicMetadataPlugin returns the model name, tenant ID and cost tier of the request to Envoy as
+// dynamic metadata, so Envoy RBAC policies can gate on them.
+type DynamicMetadataPlugin struct {
+	typedName       plugin.TypedName
+	namespace       string
+	tenantHeader    string
+	costTiers       map[string]string
+	defaultCostTier string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *DynamicMetadataPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *DynamicMetadataPlugin) WithName(name string) *DynamicMetadataPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the dynamic metadata of the request. Empty values are omitted.
+func (p *DynamicMetadataPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, _ := request.Body[modelField].(string)
+	costTier, ok := p.costTiers[model]
+	if !ok {
+		costTier = p.defaultCostTier
+	}
+
+	metadata := map[string]string{
+		modelMetadataKey:    model,
+		tenantIDMetadataKey: request.GetHeader(p.tenantHeader),
+		costTierMetadataKey: costTier,
+	}
+	for key, value := range metadata {
+		if value != "" {
+			request.SetDynamicMetadata(p.namespace, key, structpb.NewStringValue(value))
+		}
+	}
+
+	log.FromContext(ctx).V(logutil.TRACE).Info("set dynamic metadata", "namespace", p.namespace, "metadata", metadata)
+	return nil
+}
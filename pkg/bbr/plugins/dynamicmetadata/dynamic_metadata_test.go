@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamicmetadata
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestDynamicMetadataPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "valid config", rawParams: json.RawMessage(`{"tenant_header":"X-Org","cost_tiers":{"gpt-4":"premium"},"default_cost_tier":"standard"}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := DynamicMetadataPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestDynamicMetadataPlugin(t *testing.T) {
+	p := NewDynamicMetadataPlugin(DynamicMetadataConfig{
+		CostTiers:       map[string]string{"gpt-4": "premium"},
+		DefaultCostTier: "standard",
+	})
+
+	tests := []struct {
+		name    string
+		model   string
+		tenant  string
+		wantMap map[string]any
+	}{
+		{
+			name:   "all fields",
+			model:  "gpt-4",
+			tenant: "acme",
+			wantMap: map[string]any{DefaultNamespace: map[string]any{
+				"model": "gpt-4", "tenant_id": "acme", "cost_tier": "premium",
+			}},
+		},
+		{
+			name:  "default cost tier without tenant",
+			model: "llama",
+			wantMap: map[string]any{DefaultNamespace: map[string]any{
+				"model": "llama", "cost_tier": "standard",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body["model"] = tt.model
+			if tt.tenant != "" {
+				request.Headers["x-tenant-id"] = tt.tenant
+			}
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantMap, request.DynamicMetadata.AsMap()); diff != "" {
+				t.Errorf("unexpected dynamic metadata (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
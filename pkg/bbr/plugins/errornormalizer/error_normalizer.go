@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errornormalizer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ErrorNormalizerPluginType = "error-normalizer"
+	UpstreamErrorHeader       = "X-BBR-Upstream-Error"
+	modelField                = "model"
+
+	defaultBackendHeader = "server"
+	defaultErrorType     = "api_error"
+)
+
+// defaultTypeMapping maps error types returned by common model servers to the standard OpenAI error types.
+var defaultTypeMapping = map[string]string{
+	// OpenAI compatible error codes
+	"context_length_exceeded": "invalid_request_error",
+	"model_not_found":         "not_found_error",
+	"rate_limit_exceeded":     "rate_limit_error",
+	"insufficient_quota":      "rate_limit_error",
+	"server_error":            "api_error",
+	// vLLM error types
+	"BadRequestError":     "invalid_request_error",
+	"NotFoundError":       "not_found_error",
+	"InternalServerError": "api_error",
+}
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &ErrorNormalizerPlugin{}
+	_ framework.ResponseProcessor = &ErrorNormalizerPlugin{}
+)
+
+// ErrorNormalizerConfig defines the JSON configuration structure for the plugin.
+type ErrorNormalizerConfig struct {
+	// TypeMapping maps backend specific error types to standard error types. It extends and overrides
+	// the default mapping.
+	TypeMapping map[string]string `json:"type_mapping"`
+	// BackendHeader is the response header identifying the backend that responded. Defaults to "server".
+	BackendHeader string `json:"backend_header"`
+}
+
+// ErrorNormalizerPluginFactory defines the factory function for NewErrorNormalizerPlugin.
+func ErrorNormalizerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ErrorNormalizerConfig{BackendHeader: defaultBackendHeader}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ErrorNormalizerPluginType, err)
+		}
+	}
+
+	return NewErrorNormalizerPlugin(config).WithName(name), nil
+}
+
+// NewErrorNormalizerPlugin initializes a new ErrorNormalizerPlugin and returns its pointer.
+func NewErrorNormalizerPlugin(config ErrorNormalizerConfig) *ErrorNormalizerPlugin {
+	typeMapping := maps.Clone(defaultTypeMapping)
+	maps.Copy(typeMapping, config.TypeMapping)
+
+	return &ErrorNormalizerPlugin{
+		typedName: plugin.TypedName{
+			Type: ErrorNormalizerPluginType,
+			Name: ErrorNormalizerPluginType,
+		},
+		typeMapping:   typeMapping,
+		backendHeader: config.BackendHeader,
+	}
+}
+
+// ErrorNormalizerPlugin converts error responses of the backend, either in the OpenAI format
+// ({"error":{...}}) or in the vLLM format ({"object":"error",...}), into a standard OpenAI error
+// response. Backend specific error types are mapped to standard ones, the original type is kept in
+// "upstream_type", and the backend and model are added as gateway context.
+type ErrorNormalizerPlugin struct {
+	typedName     plugin.TypedName
+	typeMapping   map[string]string
+	backendHeader string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ErrorNormalizerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ErrorNormalizerPlugin) WithName(name string) *ErrorNormalizerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest records the requested model, to be added to errors of the response.
+func (p *ErrorNormalizerPlugin) ProcessRequest(_ context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	if model, ok := request.Body[modelField].(string); ok {
+		cycleState.Write(p.typedName.String(), model)
+	}
+	return nil
+}
+
+// ProcessResponse normalizes error responses of the backend.
+func (p *ErrorNormalizerPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil {
+		return nil
+	}
+
+	upstreamErr, ok := upstreamError(response.Body)
+	if !ok {
+		return nil
+	}
+
+	upstreamType, _ := upstreamErr["type"].(string)
+	if code, ok := upstreamErr["code"].(string); ok {
+		if _, mapped := p.typeMapping[code]; mapped {
+			upstreamType = code // OpenAI sends specific errors such as context_length_exceeded as the code
+		}
+	}
+	normalizedType, ok := p.typeMapping[upstreamType]
+	if !ok {
+		normalizedType = upstreamType
+	}
+	if normalizedType == "" {
+		normalizedType = defaultErrorType
+	}
+
+	normalized := map[string]any{
+		"message":       upstreamErr["message"],
+		"type":          normalizedType,
+		"param":         upstreamErr["param"],
+		"code":          upstreamErr["code"],
+		"upstream_type": upstreamType,
+	}
+	if backend := response.GetHeader(p.backendHeader); backend != "" {
+		normalized["backend"] = backend
+	}
+	if cycleState != nil {
+		if model, err := framework.ReadCycleStateKey[string](cycleState, p.typedName.String()); err == nil {
+			normalized["model"] = model
+		}
+	}
+
+	response.SetBody(map[string]any{"error": normalized})
+	response.SetHeader(UpstreamErrorHeader, "true")
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("normalized upstream error", "upstreamType", upstreamType, "type", normalizedType)
+
+	return nil
+}
+
+// upstreamError returns the error object of an error response in the OpenAI or the vLLM format.
+func upstreamError(body map[string]any) (map[string]any, bool) {
+	if upstreamErr, ok := body["error"].(map[string]any); ok {
+		return upstreamErr, true
+	}
+	if body["object"] == "error" {
+		return body, true
+	}
+	return nil, false
+}
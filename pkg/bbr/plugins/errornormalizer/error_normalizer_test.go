@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errornormalizer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestErrorNormalizerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "custom mapping", rawParams: json.RawMessage(`{"type_mapping":{"overloaded":"api_error"},"backend_header":"x-backend"}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ErrorNormalizerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestErrorNormalizerPlugin_ProcessResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  map[string]any
+		wantError map[string]any
+	}{
+		{
+			name: "OpenAI error with a specific code",
+			response: map[string]any{"error": map[string]any{
+				"message": "maximum context length is 4096 tokens",
+				"type":    "invalid_request_error",
+				"code":    "context_length_exceeded",
+			}},
+			wantError: map[string]any{
+				"message":       "maximum context length is 4096 tokens",
+				"type":          "invalid_request_error",
+				"param":         nil,
+				"code":          "context_length_exceeded",
+				"upstream_type": "context_length_exceeded",
+				"backend":       "vllm",
+				"model":         "llama",
+			},
+		},
+		{
+			name: "vLLM error",
+			response: map[string]any{
+				"object":  "error",
+				"message": "The model `foo` does not exist.",
+				"type":    "NotFoundError",
+				"code":    404.0,
+			},
+			wantError: map[string]any{
+				"message":       "The model `foo` does not exist.",
+				"type":          "not_found_error",
+				"param":         nil,
+				"code":          404.0,
+				"upstream_type": "NotFoundError",
+				"backend":       "vllm",
+				"model":         "llama",
+			},
+		},
+		{
+			name:     "custom mapping",
+			response: map[string]any{"error": map[string]any{"message": "try again", "type": "overloaded"}},
+			wantError: map[string]any{
+				"message":       "try again",
+				"type":          "api_error",
+				"param":         nil,
+				"code":          nil,
+				"upstream_type": "overloaded",
+				"backend":       "vllm",
+				"model":         "llama",
+			},
+		},
+		{
+			name:     "unknown type is kept",
+			response: map[string]any{"error": map[string]any{"message": "oops", "type": "weird_error"}},
+			wantError: map[string]any{
+				"message":       "oops",
+				"type":          "weird_error",
+				"param":         nil,
+				"code":          nil,
+				"upstream_type": "weird_error",
+				"backend":       "vllm",
+				"model":         "llama",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewErrorNormalizerPlugin(ErrorNormalizerConfig{
+				TypeMapping:   map[string]string{"overloaded": "api_error"},
+				BackendHeader: defaultBackendHeader,
+			})
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			request.Body[modelField] = "llama"
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := framework.NewInferenceResponse()
+			response.Headers["server"] = "vllm"
+			response.Body = tt.response
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(map[string]any{"error": tt.wantError}, response.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+			if got := response.Headers[UpstreamErrorHeader]; got != "true" {
+				t.Errorf("Headers[%q] = %q, want %q", UpstreamErrorHeader, got, "true")
+			}
+		})
+	}
+}
+
+func TestErrorNormalizerPlugin_SuccessfulResponse(t *testing.T) {
+	p := NewErrorNormalizerPlugin(ErrorNormalizerConfig{})
+	response := framework.NewInferenceResponse()
+	response.Body["choices"] = []any{}
+	if err := p.ProcessResponse(context.Background(), framework.NewCycleState(), response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.BodyMutated() {
+		t.Error("expected a successful response to be left unchanged")
+	}
+	if _, ok := response.Headers[UpstreamErrorHeader]; ok {
+		t.Errorf("expected no %q header", UpstreamErrorHeader)
+	}
+}
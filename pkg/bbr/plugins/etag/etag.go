@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etag
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/credential"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ETagPluginType    = "etag"
+	RequestETagHeader = "X-BBR-ETag"
+	ETagHeader        = "ETag"
+	IfNoneMatchHeader = "If-None-Match"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &ETagPlugin{}
+	_ framework.ResponseProcessor = &ETagPlugin{}
+)
+
+// ETagPluginFactory defines the factory function for NewETagPlugin.
+func ETagPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewETagPlugin().WithName(name), nil
+}
+
+// NewETagPlugin initializes a new ETagPlugin and returns its pointer.
+func NewETagPlugin() *ETagPlugin {
+	return &ETagPlugin{
+		typedName: plugin.TypedName{
+			Type: ETagPluginType,
+			Name: ETagPluginType,
+		},
+	}
+}
+
+// ETagPlugin computes a deterministic ETag for requests whose response is reproducible,
+// i.e. requests with temperature 0. The ETag is set on the request for Envoy's cache filter,
+// and on the response so clients can send conditional requests with If-None-Match.
+type ETagPlugin struct {
+	typedName plugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ETagPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ETagPlugin) WithName(name string) *ETagPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the ETag of deterministic requests, and answers with 304 Not Modified
+// when the client already holds a response with the same ETag.
+func (p *ETagPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	etag, ok := ComputeETag(request)
+	if !ok {
+		return nil
+	}
+
+	if matchesETag(request.GetHeader(IfNoneMatchHeader), etag) {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("client holds an up to date response", "etag", etag)
+		// a 304 response has no body
+		return errcommon.ImmediateResponse{
+			Code:    errcommon.NotModified,
+			Headers: map[string]string{ETagHeader: etag},
+		}
+	}
+
+	request.SetHeader(RequestETagHeader, etag)
+	if cycleState != nil {
+		cycleState.Write(p.typedName.String(), etag)
+	}
+
+	return nil
+}
+
+// ProcessResponse sets the ETag header computed for the request on the response.
+func (p *ETagPlugin) ProcessResponse(_ context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || cycleState == nil {
+		return nil
+	}
+
+	etag, err := framework.ReadCycleStateKey[string](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request is not deterministic, nothing to set
+	}
+	response.SetHeader(ETagHeader, etag)
+
+	return nil
+}
+
+// ComputeETag returns a strong ETag for requests with temperature 0, identifying their reproducible response.
+// Requests sampled with a non-zero temperature are not reproducible and have no ETag. The ETag hashes the
+// whole body, since any field, e.g. max_tokens or tools, may change the response, and the credentials of the
+// request, so that clients never share responses. It is also the key of the response cache plugin, so both
+// plugins set the same ETag on a response.
+func ComputeETag(request *framework.InferenceRequest) (string, bool) {
+	if temperature, ok := request.Body["temperature"].(float64); !ok || temperature != 0 {
+		return "", false
+	}
+	// json.Marshal sorts the map keys, so identical bodies have identical ETags
+	raw, err := json.Marshal(request.Body)
+	if err != nil {
+		return "", false
+	}
+
+	sum := sha256.Sum256(append([]byte(credential.Hash(request)+"\x00"), raw...))
+	return `"` + hex.EncodeToString(sum[:]) + `"`, true
+}
+
+// matchesETag reports whether the If-None-Match header value matches the given ETag,
+// using the weak comparison function of RFC 9110.
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etag
+
+import (
+	"context"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestETagPlugin_ProcessRequest(t *testing.T) {
+	deterministicBody := map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "seed": 1.0}
+	deterministicRequest := framework.NewInferenceRequest()
+	deterministicRequest.Body = deterministicBody
+	etag, ok := ComputeETag(deterministicRequest)
+	if !ok {
+		t.Fatal("expected an ETag for a deterministic request")
+	}
+
+	tests := []struct {
+		name        string
+		body        map[string]any
+		ifNoneMatch string
+		wantETag    bool
+		wantCode    string
+	}{
+		{
+			name:     "deterministic request",
+			body:     deterministicBody,
+			wantETag: true,
+		},
+		{
+			name: "non-zero temperature",
+			body: map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.7},
+		},
+		{
+			name: "temperature not set",
+			body: map[string]any{"model": "llama", "prompt": "hello"},
+		},
+		{
+			name:        "If-None-Match matches",
+			body:        deterministicBody,
+			ifNoneMatch: etag,
+			wantCode:    errcommon.NotModified,
+		},
+		{
+			name:        "If-None-Match matches weak ETag in a list",
+			body:        deterministicBody,
+			ifNoneMatch: `"other", W/` + etag,
+			wantCode:    errcommon.NotModified,
+		},
+		{
+			name:        "If-None-Match does not match",
+			body:        deterministicBody,
+			ifNoneMatch: `"other"`,
+			wantETag:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewETagPlugin()
+			request := framework.NewInferenceRequest()
+			for k, v := range tt.body {
+				request.Body[k] = v
+			}
+			if tt.ifNoneMatch != "" {
+				request.Headers["if-none-match"] = tt.ifNoneMatch
+			}
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Fatalf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				resp, err := errcommon.BuildErrResponse(err)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if body := resp.GetImmediateResponse().GetBody(); len(body) != 0 {
+					t.Errorf("unexpected body %q of the 304 response", body)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, found := request.Headers[RequestETagHeader]
+			if found != tt.wantETag {
+				t.Fatalf("ETag header set = %v, want %v", found, tt.wantETag)
+			}
+			if tt.wantETag && got != etag {
+				t.Errorf("Headers[%q] = %q, want %q", RequestETagHeader, got, etag)
+			}
+		})
+	}
+}
+
+func TestComputeETag(t *testing.T) {
+	newRequest := func(body map[string]any, apiKey string) *framework.InferenceRequest {
+		request := framework.NewInferenceRequest()
+		request.Body = body
+		if apiKey != "" {
+			request.Headers["x-api-key"] = apiKey
+		}
+		return request
+	}
+	base := map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0}
+	etag, _ := ComputeETag(newRequest(base, ""))
+
+	tests := []struct {
+		name     string
+		request  *framework.InferenceRequest
+		wantSame bool
+	}{
+		{
+			name:     "identical body",
+			request:  newRequest(map[string]any{"temperature": 0.0, "prompt": "hello", "model": "llama"}, ""),
+			wantSame: true,
+		},
+		{
+			name:    "different max_tokens",
+			request: newRequest(map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "max_tokens": 10.0}, ""),
+		},
+		{
+			name:    "different credentials",
+			request: newRequest(map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0}, "key-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ComputeETag(tt.request)
+			if !ok {
+				t.Fatal("expected an ETag for a deterministic request")
+			}
+			if (got == etag) != tt.wantSame {
+				t.Errorf("ETag %s equals %s = %v, want %v", got, etag, got == etag, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestETagPlugin_ProcessResponse(t *testing.T) {
+	p := NewETagPlugin()
+	cycleState := framework.NewCycleState()
+
+	request := framework.NewInferenceRequest()
+	request.Body["model"] = "llama"
+	request.Body["prompt"] = "hello"
+	request.Body["temperature"] = 0.0
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	response := framework.NewInferenceResponse()
+	if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := response.Headers[ETagHeader], request.Headers[RequestETagHeader]; got != want {
+		t.Errorf("Headers[%q] = %q, want %q", ETagHeader, got, want)
+	}
+
+	// a response to a non-deterministic request has no ETag
+	response = framework.NewInferenceResponse()
+	if err := p.ProcessResponse(context.Background(), framework.NewCycleState(), response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := response.Headers[ETagHeader]; ok {
+		t.Errorf("expected no %q header", ETagHeader)
+	}
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fanout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	FanoutPluginType   = "fanout"
+	WinningModelHeader = "X-BBR-Winning-Model"
+	// FanoutHeader marks the fanned out requests, so that they are forwarded as is when they are routed
+	// through BBR again, instead of being fanned out recursively.
+	FanoutHeader = "X-BBR-Fanout"
+	modelField   = "model"
+	streamField  = "stream"
+
+	defaultTimeoutSeconds = 30
+)
+
+// compile-time type validation
+var _ framework.ModelSelector = &FanoutPlugin{}
+
+// skippedHeaders are the headers of the request that are not forwarded with the fanned out requests: the
+// hop-by-hop headers, the headers describing the original body, and Accept-Encoding, so that the HTTP client
+// decompresses the responses. Pseudo-headers, e.g. ":path", are not forwarded either.
+var skippedHeaders = map[string]bool{
+	"connection": true, "keep-alive": true, "proxy-authenticate": true, "proxy-authorization": true,
+	"te": true, "trailer": true, "transfer-encoding": true, "upgrade": true, "host": true,
+	"content-length": true, "content-type": true, "accept-encoding": true, strings.ToLower(FanoutHeader): true,
+}
+
+// FanoutConfig defines the JSON configuration structure for the plugin.
+type FanoutConfig struct {
+	// FanoutModels are the models a request for any of them is sent to concurrently,
+	// e.g. ["gpt-4", "claude-3", "llama-3"].
+	FanoutModels []string `json:"fanout_models"`
+	// Endpoint is the URL the fanned out requests are sent to, with the model field set to each of
+	// the fanout models, e.g. "http://inference-gateway.default.svc/v1/chat/completions".
+	Endpoint string `json:"endpoint"`
+	// ModelEndpoints optionally overrides the endpoint of some of the fanout models.
+	ModelEndpoints map[string]string `json:"model_endpoints"`
+	// TimeoutSeconds bounds the wait for the first successful response. Defaults to 30.
+	TimeoutSeconds int `json:"timeout_seconds"`
+}
+
+// FanoutPluginFactory defines the factory function for NewFanoutPlugin.
+func FanoutPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := FanoutConfig{TimeoutSeconds: defaultTimeoutSeconds}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", FanoutPluginType, err)
+		}
+	}
+
+	plugin, err := NewFanoutPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", FanoutPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewFanoutPlugin initializes a new FanoutPlugin and returns its pointer.
+func NewFanoutPlugin(config FanoutConfig) (*FanoutPlugin, error) {
+	if len(config.FanoutModels) < 2 {
+		return nil, errors.New("fanout_models must list at least two models in Fanout plugin")
+	}
+	if config.TimeoutSeconds <= 0 {
+		return nil, errors.New("timeout_seconds must be positive in Fanout plugin")
+	}
+
+	endpoints := make(map[string]string, len(config.FanoutModels))
+	for _, model := range config.FanoutModels {
+		endpoint := config.Endpoint
+		if override, ok := config.ModelEndpoints[model]; ok {
+			endpoint = override
+		}
+		if endpoint == "" {
+			return nil, fmt.Errorf("no endpoint for model %q in Fanout plugin", model)
+		}
+		if _, err := url.ParseRequestURI(endpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint of model %q in Fanout plugin - %w", model, err)
+		}
+		endpoints[model] = endpoint
+	}
+
+	timeout := time.Duration(config.TimeoutSeconds) * time.Second
+	return &FanoutPlugin{
+		typedName: plugin.TypedName{
+			Type: FanoutPluginType,
+			Name: FanoutPluginType,
+		},
+		models:    config.FanoutModels,
+		endpoints: endpoints,
+		timeout:   timeout,
+		client:    &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// FanoutPlugin lowers the latency of requests for any of the fanout models by sending them to all the
+// fanout models concurrently, and answering with the first successful response. The other requests are
+// canceled, and the model that answered is set in the X-BBR-Winning-Model header of the response.
+// The fanned out requests carry the headers of the request, e.g. its credentials and tracing headers, and
+// the X-BBR-Fanout header, so that they are not fanned out again. Streaming requests are not fanned out.
+// When none of the models answers successfully, the request is forwarded unchanged.
+type FanoutPlugin struct {
+	typedName plugin.TypedName
+	models    []string
+	endpoints map[string]string
+	timeout   time.Duration
+	client    *http.Client
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *FanoutPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *FanoutPlugin) WithName(name string) *FanoutPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest fans the request out to the fanout models and answers it with the first successful response.
+func (p *FanoutPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	winner, err := p.SelectModel(ctx, cycleState, request)
+	if err != nil || winner == "" {
+		return err
+	}
+	body, err := framework.ReadCycleStateKey[[]byte](cycleState, p.typedName.String())
+	if err != nil {
+		return err
+	}
+
+	return errcommon.ImmediateResponse{
+		Headers: map[string]string{"content-type": "application/json", WinningModelHeader: winner},
+		Body:    body,
+	}
+}
+
+// SelectModel fans the request out to the fanout models and selects the model that answered first. Its
+// response body is written to the cycle state for ProcessRequest.
+func (p *FanoutPlugin) SelectModel(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) (string, error) {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return "", nil // this shouldn't happen
+	}
+	if request.GetHeader(FanoutHeader) != "" {
+		return "", nil // the request was fanned out already
+	}
+
+	model, _ := request.Body[modelField].(string)
+	if !slices.Contains(p.models, model) {
+		return "", nil
+	}
+	if stream, _ := request.Body[streamField].(bool); stream {
+		return "", nil // a streamed response can not be returned as an immediate response
+	}
+
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	winner, body, err := p.race(ctx, request)
+	if err != nil {
+		logger.Info("no fanout model answered successfully, forwarding the request", "error", err.Error())
+		return "", nil
+	}
+	logger.Info("fanout model answered first", "model", winner)
+
+	cycleState.Write(p.typedName.String(), body)
+	return winner, nil
+}
+
+// result is the outcome of the request sent to one of the fanout models.
+type result struct {
+	model string
+	body  []byte
+	err   error
+}
+
+// race sends the request to all the fanout models and returns the first successful response.
+// The requests still in flight are canceled once it returns.
+func (p *FanoutPlugin) race(ctx context.Context, request *framework.InferenceRequest) (string, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	// buffered, so the losing requests never block once the race is over
+	results := make(chan result, len(p.models))
+	for _, model := range p.models {
+		body, err := withModel(request.Body, model)
+		if err != nil {
+			return "", nil, err
+		}
+		go func() {
+			response, err := p.send(ctx, p.endpoints[model], request.Headers, body)
+			results <- result{model: model, body: response, err: err}
+		}()
+	}
+
+	var errs []error
+	for range p.models {
+		r := <-results
+		if r.err == nil {
+			return r.model, r.body, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", r.model, r.err))
+	}
+	return "", nil, errors.Join(errs...)
+}
+
+// withModel returns the marshaled request body with the model field replaced.
+func withModel(requestBody map[string]any, model string) ([]byte, error) {
+	body := make(map[string]any, len(requestBody))
+	for key, value := range requestBody {
+		body[key] = value
+	}
+	body[modelField] = model
+	return json.Marshal(body)
+}
+
+// send posts the body to the endpoint with the given headers, and returns the response body.
+func (p *FanoutPlugin) send(ctx context.Context, endpoint string, headers map[string]string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range headers {
+		if strings.HasPrefix(key, ":") || skippedHeaders[strings.ToLower(key)] {
+			continue
+		}
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(FanoutHeader, "1")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return raw, nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fanout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestFanoutPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"fanout_models":["gpt-4","claude-3","llama-3"],"endpoint":"http://gateway/v1/chat/completions"}`)},
+		{name: "model endpoints", rawParams: json.RawMessage(`{"fanout_models":["gpt-4","llama-3"],"model_endpoints":{"gpt-4":"http://a/v1/chat/completions","llama-3":"http://b/v1/chat/completions"}}`)},
+		{name: "single model", rawParams: json.RawMessage(`{"fanout_models":["gpt-4"],"endpoint":"http://gateway"}`), wantErr: true},
+		{name: "missing endpoint", rawParams: json.RawMessage(`{"fanout_models":["gpt-4","llama-3"],"model_endpoints":{"gpt-4":"http://a"}}`), wantErr: true},
+		{name: "invalid endpoint", rawParams: json.RawMessage(`{"fanout_models":["gpt-4","llama-3"],"endpoint":"not a url"}`), wantErr: true},
+		{name: "non-positive timeout", rawParams: json.RawMessage(`{"fanout_models":["gpt-4","llama-3"],"endpoint":"http://gateway","timeout_seconds":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := FanoutPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestFanoutPlugin(t *testing.T) {
+	canceled := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the server only detects that the client went away once the request body was read
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+			close(canceled)
+		case <-time.After(10 * time.Second):
+		}
+	}))
+	defer slow.Close()
+	forwarded := make(chan http.Header, 1)
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case forwarded <- r.Header.Clone():
+		default:
+		}
+		body := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"model":"` + body["model"].(string) + `"}`))
+	}))
+	defer fast.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	p, err := NewFanoutPlugin(FanoutConfig{
+		FanoutModels:   []string{"gpt-4", "claude-3", "llama-3"},
+		ModelEndpoints: map[string]string{"gpt-4": slow.URL, "claude-3": fast.URL, "llama-3": failing.URL},
+		TimeoutSeconds: 5,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	request := framework.NewInferenceRequest()
+	request.Headers["authorization"] = "Bearer token"
+	request.Headers["traceparent"] = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	request.Headers[":path"] = "/v1/chat/completions"
+	request.Headers["content-length"] = "17"
+	request.Body["model"] = "gpt-4"
+	err = p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+	var response errcommon.ImmediateResponse
+	if !errors.As(err, &response) {
+		t.Fatalf("ProcessRequest() error = %v, want an immediate response", err)
+	}
+	if diff := cmp.Diff(`{"model":"claude-3"}`, string(response.Body)); diff != "" {
+		t.Errorf("unexpected body (-want +got):\n%s", diff)
+	}
+	if got := response.Headers[WinningModelHeader]; got != "claude-3" {
+		t.Errorf("%s = %q, want %q", WinningModelHeader, got, "claude-3")
+	}
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Error("the request of the losing model was not canceled")
+	}
+	headers := <-forwarded
+	for key, want := range map[string]string{
+		"Authorization": "Bearer token",
+		"Traceparent":   "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+		FanoutHeader:    "1",
+	} {
+		if got := headers.Get(key); got != want {
+			t.Errorf("forwarded header %s = %q, want %q", key, got, want)
+		}
+	}
+	if _, ok := headers[":path"]; ok {
+		t.Error("pseudo-header :path should not be forwarded")
+	}
+
+	for name, tc := range map[string]struct {
+		headers map[string]string
+		body    map[string]any
+	}{
+		"model not fanned out": {body: map[string]any{"model": "mistral"}},
+		"streaming request":    {body: map[string]any{"model": "gpt-4", "stream": true}},
+		"fanned out request":   {headers: map[string]string{"x-bbr-fanout": "1"}, body: map[string]any{"model": "gpt-4"}},
+	} {
+		request := framework.NewInferenceRequest()
+		for key, value := range tc.headers {
+			request.Headers[key] = value
+		}
+		request.Body = tc.body
+		if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+			t.Errorf("%s: ProcessRequest() unexpected error: %v", name, err)
+		}
+	}
+}
+
+func TestFanoutPluginAllModelsFail(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	p, err := NewFanoutPlugin(FanoutConfig{FanoutModels: []string{"gpt-4", "llama-3"}, Endpoint: failing.URL, TimeoutSeconds: 5})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	request := framework.NewInferenceRequest()
+	request.Body["model"] = "gpt-4"
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Errorf("ProcessRequest() = %v, want the request forwarded", err)
+	}
+}
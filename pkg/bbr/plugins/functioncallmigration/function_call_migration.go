@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package functioncallmigration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	FunctionCallMigrationPluginType = "function-call-migration"
+	APIVersionHeader                = "X-API-Version"
+
+	// defaultToolsAPIVersion is the first API version that supports tools and tool_calls.
+	defaultToolsAPIVersion = "2023-12-01"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &FunctionCallMigrationPlugin{}
+	_ framework.ResponseProcessor = &FunctionCallMigrationPlugin{}
+)
+
+// FunctionCallMigrationConfig defines the JSON configuration structure for the plugin.
+type FunctionCallMigrationConfig struct {
+	// ToolsAPIVersion is the first API version (in YYYY-MM-DD format) whose clients expect tool_calls
+	// in responses. Clients sending an older X-API-Version get function_call responses. Defaults to "2023-12-01".
+	ToolsAPIVersion string `json:"tools_api_version"`
+}
+
+// FunctionCallMigrationPluginFactory defines the factory function for NewFunctionCallMigrationPlugin.
+func FunctionCallMigrationPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := FunctionCallMigrationConfig{ToolsAPIVersion: defaultToolsAPIVersion}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", FunctionCallMigrationPluginType, err)
+		}
+	}
+
+	return NewFunctionCallMigrationPlugin(config.ToolsAPIVersion).WithName(name), nil
+}
+
+// NewFunctionCallMigrationPlugin initializes a new FunctionCallMigrationPlugin and returns its pointer.
+func NewFunctionCallMigrationPlugin(toolsAPIVersion string) *FunctionCallMigrationPlugin {
+	return &FunctionCallMigrationPlugin{
+		typedName: plugin.TypedName{
+			Type: FunctionCallMigrationPluginType,
+			Name: FunctionCallMigrationPluginType,
+		},
+		toolsAPIVersion: toolsAPIVersion,
+	}
+}
+
+// FunctionCallMigrationPlugin converts requests using the deprecated OpenAI "functions" and
+// "function_call" fields into the "tools" and "tool_choice" format, including the function calls
+// in the conversation history. Responses are converted back from "tool_calls" to "function_call"
+// for legacy clients, i.e. clients that sent the deprecated fields or an X-API-Version older than
+// the configured tools API version.
+type FunctionCallMigrationPlugin struct {
+	typedName       plugin.TypedName
+	toolsAPIVersion string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *FunctionCallMigrationPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *FunctionCallMigrationPlugin) WithName(name string) *FunctionCallMigrationPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest converts the deprecated function calling fields to the tools format.
+func (p *FunctionCallMigrationPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	legacy := false
+	if functions, ok := request.Body["functions"].([]any); ok {
+		tools := make([]any, 0, len(functions))
+		for _, function := range functions {
+			tools = append(tools, map[string]any{"type": "function", "function": function})
+		}
+		request.RemoveBodyField("functions")
+		request.SetBodyField("tools", tools)
+		legacy = true
+	}
+	if functionCall, ok := request.Body["function_call"]; ok {
+		request.RemoveBodyField("function_call")
+		request.SetBodyField("tool_choice", toToolChoice(functionCall))
+		legacy = true
+	}
+	if messages, ok := request.Body["messages"].([]any); ok && migrateMessages(messages) {
+		request.SetBodyField("messages", messages)
+		legacy = true
+	}
+
+	if apiVersion := strings.TrimSpace(request.GetHeader(APIVersionHeader)); apiVersion != "" && apiVersion < p.toolsAPIVersion {
+		legacy = true
+	}
+	if legacy {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("migrated a legacy function calling request to tools")
+		if cycleState != nil {
+			cycleState.Write(p.typedName.String(), true)
+		}
+	}
+
+	return nil
+}
+
+// ProcessResponse converts tool calls in the response back to function calls for legacy clients.
+func (p *FunctionCallMigrationPlugin) ProcessResponse(_ context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+	if legacy, err := framework.ReadCycleStateKey[bool](cycleState, p.typedName.String()); err != nil || !legacy {
+		return nil
+	}
+
+	choices, ok := response.Body["choices"].([]any)
+	if !ok {
+		return nil
+	}
+
+	converted := false
+	for _, c := range choices {
+		choice, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		message, ok := choice["message"].(map[string]any)
+		if !ok {
+			continue
+		}
+		toolCalls, ok := message["tool_calls"].([]any)
+		if !ok || len(toolCalls) == 0 {
+			continue
+		}
+		// the deprecated format supports a single function call per message
+		if toolCall, ok := toolCalls[0].(map[string]any); ok {
+			message["function_call"] = toolCall["function"]
+		}
+		delete(message, "tool_calls")
+		if choice["finish_reason"] == "tool_calls" {
+			choice["finish_reason"] = "function_call"
+		}
+		converted = true
+	}
+
+	if converted {
+		response.SetBodyField("choices", choices)
+	}
+	return nil
+}
+
+// toToolChoice converts a function_call value ("none", "auto" or {"name":"..."}) to a tool_choice value.
+func toToolChoice(functionCall any) any {
+	if function, ok := functionCall.(map[string]any); ok {
+		return map[string]any{"type": "function", "function": function}
+	}
+	return functionCall
+}
+
+// migrateMessages converts the function calls in the conversation history to tool calls, in place.
+// Assistant messages with a function_call get a tool call with a generated ID, and the following
+// "function" role messages become "tool" role messages referencing that ID.
+// It returns whether any message was converted.
+func migrateMessages(messages []any) bool {
+	converted := false
+	callIDs := map[string]string{} // function name to the ID of its last call
+	for i, m := range messages {
+		message, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		if functionCall, ok := message["function_call"].(map[string]any); ok {
+			name, _ := functionCall["name"].(string)
+			id := fmt.Sprintf("call_%d", i)
+			callIDs[name] = id
+			message["tool_calls"] = []any{map[string]any{"id": id, "type": "function", "function": functionCall}}
+			delete(message, "function_call")
+			converted = true
+		}
+		if message["role"] == "function" {
+			name, _ := message["name"].(string)
+			message["role"] = "tool"
+			message["tool_call_id"] = callIDs[name]
+			delete(message, "name")
+			converted = true
+		}
+	}
+	return converted
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package functioncallmigration
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func unmarshal(t *testing.T, raw string) map[string]any {
+	t.Helper()
+	out := map[string]any{}
+	if err := json.Unmarshal([]byte(raw), &out); err != nil {
+		t.Fatalf("failed to unmarshal %q: %v", raw, err)
+	}
+	return out
+}
+
+func TestFunctionCallMigrationPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "custom version", rawParams: json.RawMessage(`{"tools_api_version":"2024-02-01"}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := FunctionCallMigrationPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestFunctionCallMigrationPlugin_ProcessRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "functions and function_call",
+			body: `{
+				"model": "gpt-4",
+				"messages": [{"role": "user", "content": "What's the weather in Paris?"}],
+				"functions": [{"name": "get_weather", "parameters": {"type": "object"}}],
+				"function_call": {"name": "get_weather"}
+			}`,
+			want: `{
+				"model": "gpt-4",
+				"messages": [{"role": "user", "content": "What's the weather in Paris?"}],
+				"tools": [{"type": "function", "function": {"name": "get_weather", "parameters": {"type": "object"}}}],
+				"tool_choice": {"type": "function", "function": {"name": "get_weather"}}
+			}`,
+		},
+		{
+			name: "function_call auto",
+			body: `{"model": "gpt-4", "functions": [{"name": "f"}], "function_call": "auto"}`,
+			want: `{"model": "gpt-4", "tools": [{"type": "function", "function": {"name": "f"}}], "tool_choice": "auto"}`,
+		},
+		{
+			name: "function calls in the conversation history",
+			body: `{
+				"model": "gpt-4",
+				"messages": [
+					{"role": "user", "content": "What's the weather in Paris?"},
+					{"role": "assistant", "content": null, "function_call": {"name": "get_weather", "arguments": "{\"city\":\"Paris\"}"}},
+					{"role": "function", "name": "get_weather", "content": "sunny"}
+				]
+			}`,
+			want: `{
+				"model": "gpt-4",
+				"messages": [
+					{"role": "user", "content": "What's the weather in Paris?"},
+					{"role": "assistant", "content": null, "tool_calls": [
+						{"id": "call_1", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\":\"Paris\"}"}}
+					]},
+					{"role": "tool", "tool_call_id": "call_1", "content": "sunny"}
+				]
+			}`,
+		},
+		{
+			name: "tools request is unchanged",
+			body: `{"model": "gpt-4", "tools": [{"type": "function", "function": {"name": "f"}}], "tool_choice": "auto"}`,
+			want: `{"model": "gpt-4", "tools": [{"type": "function", "function": {"name": "f"}}], "tool_choice": "auto"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewFunctionCallMigrationPlugin(defaultToolsAPIVersion)
+			request := framework.NewInferenceRequest()
+			request.Body = unmarshal(t, tt.body)
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(unmarshal(t, tt.want), request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFunctionCallMigrationPlugin_ProcessResponse(t *testing.T) {
+	const toolCallsResponse = `{
+		"choices": [{
+			"index": 0,
+			"message": {"role": "assistant", "content": null, "tool_calls": [
+				{"id": "call_abc", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\":\"Paris\"}"}}
+			]},
+			"finish_reason": "tool_calls"
+		}]
+	}`
+	const functionCallResponse = `{
+		"choices": [{
+			"index": 0,
+			"message": {"role": "assistant", "content": null, "function_call": {"name": "get_weather", "arguments": "{\"city\":\"Paris\"}"}},
+			"finish_reason": "function_call"
+		}]
+	}`
+
+	tests := []struct {
+		name       string
+		request    string
+		apiVersion string
+		want       string
+	}{
+		{
+			name:    "legacy request gets a function_call response",
+			request: `{"model": "gpt-4", "functions": [{"name": "get_weather"}]}`,
+			want:    functionCallResponse,
+		},
+		{
+			name:       "old API version gets a function_call response",
+			request:    `{"model": "gpt-4", "tools": [{"type": "function", "function": {"name": "get_weather"}}]}`,
+			apiVersion: "2023-07-01-preview",
+			want:       functionCallResponse,
+		},
+		{
+			name:       "new API version keeps tool_calls",
+			request:    `{"model": "gpt-4", "tools": [{"type": "function", "function": {"name": "get_weather"}}]}`,
+			apiVersion: "2024-02-01",
+			want:       toolCallsResponse,
+		},
+		{
+			name:    "tools request keeps tool_calls",
+			request: `{"model": "gpt-4", "tools": [{"type": "function", "function": {"name": "get_weather"}}]}`,
+			want:    toolCallsResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewFunctionCallMigrationPlugin(defaultToolsAPIVersion)
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			request.Body = unmarshal(t, tt.request)
+			if tt.apiVersion != "" {
+				request.Headers["x-api-version"] = tt.apiVersion
+			}
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := framework.NewInferenceResponse()
+			response.Body = unmarshal(t, toolCallsResponse)
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(unmarshal(t, tt.want), response.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
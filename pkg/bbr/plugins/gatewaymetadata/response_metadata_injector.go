@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewaymetadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/metadata"
+)
+
+const (
+	ResponseMetadataInjectorPluginType = "response-metadata-injector"
+	modelField                         = "model"
+	gatewayMetadataField               = "gateway_metadata"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &ResponseMetadataInjectorPlugin{}
+	_ framework.ResponseProcessor = &ResponseMetadataInjectorPlugin{}
+)
+
+// ResponseMetadataInjectorConfig defines the JSON configuration structure for the plugin.
+type ResponseMetadataInjectorConfig struct {
+	// BackendClusterHeader is the response header the backend cluster is read from.
+	// Defaults to "x-gateway-destination-endpoint-served".
+	BackendClusterHeader string `json:"backend_cluster_header"`
+}
+
+// ResponseMetadataInjectorPluginFactory defines the factory function for NewResponseMetadataInjectorPlugin.
+func ResponseMetadataInjectorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ResponseMetadataInjectorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ResponseMetadataInjectorPluginType, err)
+		}
+	}
+
+	return NewResponseMetadataInjectorPlugin(config.BackendClusterHeader).WithName(name), nil
+}
+
+// NewResponseMetadataInjectorPlugin initializes a new ResponseMetadataInjectorPlugin and returns its pointer.
+// An empty backendClusterHeader defaults to "x-gateway-destination-endpoint-served".
+func NewResponseMetadataInjectorPlugin(backendClusterHeader string) *ResponseMetadataInjectorPlugin {
+	if backendClusterHeader == "" {
+		backendClusterHeader = metadata.DestinationEndpointServedKey
+	}
+
+	p := &ResponseMetadataInjectorPlugin{
+		typedName: plugin.TypedName{
+			Type: ResponseMetadataInjectorPluginType,
+			Name: ResponseMetadataInjectorPluginType,
+		},
+		backendClusterHeader: backendClusterHeader,
+		now:                  time.Now,
+	}
+	p.enabled.Store(true)
+	return p
+}
+
+// ResponseMetadataInjectorPlugin adds a "gateway_metadata" object to JSON responses, telling clients
+// which model and backend served their request, along with the request id and the latency.
+type ResponseMetadataInjectorPlugin struct {
+	typedName            plugin.TypedName
+	backendClusterHeader string
+	// enabled allows turning the injection off globally without removing the plugin from the chain
+	enabled atomic.Bool
+	now     func() time.Time
+}
+
+// requestState is the state kept in the cycle state between the request and the response.
+type requestState struct {
+	start     time.Time
+	model     string
+	requestID string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ResponseMetadataInjectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ResponseMetadataInjectorPlugin) WithName(name string) *ResponseMetadataInjectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// SetEnabled enables or disables the injection of the gateway metadata.
+func (p *ResponseMetadataInjectorPlugin) SetEnabled(enabled bool) {
+	p.enabled.Store(enabled)
+}
+
+// ProcessRequest keeps the request start time, model and id for the response.
+func (p *ResponseMetadataInjectorPlugin) ProcessRequest(_ context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if !p.enabled.Load() {
+		return nil
+	}
+
+	model, _ := request.Body[modelField].(string)
+	cycleState.Write(p.typedName.String(), requestState{
+		start:     p.now(),
+		model:     model,
+		requestID: request.GetHeader(reqcommon.RequestIdHeaderKey),
+	})
+	return nil
+}
+
+// ProcessResponse adds the gateway metadata to the response body.
+func (p *ResponseMetadataInjectorPlugin) ProcessResponse(_ context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Headers == nil || len(response.Body) == 0 || cycleState == nil {
+		return nil
+	}
+	if !p.enabled.Load() {
+		return nil
+	}
+
+	state, err := framework.ReadCycleStateKey[requestState](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not seen by the plugin
+	}
+
+	modelUsed, _ := response.Body[modelField].(string)
+	if modelUsed == "" {
+		modelUsed = state.model
+	}
+	response.SetBodyField(gatewayMetadataField, map[string]any{
+		"model_used":      modelUsed,
+		"backend_cluster": response.GetHeader(p.backendClusterHeader),
+		"request_id":      state.requestID,
+		"latency_ms":      p.now().Sub(state.start).Milliseconds(),
+	})
+	return nil
+}
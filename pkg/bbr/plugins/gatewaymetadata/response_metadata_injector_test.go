@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatewaymetadata
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestResponseMetadataInjectorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "custom backend header", rawParams: json.RawMessage(`{"backend_cluster_header":"x-backend"}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ResponseMetadataInjectorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestResponseMetadataInjectorPlugin(t *testing.T) {
+	tests := []struct {
+		name         string
+		disabled     bool
+		responseBody map[string]any
+		want         map[string]any
+	}{
+		{
+			name:         "metadata is injected",
+			responseBody: map[string]any{"model": "llama-3-8b-v2", "object": "chat.completion"},
+			want: map[string]any{
+				"model":  "llama-3-8b-v2",
+				"object": "chat.completion",
+				"gateway_metadata": map[string]any{
+					"model_used":      "llama-3-8b-v2",
+					"backend_cluster": "10.0.0.1:8000",
+					"request_id":      "req-1",
+					"latency_ms":      int64(1500),
+				},
+			},
+		},
+		{
+			name:         "model falls back to the requested model",
+			responseBody: map[string]any{"object": "chat.completion"},
+			want: map[string]any{
+				"object": "chat.completion",
+				"gateway_metadata": map[string]any{
+					"model_used":      "llama-3-8b",
+					"backend_cluster": "10.0.0.1:8000",
+					"request_id":      "req-1",
+					"latency_ms":      int64(1500),
+				},
+			},
+		},
+		{
+			name:         "disabled plugin leaves the response untouched",
+			disabled:     true,
+			responseBody: map[string]any{"model": "llama-3-8b"},
+			want:         map[string]any{"model": "llama-3-8b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewResponseMetadataInjectorPlugin("")
+			p.SetEnabled(!tt.disabled)
+			now := time.Unix(1000, 0)
+			p.now = func() time.Time { return now }
+			cycleState := framework.NewCycleState()
+
+			request := framework.NewInferenceRequest()
+			request.Headers["x-request-id"] = "req-1"
+			request.Body["model"] = "llama-3-8b"
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("ProcessRequest() unexpected error: %v", err)
+			}
+
+			now = now.Add(1500 * time.Millisecond)
+			response := framework.NewInferenceResponse()
+			response.Headers["x-gateway-destination-endpoint-served"] = "10.0.0.1:8000"
+			response.Body = tt.responseBody
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("ProcessResponse() unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tt.want, response.Body); diff != "" {
+				t.Errorf("response body mismatch (-want +got):\n%s", diff)
+			}
+			if got := response.BodyMutated(); got == tt.disabled {
+				t.Errorf("BodyMutated() = %v, want %v", got, !tt.disabled)
+			}
+		})
+	}
+}
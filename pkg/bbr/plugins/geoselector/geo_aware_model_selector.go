@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geoselector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	GeoAwareModelSelectorPluginType = "geo-aware-model-selector"
+	ClientRegionHeader              = "X-Client-Region"
+	DestinationClusterHeader        = "X-Gateway-Destination-Cluster"
+	DestinationRegionHeader         = "X-Gateway-Destination-Region"
+	modelField                      = "model"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &GeoAwareModelSelectorPlugin{}
+
+// GeoAwareModelSelectorConfig defines the JSON configuration structure for the plugin.
+type GeoAwareModelSelectorConfig struct {
+	// Clusters maps a model to the cluster serving it in each region, e.g. {"llama":{"us":"cluster-us","eu":"cluster-eu"}}.
+	Clusters map[string]map[string]string `json:"clusters"`
+	// DefaultRegion is the region used when no cluster serves the model in or near the client region.
+	DefaultRegion string `json:"default_region"`
+}
+
+// GeoAwareModelSelectorPluginFactory defines the factory function for NewGeoAwareModelSelectorPlugin.
+func GeoAwareModelSelectorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config GeoAwareModelSelectorConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", GeoAwareModelSelectorPluginType, err)
+		}
+	}
+
+	plugin, err := NewGeoAwareModelSelectorPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", GeoAwareModelSelectorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewGeoAwareModelSelectorPlugin initializes a new GeoAwareModelSelectorPlugin and returns its pointer.
+func NewGeoAwareModelSelectorPlugin(config GeoAwareModelSelectorConfig) (*GeoAwareModelSelectorPlugin, error) {
+	if len(config.Clusters) == 0 {
+		return nil, errors.New("clusters are required in GeoAwareModelSelector plugin")
+	}
+
+	clusters := make(map[string]map[string]string, len(config.Clusters))
+	for model, regions := range config.Clusters {
+		clusters[model] = make(map[string]string, len(regions))
+		for region, cluster := range regions {
+			clusters[model][strings.ToLower(region)] = cluster
+		}
+	}
+
+	return &GeoAwareModelSelectorPlugin{
+		typedName: plugin.TypedName{
+			Type: GeoAwareModelSelectorPluginType,
+			Name: GeoAwareModelSelectorPluginType,
+		},
+		clusters:      clusters,
+		defaultRegion: strings.ToLower(config.DefaultRegion),
+	}, nil
+}
+
+// GeoAwareModelSelectorPlugin routes requests to the cluster serving the requested model nearest to
+// the client region, which is read from the X-Client-Region header. The selected cluster and region
+// are set as headers for Envoy routing.
+type GeoAwareModelSelectorPlugin struct {
+	typedName     plugin.TypedName
+	clusters      map[string]map[string]string
+	defaultRegion string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *GeoAwareModelSelectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *GeoAwareModelSelectorPlugin) WithName(name string) *GeoAwareModelSelectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the destination cluster and region headers for the requested model.
+func (p *GeoAwareModelSelectorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, ok := request.Body[modelField].(string)
+	if !ok || model == "" {
+		return nil
+	}
+	regions, ok := p.clusters[model]
+	if !ok {
+		return nil
+	}
+
+	clientRegion := strings.ToLower(strings.TrimSpace(request.GetHeader(ClientRegionHeader)))
+	region, cluster, ok := nearestCluster(regions, clientRegion, p.defaultRegion)
+	if !ok {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("no cluster serves the model near the client region", "model", model, "clientRegion", clientRegion)
+		return nil
+	}
+
+	request.SetHeader(DestinationClusterHeader, cluster)
+	request.SetHeader(DestinationRegionHeader, region)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("selected destination cluster", "model", model, "clientRegion", clientRegion, "region", region, "cluster", cluster)
+
+	return nil
+}
+
+// nearestCluster returns the region and cluster nearest to the client region. Regions are matched
+// from the most to the least specific, e.g. "eu-west-1", then "eu-west", then "eu", before falling
+// back to the default region.
+func nearestCluster(regions map[string]string, clientRegion, defaultRegion string) (string, string, bool) {
+	for region := clientRegion; region != ""; {
+		if cluster, ok := regions[region]; ok {
+			return region, cluster, true
+		}
+		i := strings.LastIndex(region, "-")
+		if i < 0 {
+			break
+		}
+		region = region[:i]
+	}
+
+	if cluster, ok := regions[defaultRegion]; ok && defaultRegion != "" {
+		return defaultRegion, cluster, true
+	}
+	return "", "", false
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geoselector
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestGeoAwareModelSelectorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"clusters":{"llama":{"us":"cluster-us","eu":"cluster-eu"}},"default_region":"us"}`)},
+		{name: "missing clusters", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := GeoAwareModelSelectorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestGeoAwareModelSelectorPlugin_ProcessRequest(t *testing.T) {
+	p, err := NewGeoAwareModelSelectorPlugin(GeoAwareModelSelectorConfig{
+		Clusters: map[string]map[string]string{
+			"llama": {"us": "cluster-us", "eu": "cluster-eu", "ap-east": "cluster-ap-east"},
+		},
+		DefaultRegion: "us",
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		model       string
+		region      string
+		wantCluster string
+		wantRegion  string
+	}{
+		{name: "exact region", model: "llama", region: "ap-east", wantCluster: "cluster-ap-east", wantRegion: "ap-east"},
+		{name: "nearest region by prefix", model: "llama", region: "eu-west-1", wantCluster: "cluster-eu", wantRegion: "eu"},
+		{name: "region is case insensitive", model: "llama", region: "US-East", wantCluster: "cluster-us", wantRegion: "us"},
+		{name: "unknown region falls back to default", model: "llama", region: "sa-east", wantCluster: "cluster-us", wantRegion: "us"},
+		{name: "missing region falls back to default", model: "llama", wantCluster: "cluster-us", wantRegion: "us"},
+		{name: "unknown model", model: "mistral", region: "eu-west"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body[modelField] = tt.model
+			if tt.region != "" {
+				request.Headers["x-client-region"] = tt.region
+			}
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Headers[DestinationClusterHeader]; got != tt.wantCluster {
+				t.Errorf("Headers[%q] = %q, want %q", DestinationClusterHeader, got, tt.wantCluster)
+			}
+			if got := request.Headers[DestinationRegionHeader]; got != tt.wantRegion {
+				t.Errorf("Headers[%q] = %q, want %q", DestinationRegionHeader, got, tt.wantRegion)
+			}
+		})
+	}
+}
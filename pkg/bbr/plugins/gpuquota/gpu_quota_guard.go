@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpuquota
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	GPUQuotaGuardPluginType = "gpu-quota-guard"
+	modelField              = "model"
+
+	defaultLeaseTimeoutSeconds = 600
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor = &GPUQuotaGuardPlugin{}
+	_ framework.RequestCompleter = &GPUQuotaGuardPlugin{}
+)
+
+// GPUQuotaGuardConfig defines the JSON configuration structure for the plugin.
+type GPUQuotaGuardConfig struct {
+	// GPUQuota maps a model name to the number of its requests that may be in flight at the same time,
+	// e.g. {"gpt-4": 10, "llama-3": 20}. Requests for other models are not limited.
+	GPUQuota map[string]int `json:"gpu_quota"`
+	// LeaseTimeoutSeconds is the duration after which an in-flight request that was never completed
+	// stops counting against the quota. Requests are completed when their ext_proc stream ends, so the
+	// timeout only bounds leases that leak otherwise.
+	LeaseTimeoutSeconds int `json:"lease_timeout_seconds"`
+}
+
+// GPUQuotaGuardPluginFactory defines the factory function for NewGPUQuotaGuardPlugin.
+func GPUQuotaGuardPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := GPUQuotaGuardConfig{
+		LeaseTimeoutSeconds: defaultLeaseTimeoutSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", GPUQuotaGuardPluginType, err)
+		}
+	}
+
+	plugin, err := NewGPUQuotaGuardPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", GPUQuotaGuardPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewGPUQuotaGuardPlugin initializes a new GPUQuotaGuardPlugin and returns its pointer.
+func NewGPUQuotaGuardPlugin(config GPUQuotaGuardConfig) (*GPUQuotaGuardPlugin, error) {
+	if len(config.GPUQuota) == 0 {
+		return nil, errors.New("gpu_quota is required in GPUQuotaGuard plugin")
+	}
+	for model, quota := range config.GPUQuota {
+		if quota <= 0 {
+			return nil, fmt.Errorf("quota of model %q must be positive in GPUQuotaGuard plugin", model)
+		}
+	}
+	if config.LeaseTimeoutSeconds <= 0 {
+		return nil, errors.New("lease_timeout_seconds must be positive in GPUQuotaGuard plugin")
+	}
+
+	return &GPUQuotaGuardPlugin{
+		typedName: plugin.TypedName{
+			Type: GPUQuotaGuardPluginType,
+			Name: GPUQuotaGuardPluginType,
+		},
+		quotas:       config.GPUQuota,
+		leaseTimeout: time.Duration(config.LeaseTimeoutSeconds) * time.Second,
+		inFlight:     map[string]map[uint64]time.Time{},
+		now:          time.Now,
+	}, nil
+}
+
+// GPUQuotaGuardPlugin keeps the number of in-flight requests of each model within its GPU quota, so that
+// a BBR server sharing a GPU cluster does not forward more GPU-bound requests than it was allocated.
+// Each request takes a lease on a slot of its model's quota, which is released when the request completes,
+// whether its response was processed or not, e.g. when it was rejected by a later plugin, the upstream failed
+// or the client disconnected, or when the lease times out. Requests exceeding the quota are rejected with
+// HTTP 503.
+type GPUQuotaGuardPlugin struct {
+	typedName    plugin.TypedName
+	quotas       map[string]int
+	leaseTimeout time.Duration
+
+	lock sync.Mutex
+	// inFlight maps a model to the acquisition time of each of its leases
+	inFlight  map[string]map[uint64]time.Time
+	nextLease uint64
+	now       func() time.Time
+}
+
+// lease identifies the quota slot held by a request.
+type lease struct {
+	model string
+	id    uint64
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *GPUQuotaGuardPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *GPUQuotaGuardPlugin) WithName(name string) *GPUQuotaGuardPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest acquires a lease on the quota of the requested model, or rejects the request.
+func (p *GPUQuotaGuardPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, _ := request.Body[modelField].(string)
+	quota, ok := p.quotas[model]
+	if !ok {
+		return nil
+	}
+
+	id, ok := p.acquire(model, quota)
+	if !ok {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("GPU quota exceeded", "model", model, "quota", quota)
+		return errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: fmt.Sprintf("GPU quota of model %s exceeded", model)}
+	}
+	cycleState.Write(p.typedName.String(), lease{model: model, id: id})
+	return nil
+}
+
+// CompleteRequest releases the lease of the request. Streamed responses keep the lease until the end of
+// the stream, since the model is busy generating them until then.
+func (p *GPUQuotaGuardPlugin) CompleteRequest(_ context.Context, cycleState *framework.CycleState) {
+	if cycleState == nil {
+		return
+	}
+
+	l, err := framework.ReadCycleStateKey[lease](cycleState, p.typedName.String())
+	if err != nil {
+		return // the request holds no lease
+	}
+	cycleState.Delete(p.typedName.String())
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.inFlight[l.model], l.id)
+}
+
+// acquire takes a lease on the quota of the model, if one is available.
+func (p *GPUQuotaGuardPlugin) acquire(model string, quota int) (uint64, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+	leases, ok := p.inFlight[model]
+	if !ok {
+		leases = map[uint64]time.Time{}
+		p.inFlight[model] = leases
+	}
+	if len(leases) >= quota {
+		// reclaim the leases that timed out
+		for id, acquired := range leases {
+			if now.Sub(acquired) >= p.leaseTimeout {
+				delete(leases, id)
+			}
+		}
+		if len(leases) >= quota {
+			return 0, false
+		}
+	}
+
+	p.nextLease++
+	leases[p.nextLease] = now
+	return p.nextLease, true
+}
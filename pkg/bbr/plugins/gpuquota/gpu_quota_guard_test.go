@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gpuquota
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestGPUQuotaGuardPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"gpu_quota":{"gpt-4":10,"llama-3":20}}`)},
+		{name: "custom lease timeout", rawParams: json.RawMessage(`{"gpu_quota":{"gpt-4":10},"lease_timeout_seconds":60}`)},
+		{name: "missing quota", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "non-positive quota", rawParams: json.RawMessage(`{"gpu_quota":{"gpt-4":0}}`), wantErr: true},
+		{name: "non-positive lease timeout", rawParams: json.RawMessage(`{"gpu_quota":{"gpt-4":10},"lease_timeout_seconds":-1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := GPUQuotaGuardPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestGPUQuotaGuardPlugin(t *testing.T) {
+	p, err := NewGPUQuotaGuardPlugin(GPUQuotaGuardConfig{GPUQuota: map[string]int{"gpt-4": 2}, LeaseTimeoutSeconds: 60})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	send := func(model string) (*framework.CycleState, error) {
+		cycleState := framework.NewCycleState()
+		request := framework.NewInferenceRequest()
+		request.Body["model"] = model
+		return cycleState, p.ProcessRequest(context.Background(), cycleState, request)
+	}
+	complete := func(cycleState *framework.CycleState) {
+		p.CompleteRequest(context.Background(), cycleState)
+	}
+	wantRejected := func(step string, err error) {
+		t.Helper()
+		if got := errcommon.CanonicalCode(err); got != errcommon.ServiceUnavailable {
+			t.Errorf("%s: CanonicalCode = %q, want %q", step, got, errcommon.ServiceUnavailable)
+		}
+	}
+
+	first, err := send("gpt-4")
+	if err != nil {
+		t.Fatalf("first request: unexpected error: %v", err)
+	}
+	if _, err := send("gpt-4"); err != nil {
+		t.Fatalf("second request: unexpected error: %v", err)
+	}
+	_, err = send("gpt-4")
+	wantRejected("request over quota", err)
+	if _, err := send("llama-3"); err != nil {
+		t.Errorf("request for a model without quota: unexpected error: %v", err)
+	}
+
+	complete(first)
+	complete(first) // a lease is released only once
+	if _, err := send("gpt-4"); err != nil {
+		t.Fatalf("request after release: unexpected error: %v", err)
+	}
+	_, err = send("gpt-4")
+	wantRejected("request over quota after release", err)
+
+	now = now.Add(time.Minute)
+	if _, err := send("gpt-4"); err != nil {
+		t.Errorf("request after lease timeout: unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	HealthCheckBypassPluginType = "health-check-bypass"
+
+	// EnvoyInternalHeader is set to "true" by Envoy on requests originating from within the mesh, e.g. health check probes.
+	EnvoyInternalHeader = "X-Envoy-Internal"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor = &HealthCheckBypassPlugin{}
+	_ framework.RequestPlanner   = &HealthCheckBypassPlugin{}
+)
+
+// HealthCheckBypassConfig defines the JSON configuration structure for the plugin.
+type HealthCheckBypassConfig struct {
+	// ProbeBodies are the bodies of health check probes, in addition to the empty body, e.g. ["{\"model\":\"health\"}"].
+	ProbeBodies []string `json:"probe_bodies"`
+}
+
+// HealthCheckBypassPluginFactory defines the factory function for NewHealthCheckBypassPlugin.
+func HealthCheckBypassPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := HealthCheckBypassConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", HealthCheckBypassPluginType, err)
+		}
+	}
+
+	return NewHealthCheckBypassPlugin(config.ProbeBodies).WithName(name), nil
+}
+
+// NewHealthCheckBypassPlugin initializes a new HealthCheckBypassPlugin and returns its pointer.
+func NewHealthCheckBypassPlugin(probeBodies []string) *HealthCheckBypassPlugin {
+	bodies := make([][]byte, 0, len(probeBodies))
+	for _, body := range probeBodies {
+		bodies = append(bodies, bytes.TrimSpace([]byte(body)))
+	}
+
+	return &HealthCheckBypassPlugin{
+		typedName: plugin.TypedName{
+			Type: HealthCheckBypassPluginType,
+			Name: HealthCheckBypassPluginType,
+		},
+		probeBodies: bodies,
+	}
+}
+
+// HealthCheckBypassPlugin forwards the health check probes of Envoy unchanged, without running the
+// remaining request plugins, which would otherwise e.g. reject the probes as unauthenticated. A request
+// is a probe when its X-Envoy-Internal header is "true" and its body is empty or one of the configured
+// probe bodies. The plugin must be the first in the chain, so that no plugin mutates the probes.
+// Bypassed probes are counted in the bbr_health_check_bypasses_total metric.
+type HealthCheckBypassPlugin struct {
+	typedName   plugin.TypedName
+	probeBodies [][]byte
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *HealthCheckBypassPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *HealthCheckBypassPlugin) WithName(name string) *HealthCheckBypassPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest skips the remaining request plugins for health check probes.
+func (p *HealthCheckBypassPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if !strings.EqualFold(request.GetHeader(EnvoyInternalHeader), "true") {
+		return nil
+	}
+
+	body, err := framework.ReadCycleStateKey[[]byte](cycleState, framework.RequestBodyBytesKey)
+	if err != nil {
+		return nil // the raw body is not available
+	}
+	if !p.isProbeBody(bytes.TrimSpace(body)) {
+		return nil
+	}
+
+	metrics.RecordHealthCheckBypass()
+	log.FromContext(ctx).V(logutil.DEBUG).Info("bypassing the request plugins for a health check probe")
+	return framework.ErrSkipRemainingPlugins
+}
+
+// isProbeBody returns whether the trimmed body is empty or one of the probe bodies.
+func (p *HealthCheckBypassPlugin) isProbeBody(body []byte) bool {
+	if len(body) == 0 {
+		return true
+	}
+	for _, probeBody := range p.probeBodies {
+		if bytes.Equal(body, probeBody) {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanRequest predicts that the plugin skips the remaining plugins for health check probes. The raw body is
+// not available to plans, so the parsed body is compared with the parsed probe bodies.
+func (p *HealthCheckBypassPlugin) PlanRequest(_ context.Context, request *framework.InferenceRequest) framework.RequestPlan {
+	plan := framework.RequestPlan{RequiresBody: true}
+	if request == nil || request.Headers == nil || !strings.EqualFold(request.GetHeader(EnvoyInternalHeader), "true") {
+		return plan
+	}
+	if len(request.Body) == 0 {
+		plan.Fires = true
+		return plan
+	}
+	for _, probeBody := range p.probeBodies {
+		var parsed map[string]any
+		if json.Unmarshal(probeBody, &parsed) == nil && reflect.DeepEqual(parsed, request.Body) {
+			plan.Fires = true
+			break
+		}
+	}
+	return plan
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+)
+
+func TestHealthCheckBypassPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "probe bodies", rawParams: json.RawMessage(`{"probe_bodies":["{\"model\":\"health\"}"]}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := HealthCheckBypassPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestHealthCheckBypassPlugin(t *testing.T) {
+	metrics.Register()
+	p := NewHealthCheckBypassPlugin([]string{` {"model":"health"} `})
+
+	tests := []struct {
+		name     string
+		internal string
+		body     string
+		wantSkip bool
+	}{
+		{name: "probe with an empty body", internal: "true", body: "", wantSkip: true},
+		{name: "probe with a whitespace body", internal: "TRUE", body: " \n", wantSkip: true},
+		{name: "probe with a configured body", internal: "true", body: `{"model":"health"}`, wantSkip: true},
+		{name: "internal request with another body", internal: "true", body: `{"model":"llama"}`},
+		{name: "external request with an empty body", body: ""},
+		{name: "non-internal request", internal: "false", body: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			if tt.internal != "" {
+				request.Headers["x-envoy-internal"] = tt.internal
+			}
+			cycleState := framework.NewCycleState()
+			cycleState.Write(framework.RequestBodyBytesKey, []byte(tt.body))
+
+			err := p.ProcessRequest(context.Background(), cycleState, request)
+			if got := errors.Is(err, framework.ErrSkipRemainingPlugins); got != tt.wantSkip {
+				t.Errorf("skipped the remaining plugins = %v (error %v), want %v", got, err, tt.wantSkip)
+			}
+			if !tt.wantSkip && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestHealthCheckBypassPluginPlanRequest(t *testing.T) {
+	p := NewHealthCheckBypassPlugin([]string{`{"model":"health"}`})
+	tests := []struct {
+		name      string
+		internal  bool
+		body      map[string]any
+		wantFires bool
+	}{
+		{name: "empty probe", internal: true, body: map[string]any{}, wantFires: true},
+		{name: "configured probe", internal: true, body: map[string]any{"model": "health"}, wantFires: true},
+		{name: "internal request", internal: true, body: map[string]any{"model": "llama"}},
+		{name: "external request", body: map[string]any{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			if tt.internal {
+				request.SetHeader(EnvoyInternalHeader, "true")
+			}
+			request.Body = tt.body
+			if got := p.PlanRequest(context.Background(), request).Fires; got != tt.wantFires {
+				t.Errorf("Fires = %v, want %v", got, tt.wantFires)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package htmlsanitizer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	HTMLSanitizerPluginType = "html-sanitizer"
+	messagesField           = "messages"
+
+	// StripMode removes the HTML tags and keeps the text content.
+	StripMode = "strip"
+	// EscapeMode HTML-encodes the tags, so they are kept as plain text.
+	EscapeMode = "escape"
+)
+
+// rawTextTags are the elements whose content is code rather than text, and is removed with the tags in strip mode.
+var rawTextTags = sets.New("script", "style")
+
+// compile-time type validation
+var _ framework.RequestProcessor = &HTMLSanitizerPlugin{}
+
+// HTMLSanitizerConfig defines the JSON configuration structure for the plugin.
+type HTMLSanitizerConfig struct {
+	// Mode is either "strip" (default) to remove the HTML tags, or "escape" to HTML-encode them.
+	Mode string `json:"mode"`
+	// AllowList is the list of tags that are kept, e.g. ["b","i","code"]. Attributes of allowed tags are removed.
+	AllowList []string `json:"allow_list"`
+}
+
+// HTMLSanitizerPluginFactory defines the factory function for NewHTMLSanitizerPlugin.
+func HTMLSanitizerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := HTMLSanitizerConfig{Mode: StripMode}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", HTMLSanitizerPluginType, err)
+		}
+	}
+
+	plugin, err := NewHTMLSanitizerPlugin(config.Mode, config.AllowList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", HTMLSanitizerPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewHTMLSanitizerPlugin initializes a new HTMLSanitizerPlugin and returns its pointer.
+func NewHTMLSanitizerPlugin(mode string, allowList []string) (*HTMLSanitizerPlugin, error) {
+	if mode != StripMode && mode != EscapeMode {
+		return nil, errors.New("mode must be either 'strip' or 'escape' in HTMLSanitizer plugin")
+	}
+
+	allowed := sets.New[string]()
+	for _, tag := range allowList {
+		allowed.Insert(strings.ToLower(strings.TrimSpace(tag)))
+	}
+
+	return &HTMLSanitizerPlugin{
+		typedName: plugin.TypedName{
+			Type: HTMLSanitizerPluginType,
+			Name: HTMLSanitizerPluginType,
+		},
+		escape:  mode == EscapeMode,
+		allowed: allowed,
+	}, nil
+}
+
+// HTMLSanitizerPlugin strips or escapes HTML and XML tags in the content of chat messages, which can
+// confuse LLM tokenizers and carry script injection payloads, while preserving the text content.
+type HTMLSanitizerPlugin struct {
+	typedName plugin.TypedName
+	escape    bool
+	allowed   sets.Set[string]
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *HTMLSanitizerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *HTMLSanitizerPlugin) WithName(name string) *HTMLSanitizerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sanitizes the content of each chat message.
+func (p *HTMLSanitizerPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	messages, ok := request.Body[messagesField].([]any)
+	if !ok {
+		return nil
+	}
+
+	sanitized := false
+	for _, m := range messages {
+		message, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch content := message["content"].(type) {
+		case string:
+			if clean := p.sanitize(content); clean != content {
+				message["content"] = clean
+				sanitized = true
+			}
+		case []any: // content parts, e.g. [{"type":"text","text":"..."}]
+			for _, part := range content {
+				if textPart, ok := part.(map[string]any); ok {
+					if text, ok := textPart["text"].(string); ok {
+						if clean := p.sanitize(text); clean != text {
+							textPart["text"] = clean
+							sanitized = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if sanitized {
+		request.SetBodyField(messagesField, messages)
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("sanitized HTML in the request messages")
+	}
+	return nil
+}
+
+// sanitize strips or escapes the tags of the given text that are not allowed.
+func (p *HTMLSanitizerPlugin) sanitize(text string) string {
+	if !strings.ContainsAny(text, "<>") {
+		return text
+	}
+
+	var out strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(text))
+	skipUntil := "" // the end tag of a removed script or style element
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			if errors.Is(tokenizer.Err(), io.EOF) {
+				return out.String()
+			}
+			// the remainder can not be tokenized, escape it rather than forwarding it
+			out.WriteString(html.EscapeString(string(tokenizer.Raw())))
+			return out.String()
+		}
+		raw := string(tokenizer.Raw())
+
+		switch tokenType {
+		case html.TextToken:
+			if skipUntil == "" {
+				out.WriteString(raw)
+			}
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			tag := string(name)
+			if skipUntil != "" {
+				if tokenType == html.EndTagToken && tag == skipUntil {
+					skipUntil = ""
+				}
+				continue
+			}
+			switch {
+			case p.allowed.Has(tag):
+				out.WriteString(withoutAttributes(tokenType, tag))
+			case p.escape:
+				out.WriteString(html.EscapeString(raw))
+			case tokenType == html.StartTagToken && rawTextTags.Has(tag):
+				skipUntil = tag
+			}
+		default: // comments and doctypes
+			if p.escape && skipUntil == "" {
+				out.WriteString(html.EscapeString(raw))
+			}
+		}
+	}
+}
+
+// withoutAttributes renders a tag without its attributes, which may carry event handler payloads.
+func withoutAttributes(tokenType html.TokenType, tag string) string {
+	switch tokenType {
+	case html.EndTagToken:
+		return "</" + tag + ">"
+	case html.SelfClosingTagToken:
+		return "<" + tag + "/>"
+	default:
+		return "<" + tag + ">"
+	}
+}
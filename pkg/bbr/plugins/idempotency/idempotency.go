@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/credential"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	IdempotencyPluginType = "idempotency"
+	// IdempotencyKeyHeader is the request header carrying the client-generated idempotency key.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// ReplayedHeader is set to "true" on responses that are replayed from the cache.
+	ReplayedHeader = "Idempotent-Replayed"
+
+	defaultTTLSeconds         = 24 * 60 * 60
+	defaultMaxEntries         = 10000
+	defaultMaxWaitSeconds     = 60
+	defaultMaxPendingRequests = 10000
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &IdempotencyPlugin{}
+	_ framework.ResponseProcessor = &IdempotencyPlugin{}
+	_ framework.RequestCompleter  = &IdempotencyPlugin{}
+)
+
+// IdempotencyConfig defines the JSON configuration structure for the plugin.
+type IdempotencyConfig struct {
+	// TTLSeconds is how long a response is replayed for its idempotency key. Defaults to 24 hours.
+	TTLSeconds int `json:"ttl_seconds"`
+	// MaxEntries bounds the number of cached responses. Defaults to 10000.
+	MaxEntries int `json:"max_entries"`
+	// MaxWaitSeconds is the maximum duration a request waits for the response of an in-flight request
+	// with the same idempotency key, before it is forwarded upstream on its own. Defaults to 60.
+	MaxWaitSeconds int `json:"max_wait_seconds"`
+	// MaxPendingRequests is the maximum number of in-flight requests that requests with the same idempotency
+	// key can wait for. Beyond it, requests are forwarded upstream and their responses are not cached.
+	// Defaults to 10000.
+	MaxPendingRequests int `json:"max_pending_requests"`
+}
+
+// IdempotencyPluginFactory defines the factory function for NewIdempotencyPlugin.
+func IdempotencyPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := IdempotencyConfig{
+		TTLSeconds:         defaultTTLSeconds,
+		MaxEntries:         defaultMaxEntries,
+		MaxWaitSeconds:     defaultMaxWaitSeconds,
+		MaxPendingRequests: defaultMaxPendingRequests,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", IdempotencyPluginType, err)
+		}
+	}
+
+	plugin, err := NewIdempotencyPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", IdempotencyPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewIdempotencyPlugin initializes a new IdempotencyPlugin and returns its pointer.
+func NewIdempotencyPlugin(config IdempotencyConfig) (*IdempotencyPlugin, error) {
+	if config.TTLSeconds <= 0 || config.MaxEntries <= 0 || config.MaxWaitSeconds <= 0 || config.MaxPendingRequests <= 0 {
+		return nil, errors.New("ttl_seconds, max_entries, max_wait_seconds and max_pending_requests must be positive in Idempotency plugin")
+	}
+
+	return &IdempotencyPlugin{
+		typedName: plugin.TypedName{
+			Type: IdempotencyPluginType,
+			Name: IdempotencyPluginType,
+		},
+		maxWait:    time.Duration(config.MaxWaitSeconds) * time.Second,
+		maxPending: config.MaxPendingRequests,
+		responses:  expirable.NewLRU[string, cachedResponse](config.MaxEntries, nil, time.Duration(config.TTLSeconds)*time.Second),
+		pending:    map[string]*flight{},
+		now:        time.Now,
+	}, nil
+}
+
+// IdempotencyPlugin replays the response of non-streaming requests with the same Idempotency-Key
+// header, so client retries do not cause duplicate charges. The response of the first request with a
+// key is cached, and following requests with the key get the cached response as an immediate response.
+// Requests that arrive while the first one is in flight wait for its response. Only successful (2xx)
+// responses are cached, so the requests with the key can be retried after an error, and the waiting requests
+// are forwarded upstream when the first request ends without a successful response.
+// Keys are scoped by the credentials of the client, so a client cannot replay the responses of another
+// client. A request reusing a key with a different body is rejected with HTTP 422, or with HTTP 409
+// while the first request with the key is in flight, rather than getting the response of another request.
+type IdempotencyPlugin struct {
+	typedName  plugin.TypedName
+	maxWait    time.Duration
+	maxPending int
+
+	// responses maps a scoped idempotency key to its response.
+	responses *expirable.LRU[string, cachedResponse]
+
+	lock    sync.Mutex
+	pending map[string]*flight
+	now     func() time.Time
+}
+
+// cachedResponse is a cached response, with the fingerprint of the body of its request.
+type cachedResponse struct {
+	fingerprint string
+	body        []byte
+}
+
+// flight is an in-flight request that requests with the same idempotency key can wait for.
+type flight struct {
+	key         string
+	started     time.Time
+	fingerprint string
+	done        chan struct{}
+	once        sync.Once
+	// body is the response body, set before done is closed. It is nil if the response is not cached.
+	body []byte
+}
+
+// release sets the response body of the flight and wakes up the requests waiting for it, once.
+func (f *flight) release(body []byte) {
+	f.once.Do(func() {
+		f.body = body
+		close(f.done)
+	})
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *IdempotencyPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *IdempotencyPlugin) WithName(name string) *IdempotencyPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest replays the cached response of the idempotency key, or forwards the first request with the key.
+func (p *IdempotencyPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	key := request.GetHeader(IdempotencyKeyHeader)
+	if key == "" {
+		return nil
+	}
+	if stream, _ := request.Body["stream"].(bool); stream {
+		return nil // streamed responses are not replayed
+	}
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	scopedKey := credential.Hash(request) + "/" + key
+	bodyFingerprint, err := fingerprint(request.Body)
+	if err != nil {
+		return nil // this shouldn't happen, the body was parsed from JSON
+	}
+
+	p.lock.Lock()
+	if cached, ok := p.responses.Get(scopedKey); ok {
+		p.lock.Unlock()
+		if cached.fingerprint != bodyFingerprint {
+			logger.Info("rejected the reuse of an idempotency key with a different body", "idempotencyKey", key)
+			return errcommon.Error{Code: errcommon.UnprocessableEntity, Msg: "the idempotency key was already used with a different request body"}
+		}
+		logger.Info("replaying the cached response of the idempotency key", "idempotencyKey", key)
+		return replay(cached.body)
+	}
+	inFlight, ok := p.pending[scopedKey]
+	if !ok || p.expired(inFlight) {
+		// no request with the key is in flight, or its response got lost
+		if !ok && len(p.pending) >= p.maxPending {
+			p.pruneExpired()
+		}
+		if !ok && len(p.pending) >= p.maxPending {
+			p.lock.Unlock()
+			return nil // too many requests in flight, forward the request without caching its response
+		}
+		forwarded := &flight{key: scopedKey, started: p.now(), fingerprint: bodyFingerprint, done: make(chan struct{})}
+		p.pending[scopedKey] = forwarded
+		p.lock.Unlock()
+		cycleState.Write(p.typedName.String(), forwarded)
+		return nil
+	}
+	p.lock.Unlock()
+
+	if inFlight.fingerprint != bodyFingerprint {
+		logger.Info("rejected a request with a different body than the in-flight request with the same idempotency key", "idempotencyKey", key)
+		return errcommon.Error{Code: errcommon.Conflict, Msg: "a request with the same idempotency key and a different body is in progress"}
+	}
+
+	logger.Info("waiting for the response of an in-flight request with the same idempotency key", "idempotencyKey", key)
+	timer := time.NewTimer(p.maxWait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		logger.Info("timed out waiting for an in-flight request with the same idempotency key, forwarding the request")
+		return nil
+	case <-inFlight.done:
+	}
+
+	if inFlight.body == nil {
+		return nil // the in-flight request failed or ended without a response, forward the retry
+	}
+	return replay(inFlight.body)
+}
+
+// ProcessResponse caches the response of the idempotency key, and hands it over to the waiting requests.
+func (p *IdempotencyPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if cycleState == nil {
+		return nil
+	}
+
+	forwarded, err := framework.ReadCycleStateKey[*flight](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request has no idempotency key, or its response was already handled
+	}
+
+	var body []byte
+	if response != nil && response.Body != nil && response.IsSuccess() {
+		body, _ = json.Marshal(response.Body)
+	}
+
+	p.release(cycleState, forwarded, body)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("completed the request of the idempotency key", "cached", body != nil)
+	return nil
+}
+
+// CompleteRequest releases the in-flight request if it ended without a response, e.g. when it was rejected by
+// a later plugin, the upstream failed or the client disconnected, so that the requests waiting for it are
+// forwarded upstream rather than waiting for the maximum wait.
+func (p *IdempotencyPlugin) CompleteRequest(_ context.Context, cycleState *framework.CycleState) {
+	if cycleState == nil {
+		return
+	}
+	forwarded, err := framework.ReadCycleStateKey[*flight](cycleState, p.typedName.String())
+	if err != nil {
+		return // the request has no idempotency key, or its response was already handled
+	}
+	p.release(cycleState, forwarded, nil)
+}
+
+// release removes the forwarded request from the in-flight requests, caches its response body if not nil, and
+// wakes up the requests waiting for it.
+func (p *IdempotencyPlugin) release(cycleState *framework.CycleState, forwarded *flight, body []byte) {
+	cycleState.Delete(p.typedName.String())
+
+	p.lock.Lock()
+	if p.pending[forwarded.key] == forwarded {
+		delete(p.pending, forwarded.key)
+	}
+	if body != nil {
+		p.responses.Add(forwarded.key, cachedResponse{fingerprint: forwarded.fingerprint, body: body})
+	}
+	p.lock.Unlock()
+
+	forwarded.release(body)
+}
+
+// expired returns whether the in-flight request is older than the maximum wait, so that its response is
+// considered lost. It must be called with the lock held.
+func (p *IdempotencyPlugin) expired(inFlight *flight) bool {
+	return p.now().Sub(inFlight.started) > p.maxWait
+}
+
+// pruneExpired removes the expired in-flight requests. It must be called with the lock held.
+func (p *IdempotencyPlugin) pruneExpired() {
+	for key, inFlight := range p.pending {
+		if p.expired(inFlight) {
+			delete(p.pending, key)
+		}
+	}
+}
+
+// fingerprint returns the hex SHA-256 hash of the request body. The keys of the body are marshaled in
+// sorted order, so the fingerprint does not depend on the order of the fields in the request.
+func fingerprint(body map[string]any) (string, error) {
+	marshaled, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(marshaled)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replay returns an immediate response with a copy of the given body.
+func replay(body []byte) error {
+	copied := make([]byte, len(body))
+	copy(copied, body)
+	return errcommon.ImmediateResponse{
+		Headers: map[string]string{"content-type": "application/json", ReplayedHeader: "true"},
+		Body:    copied,
+	}
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestIdempotencyPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "valid config", rawParams: json.RawMessage(`{"ttl_seconds":3600,"max_entries":100,"max_wait_seconds":10}`)},
+		{name: "non-positive TTL", rawParams: json.RawMessage(`{"ttl_seconds":0}`), wantErr: true},
+		{name: "non-positive max pending requests", rawParams: json.RawMessage(`{"max_pending_requests":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := IdempotencyPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func newPlugin(t *testing.T, maxWaitSeconds int) *IdempotencyPlugin {
+	t.Helper()
+	p, err := NewIdempotencyPlugin(IdempotencyConfig{TTLSeconds: 60, MaxEntries: 10, MaxWaitSeconds: maxWaitSeconds, MaxPendingRequests: 10})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	return p
+}
+
+func newRequest(key string) *framework.InferenceRequest {
+	request := framework.NewInferenceRequest()
+	request.Body["model"] = "llama"
+	request.Body["prompt"] = "hello"
+	if key != "" {
+		request.Headers["idempotency-key"] = key
+	}
+	return request
+}
+
+// newResponse returns a response with the given status code and body.
+func newResponse(status string, body map[string]any) *framework.InferenceResponse {
+	response := framework.NewInferenceResponse()
+	response.Headers[framework.StatusHeader] = status
+	response.Body = body
+	return response
+}
+
+// startRetry processes a retry with the given key in the background.
+func startRetry(ctx context.Context, p *IdempotencyPlugin, key string) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- p.ProcessRequest(ctx, framework.NewCycleState(), newRequest(key))
+	}()
+	return result
+}
+
+// wantReplay checks that err is an immediate response replaying the given body.
+func wantReplay(t *testing.T, err error, wantBody string) {
+	t.Helper()
+	var immediate errcommon.ImmediateResponse
+	if !errors.As(err, &immediate) {
+		t.Fatalf("expected an immediate response, got %v", err)
+	}
+	if got := string(immediate.Body); got != wantBody {
+		t.Errorf("Body = %s, want %s", got, wantBody)
+	}
+	if got := immediate.Headers[ReplayedHeader]; got != "true" {
+		t.Errorf("Headers[%q] = %q, want %q", ReplayedHeader, got, "true")
+	}
+}
+
+func TestIdempotencyPlugin(t *testing.T) {
+	p := newPlugin(t, 60)
+	ctx := context.Background()
+
+	firstState := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, firstState, newRequest("key-1")); err != nil {
+		t.Fatalf("unexpected error for the first request: %v", err)
+	}
+	// requests with other keys, or without a key, are forwarded
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), newRequest("key-2")); err != nil {
+		t.Fatalf("unexpected error for another key: %v", err)
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), newRequest("")); err != nil {
+		t.Fatalf("unexpected error without a key: %v", err)
+	}
+
+	inFlightRetry := startRetry(ctx, p, "key-1")
+	select {
+	case err := <-inFlightRetry:
+		t.Fatalf("retry did not wait for the in-flight request: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	response := newResponse("200", map[string]any{"choices": []any{map[string]any{"text": "hi"}}})
+	if err := p.ProcessResponse(ctx, firstState, response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantReplay(t, <-inFlightRetry, `{"choices":[{"text":"hi"}]}`)
+	// a later retry is replayed from the cache
+	wantReplay(t, p.ProcessRequest(ctx, framework.NewCycleState(), newRequest("key-1")), `{"choices":[{"text":"hi"}]}`)
+}
+
+func TestIdempotencyPlugin_ErrorResponse(t *testing.T) {
+	p := newPlugin(t, 60)
+	ctx := context.Background()
+
+	firstState := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, firstState, newRequest("key-1")); err != nil {
+		t.Fatalf("unexpected error for the first request: %v", err)
+	}
+	inFlightRetry := startRetry(ctx, p, "key-1")
+	time.Sleep(50 * time.Millisecond)
+
+	// vLLM error bodies have no top-level error field
+	response := newResponse("400", map[string]any{"object": "error", "message": "oops"})
+	if err := p.ProcessResponse(ctx, firstState, response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// error responses are neither replayed nor cached, so the retries are forwarded
+	if err := <-inFlightRetry; err != nil {
+		t.Errorf("unexpected error for the in-flight retry: %v", err)
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), newRequest("key-1")); err != nil {
+		t.Errorf("unexpected error for a later retry: %v", err)
+	}
+}
+
+func TestIdempotencyPlugin_CompleteRequest(t *testing.T) {
+	p := newPlugin(t, 60)
+	ctx := context.Background()
+
+	firstState := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, firstState, newRequest("key-1")); err != nil {
+		t.Fatalf("unexpected error for the first request: %v", err)
+	}
+	inFlightRetry := startRetry(ctx, p, "key-1")
+	time.Sleep(50 * time.Millisecond)
+
+	// the first request ends without a response, e.g. rejected by a later plugin
+	p.CompleteRequest(ctx, firstState)
+
+	select {
+	case err := <-inFlightRetry:
+		if err != nil {
+			t.Errorf("unexpected error for the in-flight retry: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the in-flight retry was not released")
+	}
+	if got := len(p.pending); got != 0 {
+		t.Errorf("pending requests = %d, want 0", got)
+	}
+	// completing a request after its response is a no-op
+	p.CompleteRequest(ctx, firstState)
+}
+
+func TestIdempotencyPlugin_MaxPendingRequests(t *testing.T) {
+	p, err := NewIdempotencyPlugin(IdempotencyConfig{TTLSeconds: 60, MaxEntries: 10, MaxWaitSeconds: 1, MaxPendingRequests: 1})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), newRequest("key-1")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the map is full, the request is forwarded without being tracked
+	untracked := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, untracked, newRequest("key-2")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := untracked.Read(p.typedName.String()); err == nil {
+		t.Error("the request beyond max_pending_requests was tracked")
+	}
+
+	// expired in-flight requests are pruned to make room
+	now = now.Add(2 * time.Second)
+	tracked := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, tracked, newRequest("key-2")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := tracked.Read(p.typedName.String()); err != nil {
+		t.Error("the request was not tracked after pruning the expired requests")
+	}
+}
+
+func TestIdempotencyPlugin_Streaming(t *testing.T) {
+	p := newPlugin(t, 60)
+	for range 2 {
+		request := newRequest("key-1")
+		request.Body["stream"] = true
+		if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+			t.Fatalf("streaming requests should not be replayed: %v", err)
+		}
+	}
+}
+
+func TestIdempotencyPlugin_ScopedKeys(t *testing.T) {
+	p := newPlugin(t, 60)
+	ctx := context.Background()
+	withAPIKey := func(request *framework.InferenceRequest, apiKey string) *framework.InferenceRequest {
+		request.Headers["x-api-key"] = apiKey
+		return request
+	}
+
+	firstState := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, firstState, withAPIKey(newRequest("key-1"), "alice")); err != nil {
+		t.Fatalf("unexpected error for the first request: %v", err)
+	}
+	// the request of another client with the key and a different body is not in conflict
+	other := withAPIKey(newRequest("key-1"), "bob")
+	other.Body["prompt"] = "something else"
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), other); err != nil {
+		t.Fatalf("unexpected error for another client: %v", err)
+	}
+	// the same client reusing the key with a different body while the first request is in flight
+	inFlightReuse := withAPIKey(newRequest("key-1"), "alice")
+	inFlightReuse.Body["prompt"] = "something else"
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), inFlightReuse); errcommon.CanonicalCode(err) != errcommon.Conflict {
+		t.Errorf("expected a conflict for a different body in flight, got %v", err)
+	}
+
+	response := newResponse("200", map[string]any{"choices": []any{map[string]any{"text": "hi"}}})
+	if err := p.ProcessResponse(ctx, firstState, response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantReplay(t, p.ProcessRequest(ctx, framework.NewCycleState(), withAPIKey(newRequest("key-1"), "alice")), `{"choices":[{"text":"hi"}]}`)
+	// the response is not replayed to another client, nor to a request without credentials
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), withAPIKey(newRequest("key-1"), "mallory")); err != nil {
+		t.Errorf("the response of another client should not be replayed: %v", err)
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), newRequest("key-1")); err != nil {
+		t.Errorf("the response of another client should not be replayed without credentials: %v", err)
+	}
+	// nor to the same client reusing the key with a different body
+	reuse := withAPIKey(newRequest("key-1"), "alice")
+	reuse.Body["prompt"] = "something else"
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), reuse); errcommon.CanonicalCode(err) != errcommon.UnprocessableEntity {
+		t.Errorf("expected an unprocessable entity error for a reused key, got %v", err)
+	}
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageurlvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ImageURLValidatorPluginType = "image-url-validator"
+	messagesField               = "messages"
+
+	// ValidatedHeader is set to "true" on requests whose image URLs were all reachable.
+	ValidatedHeader = "X-BBR-Image-URLs-Validated"
+
+	defaultTimeoutSeconds = 2
+	defaultMaxURLs        = 10
+	dataURIPrefix         = "data:"
+
+	// maxConcurrentChecks bounds the number of image URLs of a request checked at once.
+	maxConcurrentChecks = 4
+	// maxRedirects bounds the redirects followed when checking an image URL.
+	maxRedirects = 3
+)
+
+// sharedAddressSpace is the carrier-grade NAT range, which is not routable on the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ImageURLValidatorPlugin{}
+
+// ImageURLValidatorConfig defines the JSON configuration structure for the plugin.
+type ImageURLValidatorConfig struct {
+	// TimeoutSeconds bounds the HEAD request to each image URL. Defaults to 2 seconds.
+	TimeoutSeconds int `json:"timeout_seconds"`
+	// MaxURLs is the maximum number of image URLs of a request, which is rejected if it has more. Defaults to 10.
+	MaxURLs int `json:"max_urls"`
+}
+
+// ImageURLValidatorPluginFactory defines the factory function for NewImageURLValidatorPlugin.
+func ImageURLValidatorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ImageURLValidatorConfig{TimeoutSeconds: defaultTimeoutSeconds, MaxURLs: defaultMaxURLs}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ImageURLValidatorPluginType, err)
+		}
+	}
+
+	plugin, err := NewImageURLValidatorPlugin(time.Duration(config.TimeoutSeconds)*time.Second, config.MaxURLs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ImageURLValidatorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewImageURLValidatorPlugin initializes a new ImageURLValidatorPlugin and returns its pointer.
+func NewImageURLValidatorPlugin(timeout time.Duration, maxURLs int) (*ImageURLValidatorPlugin, error) {
+	if timeout <= 0 {
+		return nil, errors.New("timeout_seconds must be positive in ImageURLValidator plugin")
+	}
+	if maxURLs <= 0 {
+		return nil, errors.New("max_urls must be positive in ImageURLValidator plugin")
+	}
+
+	p := &ImageURLValidatorPlugin{
+		typedName: plugin.TypedName{
+			Type: ImageURLValidatorPluginType,
+			Name: ImageURLValidatorPluginType,
+		},
+		maxURLs:   maxURLs,
+		isBlocked: isInternalAddr,
+	}
+	// the addresses are checked when dialing, after the host is resolved, so that a host resolving to an
+	// internal address is blocked too, including the hosts of redirects
+	dialer := &net.Dialer{Timeout: timeout, Control: p.controlDial}
+	p.client = &http.Client{
+		Timeout:       timeout,
+		Transport:     &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: timeout},
+		CheckRedirect: checkRedirect,
+	}
+	return p, nil
+}
+
+// ImageURLValidatorPlugin rejects chat completion requests whose image_url content blocks point to
+// unreachable URLs, which otherwise surface as confusing model server errors. Each URL is checked
+// with a HEAD request, and base64 data URIs are not checked. Since the URLs are chosen by the clients,
+// URLs resolving to loopback, private, link-local (e.g. cloud metadata endpoints) or otherwise internal
+// addresses are never requested and count as unreachable, the number of URLs per request is bounded,
+// and the rejection does not tell which URLs were unreachable.
+type ImageURLValidatorPlugin struct {
+	typedName plugin.TypedName
+	client    *http.Client
+	maxURLs   int
+	// isBlocked returns whether the address must not be requested
+	isBlocked func(netip.Addr) bool
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ImageURLValidatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ImageURLValidatorPlugin) WithName(name string) *ImageURLValidatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest checks the image URLs of the request and returns a BadRequest error if any is unreachable.
+func (p *ImageURLValidatorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	urls := imageURLs(request.Body)
+	if len(urls) == 0 {
+		return nil
+	}
+	if len(urls) > p.maxURLs {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("too many image URLs, at most %d are allowed", p.maxURLs)}
+	}
+
+	unreachable := p.unreachable(ctx, urls)
+	if len(unreachable) > 0 {
+		// the unreachable URLs are only logged, listing them would let clients probe the gateway's network
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected request with unreachable image URLs", "urls", unreachable)
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "unreachable image URLs"}
+	}
+
+	request.SetHeader(ValidatedHeader, "true")
+	return nil
+}
+
+// unreachable checks the given URLs, at most maxConcurrentChecks at once, and returns the unreachable ones, in
+// their original order.
+func (p *ImageURLValidatorPlugin) unreachable(ctx context.Context, urls []string) []string {
+	reachable := make([]bool, len(urls))
+	slots := make(chan struct{}, maxConcurrentChecks)
+	var wg sync.WaitGroup
+	for i, url := range urls {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			reachable[i] = p.isReachable(ctx, url)
+		}()
+	}
+	wg.Wait()
+
+	var result []string
+	for i, url := range urls {
+		if !reachable[i] {
+			result = append(result, url)
+		}
+	}
+	return result
+}
+
+// isReachable sends a HEAD request to the given URL. Servers that do not allow the HEAD method are
+// considered reachable, since the resource may still be served to a GET request.
+func (p *ImageURLValidatorPlugin) isReachable(ctx context.Context, url string) bool {
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return false
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		log.FromContext(ctx).V(logutil.DEBUG).Info("failed to reach image URL", "url", url, "error", err)
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode < http.StatusBadRequest || resp.StatusCode == http.StatusMethodNotAllowed
+}
+
+// controlDial rejects connections to blocked addresses.
+func (p *ImageURLValidatorPlugin) controlDial(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %q - %w", address, err)
+	}
+	if p.isBlocked(addrPort.Addr().Unmap()) {
+		return fmt.Errorf("address %s is not allowed", addrPort.Addr())
+	}
+	return nil
+}
+
+// checkRedirect follows at most maxRedirects redirects, to http(s) URLs only. The addresses of the redirects
+// are checked when dialing, like the address of the original URL.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return errors.New("too many redirects")
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+	}
+	return nil
+}
+
+// isInternalAddr returns whether the address is not a public unicast address, e.g. a loopback, private or
+// link-local address, such as the 169.254.169.254 cloud metadata endpoint.
+func isInternalAddr(addr netip.Addr) bool {
+	return !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() ||
+		sharedAddressSpace.Contains(addr)
+}
+
+// imageURLs returns the URLs of the image_url content blocks of the chat messages, excluding data URIs.
+func imageURLs(body map[string]any) []string {
+	messages, ok := body[messagesField].([]any)
+	if !ok {
+		return nil
+	}
+
+	var urls []string
+	for _, m := range messages {
+		message, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		parts, ok := message["content"].([]any)
+		if !ok {
+			continue
+		}
+		for _, p := range parts {
+			part, ok := p.(map[string]any)
+			if !ok {
+				continue
+			}
+			imageURL, ok := part["image_url"].(map[string]any)
+			if !ok {
+				continue
+			}
+			if url, ok := imageURL["url"].(string); ok && !strings.HasPrefix(url, dataURIPrefix) {
+				urls = append(urls, url)
+			}
+		}
+	}
+	return urls
+}
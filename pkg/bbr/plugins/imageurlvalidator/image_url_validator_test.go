@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageurlvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestImageURLValidatorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "valid config", rawParams: json.RawMessage(`{"timeout_seconds":5,"max_urls":3}`)},
+		{name: "non-positive timeout", rawParams: json.RawMessage(`{"timeout_seconds":0}`), wantErr: true},
+		{name: "non-positive max URLs", rawParams: json.RawMessage(`{"max_urls":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ImageURLValidatorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestImageURLValidatorPlugin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		switch r.URL.Path {
+		case "/missing.png":
+			w.WriteHeader(http.StatusNotFound)
+		case "/metadata.png":
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+		}
+	}))
+	defer server.Close()
+
+	p, err := NewImageURLValidatorPlugin(2*time.Second, 3)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	// the test server listens on a loopback address, all the other internal addresses stay blocked
+	p.isBlocked = func(addr netip.Addr) bool { return !addr.IsLoopback() && isInternalAddr(addr) }
+
+	imageMessage := func(urls ...string) map[string]any {
+		parts := []any{map[string]any{"type": "text", "text": "what is in these images?"}}
+		for _, url := range urls {
+			parts = append(parts, map[string]any{"type": "image_url", "image_url": map[string]any{"url": url}})
+		}
+		return map[string]any{"messages": []any{map[string]any{"role": "user", "content": parts}}}
+	}
+
+	tests := []struct {
+		name              string
+		body              map[string]any
+		wantCode          string
+		wantValidatedFlag bool
+	}{
+		{
+			name:              "reachable URL",
+			body:              imageMessage(server.URL + "/cat.png"),
+			wantValidatedFlag: true,
+		},
+		{
+			name:     "unreachable URLs",
+			body:     imageMessage(server.URL+"/cat.png", server.URL+"/missing.png", "ftp://example.com/dog.png"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "link-local address",
+			body:     imageMessage("http://169.254.169.254/latest/meta-data/"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "redirect to a link-local address",
+			body:     imageMessage(server.URL + "/metadata.png"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "too many URLs",
+			body:     imageMessage(server.URL+"/1.png", server.URL+"/2.png", server.URL+"/3.png", server.URL+"/4.png"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name: "data URIs are skipped",
+			body: imageMessage("data:image/png;base64,iVBORw0KGgo="),
+		},
+		{
+			name: "text only request",
+			body: map[string]any{"messages": []any{map[string]any{"role": "user", "content": "hello"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			} else {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Fatalf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				// the probed URLs are not disclosed
+				if strings.Contains(err.Error(), server.URL) || strings.Contains(err.Error(), "169.254") {
+					t.Errorf("error %q lists URLs", err.Error())
+				}
+			}
+			if got := request.GetHeader(ValidatedHeader) == "true"; got != tt.wantValidatedFlag {
+				t.Errorf("%s set = %v, want %v", ValidatedHeader, got, tt.wantValidatedFlag)
+			}
+		})
+	}
+}
+
+func TestImageURLValidatorPluginBlocksInternalAddresses(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	p, err := NewImageURLValidatorPlugin(2*time.Second, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	request := framework.NewInferenceRequest()
+	request.Body = map[string]any{"messages": []any{map[string]any{"role": "user", "content": []any{
+		map[string]any{"type": "image_url", "image_url": map[string]any{"url": server.URL + "/cat.png"}},
+	}}}}
+
+	err = p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+	if got := errcommon.CanonicalCode(err); got != errcommon.BadRequest {
+		t.Errorf("CanonicalCode = %q, want %q", got, errcommon.BadRequest)
+	}
+	if requested {
+		t.Error("the loopback server was requested")
+	}
+}
+
+func TestIsInternalAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "127.0.0.1", want: true},
+		{addr: "10.1.2.3", want: true},
+		{addr: "192.168.0.1", want: true},
+		{addr: "169.254.169.254", want: true},
+		{addr: "100.64.0.1", want: true},
+		{addr: "0.0.0.0", want: true},
+		{addr: "::1", want: true},
+		{addr: "fd00:ec2::254", want: true},
+		{addr: "fe80::1", want: true},
+		{addr: "8.8.8.8", want: false},
+		{addr: "2001:4860:4860::8888", want: false},
+	}
+	for _, tt := range tests {
+		if got := isInternalAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("isInternalAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package credential identifies the client of an inference request by its credentials, for the plugins
+// keeping state per client.
+package credential
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+const (
+	AuthorizationHeader = "Authorization"
+	APIKeyHeader        = "X-API-Key"
+)
+
+// Hash returns the hex SHA-256 hash of the credentials of the request, its Authorization and X-API-Key
+// headers, or "" if the request carries none. The hash identifies the client without keeping its
+// credentials in the state of the plugins.
+func Hash(request *framework.InferenceRequest) string {
+	authorization, apiKey := request.GetHeader(AuthorizationHeader), request.GetHeader(APIKeyHeader)
+	if authorization == "" && apiKey == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(authorization + "\x00" + apiKey))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credential
+
+import (
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestHash(t *testing.T) {
+	newRequest := func(headers map[string]string) *framework.InferenceRequest {
+		request := framework.NewInferenceRequest()
+		for key, value := range headers {
+			request.Headers[key] = value
+		}
+		return request
+	}
+
+	if got := Hash(newRequest(nil)); got != "" {
+		t.Errorf("Hash() of a request without credentials = %q, want empty", got)
+	}
+
+	hashes := map[string]string{}
+	for name, headers := range map[string]map[string]string{
+		"bearer token":           {AuthorizationHeader: "Bearer a"},
+		"other bearer token":     {AuthorizationHeader: "Bearer b"},
+		"api key":                {APIKeyHeader: "Bearer a"},
+		"bearer token and key":   {AuthorizationHeader: "Bearer a", APIKeyHeader: "k"},
+		"lower case api key":     {"x-api-key": "k"},
+		"lower case bearer only": {"authorization": "Bearer b"},
+	} {
+		got := Hash(newRequest(headers))
+		if len(got) != 64 {
+			t.Errorf("Hash() of the %s = %q, want a hex SHA-256 hash", name, got)
+		}
+		hashes[name] = got
+	}
+	if hashes["other bearer token"] != hashes["lower case bearer only"] {
+		t.Error("Hash() should not depend on the case of the header names")
+	}
+	for _, pair := range [][2]string{{"bearer token", "other bearer token"}, {"bearer token", "api key"}, {"bearer token", "bearer token and key"}} {
+		if hashes[pair[0]] == hashes[pair[1]] {
+			t.Errorf("Hash() of the %s and of the %s should differ", pair[0], pair[1])
+		}
+	}
+}
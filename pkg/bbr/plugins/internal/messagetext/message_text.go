@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package messagetext extracts the texts of the messages and prompts of inference requests, for the plugins
+// inspecting them.
+package messagetext
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	messagesField = "messages"
+	promptField   = "prompt"
+)
+
+// Append appends the texts of a content, which is either a string, or a list of strings or text parts, e.g.
+// OpenAI content parts or Anthropic content blocks. Parts without text, e.g. images, are skipped.
+func Append(texts []string, content any) []string {
+	switch c := content.(type) {
+	case string:
+		texts = append(texts, c)
+	case []any:
+		for _, item := range c {
+			switch part := item.(type) {
+			case string:
+				texts = append(texts, part)
+			case map[string]any:
+				if text, ok := part["text"].(string); ok {
+					texts = append(texts, text)
+				}
+			}
+		}
+	}
+	return texts
+}
+
+// Join returns the texts of a content joined with the given separator.
+func Join(content any, sep string) string {
+	return strings.Join(Append(nil, content), sep)
+}
+
+// Request returns the texts of the messages of chat completion requests having one of the given roles, or of
+// all the messages when no role is given, followed by the prompt of completion requests.
+func Request(body map[string]any, roles ...string) []string {
+	var texts []string
+	if messages, ok := body[messagesField].([]any); ok {
+		for _, raw := range messages {
+			message, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if role, _ := message["role"].(string); len(roles) > 0 && !slices.Contains(roles, role) {
+				continue
+			}
+			texts = Append(texts, message["content"])
+		}
+	}
+	return Append(texts, body[promptField])
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagetext
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name    string
+		content any
+		want    string
+	}{
+		{
+			name:    "string",
+			content: "hello",
+			want:    "hello",
+		},
+		{
+			name: "text and image parts",
+			content: []any{
+				map[string]any{"type": "text", "text": "hello"},
+				map[string]any{"type": "image_url", "image_url": map[string]any{"url": "https://example.com/cat.png"}},
+				map[string]any{"type": "text", "text": "world"},
+			},
+			want: "hello\nworld",
+		},
+		{
+			name:    "list of strings",
+			content: []any{"hello", "world"},
+			want:    "hello\nworld",
+		},
+		{
+			name:    "missing content",
+			content: nil,
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Join(tt.content, "\n"); got != tt.want {
+				t.Errorf("Join() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequest(t *testing.T) {
+	chat := map[string]any{
+		"messages": []any{
+			map[string]any{"role": "system", "content": "be nice"},
+			map[string]any{"role": "user", "content": []any{map[string]any{"type": "text", "text": "hi"}}},
+			map[string]any{"role": "assistant", "content": "hello"},
+			"not a message",
+		},
+	}
+	tests := []struct {
+		name  string
+		body  map[string]any
+		roles []string
+		want  []string
+	}{
+		{
+			name: "all messages",
+			body: chat,
+			want: []string{"be nice", "hi", "hello"},
+		},
+		{
+			name:  "user messages",
+			body:  chat,
+			roles: []string{"user"},
+			want:  []string{"hi"},
+		},
+		{
+			name: "completion prompt",
+			body: map[string]any{"prompt": []any{"one", "two"}},
+			want: []string{"one", "two"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, Request(tt.body, tt.roles...)); diff != "" {
+				t.Errorf("Request() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
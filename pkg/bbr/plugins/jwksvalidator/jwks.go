@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwksvalidator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultMinRefreshInterval bounds how often the JWKS endpoint is fetched, so that neither tokens
+// with made-up key ids nor a Cache-Control header that disables caching can flood the endpoint.
+const defaultMinRefreshInterval = 10 * time.Second
+
+// maxJWKSSize bounds the size of a JWKS document that is read from the endpoint.
+const maxJWKSSize = 1 << 20
+
+var errUnknownKeyID = errors.New("unknown key id")
+
+// jsonWebKey is the subset of RFC 7517 fields needed to verify RSA and EC signatures.
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	// RSA public key fields
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC public key fields
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// keySet fetches a JWKS document and caches the parsed public keys until the
+// expiry announced by the endpoint's Cache-Control max-age directive. Concurrent
+// refreshes share a single fetch, and the cached keys are kept when a fetch fails.
+type keySet struct {
+	url        string
+	client     *http.Client
+	defaultTTL time.Duration
+	// minRefreshInterval is the minimal duration between two fetches, and the minimal cache TTL.
+	minRefreshInterval time.Duration
+	group              singleflight.Group
+
+	lock        sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	attemptedAt time.Time
+	expiresAt   time.Time
+}
+
+func newKeySet(url string, client *http.Client, defaultTTL time.Duration) *keySet {
+	return &keySet{
+		url:                url,
+		client:             client,
+		defaultTTL:         defaultTTL,
+		minRefreshInterval: defaultMinRefreshInterval,
+		keys:               map[string]crypto.PublicKey{},
+	}
+}
+
+// get returns the public key with the given id. On an unknown key id the key set is
+// re-fetched once, since the issuer may have rotated its keys since the last fetch.
+// A stale key is still returned when the key set can't be re-fetched.
+func (s *keySet) get(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.lock.RLock()
+	key, ok := s.keys[kid]
+	now := time.Now()
+	fresh := now.Before(s.expiresAt)
+	canRefetch := now.Sub(s.attemptedAt) >= s.minRefreshInterval
+	s.lock.RUnlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+
+	if canRefetch {
+		// the fetch is shared by all the waiting requests, so it must not be canceled with the first one
+		_, err, _ := s.group.Do(s.url, func() (any, error) {
+			return nil, s.refresh(context.WithoutCancel(ctx))
+		})
+		if err != nil {
+			if ok {
+				return key, nil
+			}
+			return nil, err
+		}
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w '%s'", errUnknownKeyID, kid)
+}
+
+func (s *keySet) refresh(ctx context.Context) error {
+	// requests that arrive while the fetch is in flight share it, so the attempt is recorded when it ends
+	defer func() {
+		s.lock.Lock()
+		s.attemptedAt = time.Now()
+		s.lock.Unlock()
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create JWKS request - %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS - %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS - unexpected status %d", resp.StatusCode)
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS - %w", err)
+	}
+	var jwks jsonWebKeySet
+	if err := json.Unmarshal(raw, &jwks); err != nil {
+		return fmt.Errorf("failed to parse JWKS - %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue // skip keys of unsupported types, the remaining keys are still usable
+		}
+		keys[jwk.Kid] = key
+	}
+
+	ttl := max(cacheTTL(resp.Header.Get("Cache-Control"), s.defaultTTL), s.minRefreshInterval)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keys = keys
+	s.expiresAt = time.Now().Add(ttl)
+	return nil
+}
+
+// cacheTTL returns the max-age of the given Cache-Control header value, or defaultTTL
+// if the header does not allow caching for a specific duration.
+func cacheTTL(cacheControl string, defaultTTL time.Duration) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		directive = strings.TrimSpace(strings.ToLower(directive))
+		if directive == "no-store" || directive == "no-cache" {
+			return 0
+		}
+		if value, found := strings.CutPrefix(directive, "max-age="); found {
+			if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+				return time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	return defaultTTL
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve '%s'", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type '%s'", k.Kty)
+	}
+}
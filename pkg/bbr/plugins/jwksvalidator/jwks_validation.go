@@ -0,0 +1,367 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwksvalidator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	JWKSValidationPluginType = "jwks-validation"
+
+	authorizationHeader   = "Authorization"
+	wwwAuthenticateHeader = "WWW-Authenticate"
+	invalidTokenChallenge = `Bearer error="invalid_token"`
+
+	defaultCacheTTLSeconds     = 300
+	defaultFetchTimeoutSeconds = 5
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &JWKSValidationPlugin{}
+
+// JWKSValidationConfig defines the JSON configuration structure for the plugin.
+type JWKSValidationConfig struct {
+	// JWKSURL is the URL of the JSON Web Key Set used to verify token signatures.
+	JWKSURL string `json:"jwks_url"`
+	// PublicKey is a PEM encoded public key used to verify token signatures, instead of a JWKS endpoint.
+	PublicKey string `json:"public_key"`
+	// Issuer, if set, must match the "iss" claim of the token.
+	Issuer string `json:"issuer"`
+	// Audience, if set, must be contained in the "aud" claim of the token.
+	Audience string `json:"audience"`
+	// DefaultCacheTTLSeconds is used when the JWKS response has no Cache-Control max-age.
+	DefaultCacheTTLSeconds int `json:"default_cache_ttl_seconds"`
+	// FetchTimeoutSeconds bounds the duration of a single JWKS fetch.
+	FetchTimeoutSeconds int `json:"fetch_timeout_seconds"`
+	// Claims maps the names of the token claims to the request headers they are set as, e.g.
+	// {"sub": "X-User-Id"}.
+	Claims map[string]string `json:"claims"`
+}
+
+// JWKSValidationPluginFactory defines the factory function for NewJWKSValidationPlugin.
+func JWKSValidationPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := JWKSValidationConfig{
+		DefaultCacheTTLSeconds: defaultCacheTTLSeconds,
+		FetchTimeoutSeconds:    defaultFetchTimeoutSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", JWKSValidationPluginType, err)
+		}
+	}
+
+	plugin, err := NewJWKSValidationPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", JWKSValidationPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewJWKSValidationPlugin initializes a new JWKSValidationPlugin and returns its pointer.
+func NewJWKSValidationPlugin(config JWKSValidationConfig) (*JWKSValidationPlugin, error) {
+	if (config.JWKSURL == "") == (config.PublicKey == "") {
+		return nil, errors.New("exactly one of jwks_url and public_key is required in JWKSValidation plugin")
+	}
+	if config.FetchTimeoutSeconds <= 0 {
+		return nil, errors.New("fetch_timeout_seconds must be positive in JWKSValidation plugin")
+	}
+	for claim, header := range config.Claims {
+		if claim == "" || header == "" {
+			return nil, errors.New("claims must map claim names to header names in JWKSValidation plugin")
+		}
+	}
+
+	var keys publicKeys
+	if config.PublicKey != "" {
+		key, err := parsePublicKey(config.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public_key in JWKSValidation plugin - %w", err)
+		}
+		keys = staticKey{key: key}
+	} else {
+		client := &http.Client{Timeout: time.Duration(config.FetchTimeoutSeconds) * time.Second}
+		keys = newKeySet(config.JWKSURL, client, time.Duration(config.DefaultCacheTTLSeconds)*time.Second)
+	}
+
+	return &JWKSValidationPlugin{
+		typedName: plugin.TypedName{
+			Type: JWKSValidationPluginType,
+			Name: JWKSValidationPluginType,
+		},
+		keys:     keys,
+		issuer:   config.Issuer,
+		audience: config.Audience,
+		claims:   config.Claims,
+	}, nil
+}
+
+// publicKeys provides the public keys verifying the token signatures.
+type publicKeys interface {
+	get(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// staticKey is a configured public key, which verifies the tokens of any key id.
+type staticKey struct {
+	key crypto.PublicKey
+}
+
+func (k staticKey) get(_ context.Context, _ string) (crypto.PublicKey, error) {
+	return k.key, nil
+}
+
+// parsePublicKey parses a PEM encoded PKIX RSA or ECDSA public key.
+func parsePublicKey(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// JWKSValidationPlugin validates the Bearer JWT in the Authorization header against the keys
+// published at a JWKS endpoint, or against a configured public key, and rejects the request with
+// HTTP 401 if the token is invalid. The configured claims of valid tokens are set as request headers.
+type JWKSValidationPlugin struct {
+	typedName plugin.TypedName
+	keys      publicKeys
+	issuer    string
+	audience  string
+	claims    map[string]string // claim name to header name
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *JWKSValidationPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *JWKSValidationPlugin) WithName(name string) *JWKSValidationPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the signature and expiry of the Bearer token.
+func (p *JWKSValidationPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil {
+		return nil // this shouldn't happen
+	}
+
+	token, found := strings.CutPrefix(request.GetHeader(authorizationHeader), "Bearer ")
+	if !found || strings.TrimSpace(token) == "" {
+		return unauthorized("missing bearer token")
+	}
+
+	claims, err := p.validate(ctx, strings.TrimSpace(token))
+	if err != nil {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected request with invalid token", "reason", err.Error())
+		return unauthorized(err.Error())
+	}
+
+	for claim, header := range p.claims {
+		value, ok := claimValue(claims[claim])
+		if !ok {
+			// the header must not be set by the client when the token has no such claim
+			for key := range request.Headers {
+				if strings.EqualFold(key, header) {
+					request.RemoveHeader(key)
+				}
+			}
+			continue
+		}
+		request.SetHeader(header, value)
+	}
+
+	return nil
+}
+
+// claimValue returns the header value of a claim. Strings are used as is, and other values are JSON encoded.
+func claimValue(claim any) (string, bool) {
+	switch v := claim.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	default:
+		value, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(value), true
+	}
+}
+
+func unauthorized(msg string) error {
+	return errcommon.ErrorWithHeaders{
+		Err:     errcommon.Error{Code: errcommon.Unauthorized, Msg: msg},
+		Headers: map[string]string{wwwAuthenticateHeader: invalidTokenChallenge},
+	}
+}
+
+type tokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// validate verifies the token signature and registered claims and returns the token claims.
+func (p *JWKSValidationPlugin) validate(ctx context.Context, token string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed token header - %w", err)
+	}
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims - %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature - %w", err)
+	}
+
+	key, err := p.keys.get(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	if err := p.validateClaims(claims, time.Now()); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (p *JWKSValidationPlugin) validateClaims(claims map[string]any, now time.Time) error {
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return errors.New("token has no expiry")
+	}
+	if now.Unix() >= int64(exp) {
+		return errors.New("token is expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Unix() < int64(nbf) {
+		return errors.New("token is not valid yet")
+	}
+	if p.issuer != "" && claims["iss"] != p.issuer {
+		return errors.New("unexpected token issuer")
+	}
+	if p.audience != "" && !hasAudience(claims["aud"], p.audience) {
+		return errors.New("unexpected token audience")
+	}
+	return nil
+}
+
+// hasAudience checks the "aud" claim, which may be either a single string or an array of strings.
+func hasAudience(aud any, audience string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == audience
+	case []any:
+		for _, a := range v {
+			if a == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, out any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signingInput string, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signing algorithm '%s'", alg)
+	}
+	hasher := hash.New()
+	hasher.Write([]byte(signingInput))
+	digest := hasher.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			return errors.New("signing algorithm does not match key type")
+		}
+		if err := rsa.VerifyPKCS1v15(k, hash, digest, signature); err != nil {
+			return errors.New("invalid token signature")
+		}
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") {
+			return errors.New("signing algorithm does not match key type")
+		}
+		// JWS encodes ECDSA signatures as the fixed-size concatenation of r and s.
+		size := len(signature) / 2
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if len(signature)%2 != 0 || !ecdsa.Verify(k, digest, r, s) {
+			return errors.New("invalid token signature")
+		}
+	default:
+		return errors.New("unsupported key type")
+	}
+	return nil
+}
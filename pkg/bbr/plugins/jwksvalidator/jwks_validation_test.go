@@ -0,0 +1,432 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwksvalidator
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+// jwksServer serves the public keys of the currently configured signing keys.
+type jwksServer struct {
+	*httptest.Server
+	lock         sync.Mutex
+	keys         map[string]*rsa.PrivateKey
+	cacheControl string
+	failing      bool
+	fetches      atomic.Int32
+}
+
+func newJWKSServer(t *testing.T, keys map[string]*rsa.PrivateKey) *jwksServer {
+	s := &jwksServer{keys: keys, cacheControl: "public, max-age=3600"}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		s.fetches.Add(1)
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if s.failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		jwks := jsonWebKeySet{}
+		for kid, key := range s.keys {
+			jwks.Keys = append(jwks.Keys, jsonWebKey{
+				Kid: kid,
+				Kty: "RSA",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		w.Header().Set("Cache-Control", s.cacheControl)
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) setKeys(keys map[string]*rsa.PrivateKey) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keys = keys
+}
+
+func (s *jwksServer) setCacheControl(cacheControl string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cacheControl = cacheControl
+}
+
+func (s *jwksServer) setFailing(failing bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failing = failing
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": kid})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func requestWithToken(token string) *framework.InferenceRequest {
+	r := framework.NewInferenceRequest()
+	if token != "" {
+		r.Headers["authorization"] = "Bearer " + token
+	}
+	return r
+}
+
+func TestJWKSValidationPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"jwks_url":"https://issuer.example.com/.well-known/jwks.json"}`),
+		},
+		{
+			name:      "missing jwks_url",
+			rawParams: json.RawMessage(`{}`),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "non-positive fetch timeout",
+			rawParams: json.RawMessage(`{"jwks_url":"https://issuer.example.com","fetch_timeout_seconds":0}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := JWKSValidationPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestJWKSValidationPlugin_ProcessRequest(t *testing.T) {
+	key := generateKey(t)
+	otherKey := generateKey(t)
+	server := newJWKSServer(t, map[string]*rsa.PrivateKey{"kid-1": key})
+
+	validClaims := map[string]any{"sub": "user", "iss": "issuer", "aud": "bbr", "exp": time.Now().Add(time.Hour).Unix()}
+	expiredClaims := map[string]any{"sub": "user", "iss": "issuer", "aud": "bbr", "exp": time.Now().Add(-time.Hour).Unix()}
+	wrongAudience := map[string]any{"sub": "user", "iss": "issuer", "aud": "other", "exp": time.Now().Add(time.Hour).Unix()}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "valid token",
+			token: signToken(t, key, "kid-1", validClaims),
+		},
+		{
+			name:    "missing token",
+			token:   "",
+			wantErr: true,
+		},
+		{
+			name:    "malformed token",
+			token:   "not-a-jwt",
+			wantErr: true,
+		},
+		{
+			name:    "expired token",
+			token:   signToken(t, key, "kid-1", expiredClaims),
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience",
+			token:   signToken(t, key, "kid-1", wrongAudience),
+			wantErr: true,
+		},
+		{
+			name:    "signed by another key",
+			token:   signToken(t, otherKey, "kid-1", validClaims),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewJWKSValidationPlugin(JWKSValidationConfig{
+				JWKSURL:             server.URL,
+				Issuer:              "issuer",
+				Audience:            "bbr",
+				FetchTimeoutSeconds: 1,
+			})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+
+			err = p.ProcessRequest(context.Background(), nil, requestWithToken(tt.token))
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if got := errcommon.CanonicalCode(err); got != errcommon.Unauthorized {
+				t.Errorf("CanonicalCode = %q, want %q", got, errcommon.Unauthorized)
+			}
+			headersErr, ok := err.(errcommon.ErrorWithHeaders)
+			if !ok {
+				t.Fatalf("expected ErrorWithHeaders, got %T", err)
+			}
+			if got := headersErr.Headers[wwwAuthenticateHeader]; got != invalidTokenChallenge {
+				t.Errorf("Headers[%q] = %q, want %q", wwwAuthenticateHeader, got, invalidTokenChallenge)
+			}
+		})
+	}
+}
+
+func TestJWKSValidationPlugin_KeyRotation(t *testing.T) {
+	oldKey := generateKey(t)
+	newKey := generateKey(t)
+	server := newJWKSServer(t, map[string]*rsa.PrivateKey{"old": oldKey})
+
+	p, err := NewJWKSValidationPlugin(JWKSValidationConfig{JWKSURL: server.URL, FetchTimeoutSeconds: 1})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	p.keys.(*keySet).minRefreshInterval = 0
+
+	claims := map[string]any{"exp": time.Now().Add(time.Hour).Unix()}
+	if err := p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, oldKey, "old", claims))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the cached key set is reused while it is fresh
+	if err := p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, oldKey, "old", claims))); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := server.fetches.Load(); got != 1 {
+		t.Errorf("JWKS fetches = %d, want 1", got)
+	}
+
+	server.setKeys(map[string]*rsa.PrivateKey{"new": newKey})
+	if err := p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, newKey, "new", claims))); err != nil {
+		t.Fatalf("unexpected error after key rotation: %v", err)
+	}
+	if got := server.fetches.Load(); got != 2 {
+		t.Errorf("JWKS fetches = %d, want 2", got)
+	}
+}
+
+func TestJWKSValidationPlugin_Refresh(t *testing.T) {
+	key := generateKey(t)
+	claims := map[string]any{"exp": time.Now().Add(time.Hour).Unix()}
+	newPlugin := func(t *testing.T, server *jwksServer) *JWKSValidationPlugin {
+		p, err := NewJWKSValidationPlugin(JWKSValidationConfig{JWKSURL: server.URL, FetchTimeoutSeconds: 1})
+		if err != nil {
+			t.Fatalf("failed to create plugin: %v", err)
+		}
+		return p
+	}
+
+	t.Run("no-cache does not bypass the minimal refresh interval", func(t *testing.T) {
+		server := newJWKSServer(t, map[string]*rsa.PrivateKey{"kid-1": key})
+		server.setCacheControl("no-cache")
+		p := newPlugin(t, server)
+
+		for range 3 {
+			if err := p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, key, "kid-1", claims))); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		if got := server.fetches.Load(); got != 1 {
+			t.Errorf("JWKS fetches = %d, want 1", got)
+		}
+	})
+
+	t.Run("stale keys are served when the refresh fails", func(t *testing.T) {
+		server := newJWKSServer(t, map[string]*rsa.PrivateKey{"kid-1": key})
+		server.setCacheControl("no-store")
+		p := newPlugin(t, server)
+		p.keys.(*keySet).minRefreshInterval = 0
+
+		if err := p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, key, "kid-1", claims))); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		server.setFailing(true)
+		if err := p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, key, "kid-1", claims))); err != nil {
+			t.Fatalf("unexpected error with a stale key: %v", err)
+		}
+		if got := server.fetches.Load(); got != 2 {
+			t.Errorf("JWKS fetches = %d, want 2", got)
+		}
+		if err := p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, key, "kid-2", claims))); err == nil {
+			t.Error("expected error for an unknown key id, got nil")
+		}
+	})
+
+	t.Run("concurrent refreshes share a single fetch", func(t *testing.T) {
+		server := newJWKSServer(t, map[string]*rsa.PrivateKey{"kid-1": key})
+		server.lock.Lock() // hold the response until all the requests wait for it
+		p := newPlugin(t, server)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 5)
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, key, "kid-1", claims)))
+			}()
+		}
+		time.Sleep(100 * time.Millisecond)
+		server.lock.Unlock()
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}
+		if got := server.fetches.Load(); got != 1 {
+			t.Errorf("JWKS fetches = %d, want 1", got)
+		}
+	})
+}
+
+func TestCacheTTL(t *testing.T) {
+	tests := []struct {
+		cacheControl string
+		want         time.Duration
+	}{
+		{cacheControl: "max-age=60", want: time.Minute},
+		{cacheControl: "public, max-age=120", want: 2 * time.Minute},
+		{cacheControl: "no-store", want: 0},
+		{cacheControl: "", want: 5 * time.Minute},
+		{cacheControl: "max-age=invalid", want: 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := cacheTTL(tt.cacheControl, 5*time.Minute); got != tt.want {
+			t.Errorf("cacheTTL(%q) = %v, want %v", tt.cacheControl, got, tt.want)
+		}
+	}
+}
+
+func TestJWKSValidationPlugin_PublicKey(t *testing.T) {
+	key := generateKey(t)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+	publicKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	if _, err := NewJWKSValidationPlugin(JWKSValidationConfig{PublicKey: "not-a-key", FetchTimeoutSeconds: 1}); err == nil {
+		t.Error("expected error for an invalid public key, got nil")
+	}
+	if _, err := NewJWKSValidationPlugin(JWKSValidationConfig{JWKSURL: "https://issuer.example.com", PublicKey: publicKey, FetchTimeoutSeconds: 1}); err == nil {
+		t.Error("expected error when both jwks_url and public_key are set, got nil")
+	}
+
+	p, err := NewJWKSValidationPlugin(JWKSValidationConfig{PublicKey: publicKey, FetchTimeoutSeconds: 1})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	claims := map[string]any{"sub": "user", "exp": time.Now().Add(time.Hour).Unix()}
+	if err := p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, key, "any-kid", claims))); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err = p.ProcessRequest(context.Background(), nil, requestWithToken(signToken(t, generateKey(t), "any-kid", claims)))
+	if got := errcommon.CanonicalCode(err); got != errcommon.Unauthorized {
+		t.Errorf("CanonicalCode = %q, want %q", got, errcommon.Unauthorized)
+	}
+}
+
+func TestJWKSValidationPlugin_Claims(t *testing.T) {
+	key := generateKey(t)
+	server := newJWKSServer(t, map[string]*rsa.PrivateKey{"kid-1": key})
+
+	p, err := NewJWKSValidationPlugin(JWKSValidationConfig{
+		JWKSURL:             server.URL,
+		FetchTimeoutSeconds: 1,
+		Claims:              map[string]string{"sub": "X-User-Id", "tenant": "X-Tenant-Id", "tier": "X-Tier"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	claims := map[string]any{"sub": "user-1", "tier": 2, "exp": time.Now().Add(time.Hour).Unix()}
+	request := requestWithToken(signToken(t, key, "kid-1", claims))
+	// the token has no tenant claim, so the header sent by the client is removed
+	request.Headers["x-tenant-id"] = "spoofed"
+
+	if err := p.ProcessRequest(context.Background(), nil, request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"X-User-Id": "user-1", "X-Tier": "2"}, request.MutatedHeaders()); diff != "" {
+		t.Errorf("unexpected headers (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"x-tenant-id"}, request.RemovedHeaders()); diff != "" {
+		t.Errorf("unexpected removed headers (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package languagemismatch
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// minLetters is the number of letters below which the language of a text is not detected.
+	minLetters = 12
+	// minStopwords is the number of stopwords a Latin script text must contain for its language to be detected.
+	minStopwords = 2
+)
+
+// scriptLanguages maps non-Latin scripts to the language they are predominantly written in.
+// Han is handled separately, since it is shared by Chinese and Japanese.
+var scriptLanguages = []struct {
+	script   *unicode.RangeTable
+	language string
+}{
+	{script: unicode.Hangul, language: "ko"},
+	{script: unicode.Cyrillic, language: "ru"},
+	{script: unicode.Arabic, language: "ar"},
+	{script: unicode.Hebrew, language: "he"},
+	{script: unicode.Greek, language: "el"},
+	{script: unicode.Devanagari, language: "hi"},
+	{script: unicode.Thai, language: "th"},
+}
+
+// stopwords are frequent words that tell apart the languages written in the Latin script.
+var stopwords = map[string][]string{
+	"en": {"the", "and", "is", "are", "you", "that", "with", "this", "what", "how", "of", "to", "it", "be", "your"},
+	"es": {"el", "los", "las", "y", "es", "que", "del", "por", "una", "para", "con", "como", "pero", "está", "qué"},
+	"fr": {"le", "les", "et", "est", "des", "une", "que", "pour", "dans", "vous", "pas", "avec", "qui", "sur", "ce"},
+	"de": {"der", "die", "das", "und", "ist", "nicht", "ich", "sie", "mit", "ein", "eine", "zu", "auf", "wie", "was"},
+	"it": {"il", "gli", "della", "che", "è", "per", "non", "una", "sono", "con", "come", "questo", "del", "ma", "di"},
+	"pt": {"o", "os", "as", "que", "não", "uma", "para", "com", "você", "do", "da", "é", "em", "mais", "como"},
+	"nl": {"de", "het", "een", "en", "is", "niet", "van", "ik", "je", "dat", "met", "voor", "zijn", "wat", "hoe"},
+}
+
+// stopwordLanguages is the inverse of stopwords, mapping each stopword to the languages it belongs to.
+var stopwordLanguages = func() map[string][]string {
+	index := map[string][]string{}
+	for language, words := range stopwords {
+		for _, word := range words {
+			index[word] = append(index[word], language)
+		}
+	}
+	return index
+}()
+
+// detectLanguage returns the ISO 639-1 code of the language of the given text, or "" if the text
+// is too short or its language is not recognized. Texts in non-Latin scripts are classified by
+// their predominant script, and texts in the Latin script by their most frequent stopwords.
+func detectLanguage(text string) string {
+	letters, latin, han, kana := 0, 0, 0, 0
+	scripts := make([]int, len(scriptLanguages))
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		switch {
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		case unicode.Is(unicode.Han, r):
+			han++
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+			kana++
+		default:
+			for i, sl := range scriptLanguages {
+				if unicode.Is(sl.script, r) {
+					scripts[i]++
+					break
+				}
+			}
+		}
+	}
+	if letters < minLetters {
+		return ""
+	}
+
+	switch {
+	case 2*(han+kana) > letters:
+		if kana > 0 {
+			return "ja"
+		}
+		return "zh"
+	case 2*latin > letters:
+		return detectLatinLanguage(text)
+	}
+	for i, count := range scripts {
+		if 2*count > letters {
+			return scriptLanguages[i].language
+		}
+	}
+	return ""
+}
+
+// detectLatinLanguage returns the language whose stopwords are the most frequent in the given text,
+// or "" if no language has enough stopwords or several languages are tied.
+func detectLatinLanguage(text string) string {
+	counts := map[string]int{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !unicode.IsLetter(r) }) {
+		for _, language := range stopwordLanguages[word] {
+			counts[language]++
+		}
+	}
+
+	best, bestCount, tied := "", 0, false
+	for language, count := range counts {
+		switch {
+		case count > bestCount:
+			best, bestCount, tied = language, count, false
+		case count == bestCount:
+			tied = true
+		}
+	}
+	if bestCount < minStopwords || tied {
+		return ""
+	}
+	return best
+}
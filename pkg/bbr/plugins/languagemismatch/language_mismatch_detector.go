@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package languagemismatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	LanguageMismatchDetectorPluginType = "language-mismatch-detector"
+
+	// MismatchHeader is set to "true" on requests whose user message is in a different language than the system prompt.
+	MismatchHeader = "X-BBR-Language-Mismatch"
+
+	messagesField = "messages"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &LanguageMismatchDetectorPlugin{}
+
+// LanguageMismatchDetectorConfig defines the JSON configuration structure for the plugin.
+type LanguageMismatchDetectorConfig struct {
+	// AllowedMixing lists ISO 639-1 language codes, e.g. "en", which may be mixed with any other language.
+	AllowedMixing []string `json:"allowed_mixing"`
+	// Reject rejects requests with a language mismatch with HTTP 400, instead of only flagging them.
+	Reject bool `json:"reject"`
+}
+
+// LanguageMismatchDetectorPluginFactory defines the factory function for NewLanguageMismatchDetectorPlugin.
+func LanguageMismatchDetectorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := LanguageMismatchDetectorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", LanguageMismatchDetectorPluginType, err)
+		}
+	}
+
+	return NewLanguageMismatchDetectorPlugin(config).WithName(name), nil
+}
+
+// NewLanguageMismatchDetectorPlugin initializes a new LanguageMismatchDetectorPlugin and returns its pointer.
+func NewLanguageMismatchDetectorPlugin(config LanguageMismatchDetectorConfig) *LanguageMismatchDetectorPlugin {
+	allowedMixing := sets.New[string]()
+	for _, language := range config.AllowedMixing {
+		allowedMixing.Insert(strings.ToLower(language))
+	}
+
+	return &LanguageMismatchDetectorPlugin{
+		typedName: plugin.TypedName{
+			Type: LanguageMismatchDetectorPluginType,
+			Name: LanguageMismatchDetectorPluginType,
+		},
+		allowedMixing: allowedMixing,
+		reject:        config.Reject,
+	}
+}
+
+// LanguageMismatchDetectorPlugin detects chat completion requests whose last user message is written in a
+// different language than the system prompt, since switching languages is a known technique for smuggling
+// instructions past content filters. Mismatches are flagged with the X-BBR-Language-Mismatch header, or
+// rejected with HTTP 400. Languages in the allowed mixing list, and texts whose language cannot be
+// detected, e.g. because they are too short, never cause a mismatch.
+type LanguageMismatchDetectorPlugin struct {
+	typedName     plugin.TypedName
+	allowedMixing sets.Set[string]
+	reject        bool
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *LanguageMismatchDetectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *LanguageMismatchDetectorPlugin) WithName(name string) *LanguageMismatchDetectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest compares the languages of the system prompt and the last user message of the request.
+func (p *LanguageMismatchDetectorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	messages, _ := request.Body[messagesField].([]any)
+	systemText, userText := conversationTexts(messages)
+	systemLanguage, userLanguage := detectLanguage(systemText), detectLanguage(userText)
+	if systemLanguage == "" || userLanguage == "" || systemLanguage == userLanguage ||
+		p.allowedMixing.HasAny(systemLanguage, userLanguage) {
+		return nil
+	}
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("detected a language mismatch between the system prompt and the user message",
+		"systemLanguage", systemLanguage, "userLanguage", userLanguage)
+	if p.reject {
+		return errcommon.Error{
+			Code: errcommon.BadRequest,
+			Msg:  fmt.Sprintf("policy violation: the user message language (%s) does not match the system prompt language (%s)", userLanguage, systemLanguage),
+		}
+	}
+	request.SetHeader(MismatchHeader, "true")
+	return nil
+}
+
+// conversationTexts returns the concatenated text of the system messages, and the text of the last user message.
+func conversationTexts(messages []any) (string, string) {
+	var system strings.Builder
+	user := ""
+	for _, m := range messages {
+		message, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch message["role"] {
+		case "system", "developer":
+			system.WriteString(messagetext.Join(message["content"], " "))
+			system.WriteString("\n")
+		case "user":
+			user = messagetext.Join(message["content"], " ")
+		}
+	}
+	return system.String(), user
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latencybreaker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	LatencyCircuitBreakerPluginType = "latency-circuit-breaker"
+	modelField                      = "model"
+
+	defaultWindowSize      = 100
+	defaultMinSamples      = 20
+	defaultCooldownSeconds = 30
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &LatencyCircuitBreakerPlugin{}
+	_ framework.ResponseProcessor = &LatencyCircuitBreakerPlugin{}
+)
+
+// LatencyCircuitBreakerConfig defines the JSON configuration structure for the plugin.
+type LatencyCircuitBreakerConfig struct {
+	// P99ThresholdMs is the P99 latency above which a model is disabled.
+	P99ThresholdMs int `json:"p99_threshold_ms"`
+	// WindowSize is the number of most recent latency samples kept per model.
+	WindowSize int `json:"window_size"`
+	// MinSamples is the number of samples required before the P99 latency is evaluated.
+	MinSamples int `json:"min_samples"`
+	// CooldownSeconds is the duration a model stays disabled before it is re-enabled.
+	CooldownSeconds int `json:"cooldown_seconds"`
+}
+
+// LatencyCircuitBreakerPluginFactory defines the factory function for NewLatencyCircuitBreakerPlugin.
+func LatencyCircuitBreakerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := LatencyCircuitBreakerConfig{
+		WindowSize:      defaultWindowSize,
+		MinSamples:      defaultMinSamples,
+		CooldownSeconds: defaultCooldownSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", LatencyCircuitBreakerPluginType, err)
+		}
+	}
+
+	plugin, err := NewLatencyCircuitBreakerPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", LatencyCircuitBreakerPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewLatencyCircuitBreakerPlugin initializes a new LatencyCircuitBreakerPlugin and returns its pointer.
+func NewLatencyCircuitBreakerPlugin(config LatencyCircuitBreakerConfig) (*LatencyCircuitBreakerPlugin, error) {
+	if config.P99ThresholdMs <= 0 {
+		return nil, errors.New("p99_threshold_ms must be positive in LatencyCircuitBreaker plugin")
+	}
+	if config.WindowSize <= 0 {
+		return nil, errors.New("window_size must be positive in LatencyCircuitBreaker plugin")
+	}
+	if config.MinSamples <= 0 || config.MinSamples > config.WindowSize {
+		return nil, errors.New("min_samples must be positive and not larger than window_size in LatencyCircuitBreaker plugin")
+	}
+	if config.CooldownSeconds <= 0 {
+		return nil, errors.New("cooldown_seconds must be positive in LatencyCircuitBreaker plugin")
+	}
+
+	return &LatencyCircuitBreakerPlugin{
+		typedName: plugin.TypedName{
+			Type: LatencyCircuitBreakerPluginType,
+			Name: LatencyCircuitBreakerPluginType,
+		},
+		threshold:  time.Duration(config.P99ThresholdMs) * time.Millisecond,
+		windowSize: config.WindowSize,
+		minSamples: config.MinSamples,
+		cooldown:   time.Duration(config.CooldownSeconds) * time.Second,
+		models:     map[string]*modelState{},
+		now:        time.Now,
+	}, nil
+}
+
+// LatencyCircuitBreakerPlugin tracks the P99 latency of each model over a sliding window of
+// recent responses. When the P99 latency of a model exceeds the threshold, the model is put on
+// a deny list and its requests are rejected with HTTP 503 until the cooldown period ends.
+type LatencyCircuitBreakerPlugin struct {
+	typedName  plugin.TypedName
+	threshold  time.Duration
+	windowSize int
+	minSamples int
+	cooldown   time.Duration
+
+	lock   sync.Mutex
+	models map[string]*modelState
+	now    func() time.Time
+}
+
+// modelState holds the latency samples of a model in a circular buffer.
+type modelState struct {
+	samples       []time.Duration
+	next          int
+	disabledUntil time.Time
+}
+
+func (s *modelState) add(latency time.Duration, windowSize int) {
+	if len(s.samples) < windowSize {
+		s.samples = append(s.samples, latency)
+		return
+	}
+	s.samples[s.next] = latency
+	s.next = (s.next + 1) % windowSize
+}
+
+func (s *modelState) p99() time.Duration {
+	sorted := make([]time.Duration, len(s.samples))
+	copy(sorted, s.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	// nearest-rank percentile
+	rank := (99*len(sorted) + 99) / 100
+	return sorted[rank-1]
+}
+
+// requestState is stored in the CycleState to correlate a response with its request.
+type requestState struct {
+	model string
+	start time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *LatencyCircuitBreakerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *LatencyCircuitBreakerPlugin) WithName(name string) *LatencyCircuitBreakerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects requests to disabled models and records the request start time.
+func (p *LatencyCircuitBreakerPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, ok := request.Body[modelField].(string)
+	if !ok || model == "" {
+		return nil
+	}
+
+	now := p.now()
+	if p.isDisabled(model, now) {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected request to a model with high P99 latency", "model", model)
+		return errcommon.Error{Code: errcommon.ServiceUnavailable, Msg: fmt.Sprintf("model '%s' is temporarily disabled due to high latency", model)}
+	}
+
+	cycleState.Write(p.typedName.String(), requestState{model: model, start: now})
+	return nil
+}
+
+// ProcessResponse records the latency of the request and disables the model if its P99 latency is too high.
+func (p *LatencyCircuitBreakerPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, _ *framework.InferenceResponse) error {
+	if cycleState == nil {
+		return nil
+	}
+
+	state, err := framework.ReadCycleStateKey[requestState](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not tracked
+	}
+
+	now := p.now()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	model := p.modelState(state.model)
+	model.add(now.Sub(state.start), p.windowSize)
+	if len(model.samples) < p.minSamples || now.Before(model.disabledUntil) {
+		return nil
+	}
+
+	if p99 := model.p99(); p99 > p.threshold {
+		model.disabledUntil = now.Add(p.cooldown)
+		metrics.RecordModelCircuitBreakerState(state.model, true)
+		log.FromContext(ctx).Info("disabled model due to high P99 latency", "model", state.model, "p99", p99, "cooldown", p.cooldown)
+	}
+	return nil
+}
+
+// isDisabled reports whether the model is on the deny list, and re-enables it once the cooldown period ended.
+func (p *LatencyCircuitBreakerPlugin) isDisabled(model string, now time.Time) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	state, ok := p.models[model]
+	if !ok || state.disabledUntil.IsZero() {
+		return false
+	}
+	if now.Before(state.disabledUntil) {
+		return true
+	}
+
+	// the cooldown period ended, start over with a clean window
+	p.models[model] = &modelState{}
+	metrics.RecordModelCircuitBreakerState(model, false)
+	return false
+}
+
+// modelState returns the state of the given model, creating it if needed. Must be called with the lock held.
+func (p *LatencyCircuitBreakerPlugin) modelState(model string) *modelState {
+	state, ok := p.models[model]
+	if !ok {
+		state = &modelState{}
+		p.models[model] = state
+	}
+	return state
+}
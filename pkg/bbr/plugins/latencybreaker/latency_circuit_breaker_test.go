@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latencybreaker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestLatencyCircuitBreakerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"p99_threshold_ms":500}`),
+		},
+		{
+			name:      "missing threshold",
+			rawParams: json.RawMessage(`{}`),
+			wantErr:   true,
+		},
+		{
+			name:      "min samples larger than window",
+			rawParams: json.RawMessage(`{"p99_threshold_ms":500,"window_size":10,"min_samples":20}`),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := LatencyCircuitBreakerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestLatencyCircuitBreakerPlugin(t *testing.T) {
+	p, err := NewLatencyCircuitBreakerPlugin(LatencyCircuitBreakerConfig{
+		P99ThresholdMs:  100,
+		WindowSize:      10,
+		MinSamples:      5,
+		CooldownSeconds: 30,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	// roundTrip runs a request through the plugin and returns its response after the given latency.
+	roundTrip := func(model string, latency time.Duration) error {
+		cycleState := framework.NewCycleState()
+		request := framework.NewInferenceRequest()
+		request.Body[modelField] = model
+		if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+			return err
+		}
+		now = now.Add(latency)
+		return p.ProcessResponse(context.Background(), cycleState, framework.NewInferenceResponse())
+	}
+
+	// fast responses keep the model enabled
+	for range 5 {
+		if err := roundTrip("fast", 10*time.Millisecond); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// a slow model is not disabled before min samples are collected
+	for range 4 {
+		if err := roundTrip("slow", time.Second); err != nil {
+			t.Fatalf("unexpected error before min samples: %v", err)
+		}
+	}
+	if err := roundTrip("slow", time.Second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = roundTrip("slow", time.Second)
+	if got := errcommon.CanonicalCode(err); got != errcommon.ServiceUnavailable {
+		t.Fatalf("CanonicalCode = %q, want %q", got, errcommon.ServiceUnavailable)
+	}
+	if err := roundTrip("fast", 10*time.Millisecond); err != nil {
+		t.Errorf("other models should not be affected: %v", err)
+	}
+
+	// the model is re-enabled after the cooldown period
+	now = now.Add(31 * time.Second)
+	if err := roundTrip("slow", 10*time.Millisecond); err != nil {
+		t.Errorf("unexpected error after cooldown: %v", err)
+	}
+}
+
+func TestModelState_P99(t *testing.T) {
+	state := &modelState{}
+	for i := 1; i <= 200; i++ {
+		state.add(time.Duration(i)*time.Millisecond, 100)
+	}
+	// the circular buffer holds the samples 101ms..200ms
+	if got, want := len(state.samples), 100; got != want {
+		t.Fatalf("len(samples) = %d, want %d", got, want)
+	}
+	if got, want := state.p99(), 199*time.Millisecond; got != want {
+		t.Errorf("p99() = %v, want %v", got, want)
+	}
+}
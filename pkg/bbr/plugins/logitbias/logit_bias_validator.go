@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logitbias
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	LogitBiasValidatorPluginType = "logit-bias-validator"
+	logitBiasField               = "logit_bias"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &LogitBiasValidatorPlugin{}
+
+// LogitBiasValidatorConfig defines the JSON configuration structure for the plugin.
+type LogitBiasValidatorConfig struct {
+	// DenyTokenIDs are the token IDs that clients are not allowed to bias.
+	DenyTokenIDs []int `json:"deny_token_ids"`
+	// AllowOverride allows positive biases above MaxPositiveBias. Defaults to true.
+	AllowOverride *bool `json:"allow_override"`
+	// MaxPositiveBias is the highest positive bias allowed when AllowOverride is false.
+	MaxPositiveBias float64 `json:"max_positive_bias"`
+}
+
+// LogitBiasValidatorPluginFactory defines the factory function for NewLogitBiasValidatorPlugin.
+func LogitBiasValidatorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := LogitBiasValidatorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", LogitBiasValidatorPluginType, err)
+		}
+	}
+
+	allowOverride := config.AllowOverride == nil || *config.AllowOverride
+	plugin, err := NewLogitBiasValidatorPlugin(config.DenyTokenIDs, allowOverride, config.MaxPositiveBias)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", LogitBiasValidatorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewLogitBiasValidatorPlugin initializes a new LogitBiasValidatorPlugin and returns its pointer.
+func NewLogitBiasValidatorPlugin(denyTokenIDs []int, allowOverride bool, maxPositiveBias float64) (*LogitBiasValidatorPlugin, error) {
+	if len(denyTokenIDs) == 0 && allowOverride {
+		return nil, errors.New("deny_token_ids are required in LogitBiasValidator plugin, unless allow_override is false")
+	}
+	if maxPositiveBias < 0 {
+		return nil, errors.New("max_positive_bias must not be negative in LogitBiasValidator plugin")
+	}
+
+	return &LogitBiasValidatorPlugin{
+		typedName: plugin.TypedName{
+			Type: LogitBiasValidatorPluginType,
+			Name: LogitBiasValidatorPluginType,
+		},
+		denied:          sets.New(denyTokenIDs...),
+		allowOverride:   allowOverride,
+		maxPositiveBias: maxPositiveBias,
+	}, nil
+}
+
+// LogitBiasValidatorPlugin rejects requests whose logit_bias parameter biases a denied token, e.g. a
+// profanity token, with HTTP 400. When overrides are not allowed, it also rejects positive biases above
+// the configured maximum.
+type LogitBiasValidatorPlugin struct {
+	typedName       plugin.TypedName
+	denied          sets.Set[int]
+	allowOverride   bool
+	maxPositiveBias float64
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *LogitBiasValidatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *LogitBiasValidatorPlugin) WithName(name string) *LogitBiasValidatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the logit_bias parameter of the request.
+func (p *LogitBiasValidatorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	rawLogitBias, ok := request.Body[logitBiasField]
+	if !ok || rawLogitBias == nil {
+		return nil
+	}
+	logitBias, ok := rawLogitBias.(map[string]any)
+	if !ok {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "logit_bias must be a map of token IDs to biases"}
+	}
+
+	var deniedTokens, overriddenTokens []string
+	for token, rawBias := range logitBias {
+		tokenID, err := strconv.Atoi(token)
+		if err != nil {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("invalid token ID '%s' in logit_bias", token)}
+		}
+		bias, ok := rawBias.(float64)
+		if !ok {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("invalid bias of token ID '%s' in logit_bias", token)}
+		}
+		if p.denied.Has(tokenID) {
+			deniedTokens = append(deniedTokens, token)
+		} else if !p.allowOverride && bias > p.maxPositiveBias {
+			overriddenTokens = append(overriddenTokens, token)
+		}
+	}
+
+	if len(deniedTokens) > 0 {
+		slices.Sort(deniedTokens)
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected request biasing denied tokens", "tokens", deniedTokens)
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("logit_bias must not bias the token IDs %v", deniedTokens)}
+	}
+	if len(overriddenTokens) > 0 {
+		slices.Sort(overriddenTokens)
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected request with positive biases above the maximum", "tokens", overriddenTokens)
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("logit_bias of the token IDs %v exceeds the maximal positive bias %v", overriddenTokens, p.maxPositiveBias)}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logitbias
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestLogitBiasValidatorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"deny_token_ids":[1234,5678]}`)},
+		{name: "override limit only", rawParams: json.RawMessage(`{"allow_override":false,"max_positive_bias":10}`)},
+		{name: "missing deny list", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "negative max positive bias", rawParams: json.RawMessage(`{"allow_override":false,"max_positive_bias":-1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := LogitBiasValidatorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestLogitBiasValidatorPlugin(t *testing.T) {
+	tests := []struct {
+		name          string
+		allowOverride bool
+		logitBias     any
+		wantCode      string
+	}{
+		{
+			name:          "no denied tokens",
+			allowOverride: true,
+			logitBias:     map[string]any{"42": 50.0},
+		},
+		{
+			name:          "denied token is biased",
+			allowOverride: true,
+			logitBias:     map[string]any{"42": 1.0, "1234": -100.0},
+			wantCode:      errcommon.BadRequest,
+		},
+		{
+			name:          "positive bias above the maximum",
+			allowOverride: false,
+			logitBias:     map[string]any{"42": 50.0},
+			wantCode:      errcommon.BadRequest,
+		},
+		{
+			name:          "negative bias is allowed without override",
+			allowOverride: false,
+			logitBias:     map[string]any{"42": -100.0, "43": 10.0},
+		},
+		{
+			name:          "invalid token ID",
+			allowOverride: true,
+			logitBias:     map[string]any{"abc": 1.0},
+			wantCode:      errcommon.BadRequest,
+		},
+		{
+			name:          "logit_bias is not a map",
+			allowOverride: true,
+			logitBias:     []any{1.0},
+			wantCode:      errcommon.BadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewLogitBiasValidatorPlugin([]int{1234}, tt.allowOverride, 10)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Body["logit_bias"] = tt.logitBias
+
+			err = p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+				t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+			}
+		})
+	}
+}
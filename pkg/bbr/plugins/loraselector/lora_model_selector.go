@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loraselector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/basemodelextractor"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	LoRAModelSelectorPluginType = "lora-model-selector"
+	// LoRANameHeader carries the comma-separated LoRA adapters of the request.
+	LoRANameHeader = "X-Gateway-LoRA-Name"
+	// LoRATagEnvVar is the environment variable overriding the default LoRA tag.
+	LoRATagEnvVar = "LORA_TAG"
+	// DefaultLoRATag separates the base model from the LoRA adapters in the model name, e.g. "llama-3-8b/lora/sql".
+	DefaultLoRATag = "/lora/"
+
+	modelField = "model"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &LoRAModelSelectorPlugin{}
+
+// LoRAModelSelectorConfig defines the JSON configuration structure for the plugin.
+type LoRAModelSelectorConfig struct {
+	// LoRATag separates the base model from the LoRA adapters in the model name. Defaults to the LORA_TAG
+	// environment variable, or to "/lora/" if it is not set.
+	LoRATag string `json:"lora_tag"`
+}
+
+// LoRAModelSelectorPluginFactory defines the factory function for NewLoRAModelSelectorPlugin.
+func LoRAModelSelectorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := LoRAModelSelectorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", LoRAModelSelectorPluginType, err)
+		}
+	}
+	if config.LoRATag == "" {
+		config.LoRATag = os.Getenv(LoRATagEnvVar)
+	}
+
+	return NewLoRAModelSelectorPlugin(config.LoRATag).WithName(name), nil
+}
+
+// NewLoRAModelSelectorPlugin initializes a new LoRAModelSelectorPlugin and returns its pointer.
+// An empty tag defaults to DefaultLoRATag.
+func NewLoRAModelSelectorPlugin(loraTag string) *LoRAModelSelectorPlugin {
+	if loraTag == "" {
+		loraTag = DefaultLoRATag
+	}
+
+	return &LoRAModelSelectorPlugin{
+		typedName: plugin.TypedName{
+			Type: LoRAModelSelectorPluginType,
+			Name: LoRAModelSelectorPluginType,
+		},
+		loraTag: loraTag,
+	}
+}
+
+// LoRAModelSelectorPlugin routes requests for LoRA adapters named by convention, i.e. with a model of the
+// form <base-model><tag><lora-name>[,<lora-name>...], e.g. "llama-3-8b/lora/sql,finance". It sets the
+// X-Gateway-Base-Model-Name header to the base model, the X-Gateway-LoRA-Name header to the adapters, and
+// the model of the body to the base model. Requests for models without the tag are forwarded unchanged,
+// and requests with the tag but without a base model or an adapter are rejected with HTTP 400.
+type LoRAModelSelectorPlugin struct {
+	typedName plugin.TypedName
+	loraTag   string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *LoRAModelSelectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *LoRAModelSelectorPlugin) WithName(name string) *LoRAModelSelectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest splits the model of the request into its base model and LoRA adapters.
+func (p *LoRAModelSelectorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, ok := request.Body[modelField].(string)
+	if !ok {
+		return nil
+	}
+	baseModel, loraNames, found := strings.Cut(model, p.loraTag)
+	if !found {
+		return nil
+	}
+
+	var adapters []string
+	for _, adapter := range strings.Split(loraNames, ",") {
+		if adapter = strings.TrimSpace(adapter); adapter != "" {
+			adapters = append(adapters, adapter)
+		}
+	}
+	if baseModel == "" || len(adapters) == 0 {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("invalid LoRA model %q, expected <base-model>%s<lora-name>", model, p.loraTag)}
+	}
+
+	request.SetHeader(basemodelextractor.BaseModelHeader, baseModel)
+	request.SetHeader(LoRANameHeader, strings.Join(adapters, ","))
+	request.SetBodyField(modelField, baseModel)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("selected LoRA adapters", "baseModel", baseModel, "adapters", adapters)
+	return nil
+}
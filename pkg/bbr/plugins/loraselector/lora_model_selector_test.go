@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loraselector
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/basemodelextractor"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestLoRAModelSelectorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		env       string
+		wantTag   string
+		wantErr   bool
+	}{
+		{name: "default tag", wantTag: DefaultLoRATag},
+		{name: "environment tag", env: ":", wantTag: ":"},
+		{name: "configured tag", rawParams: json.RawMessage(`{"lora_tag":"@"}`), env: ":", wantTag: "@"},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(LoRATagEnvVar, tt.env)
+			p, err := LoRAModelSelectorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+			if got := p.(*LoRAModelSelectorPlugin).loraTag; got != tt.wantTag {
+				t.Errorf("loraTag = %q, want %q", got, tt.wantTag)
+			}
+		})
+	}
+}
+
+func TestLoRAModelSelectorPlugin(t *testing.T) {
+	tests := []struct {
+		name        string
+		loraTag     string
+		model       any
+		wantModel   any
+		wantHeaders map[string]string
+		wantCode    string
+	}{
+		{
+			name:      "single adapter",
+			model:     "llama-3-8b/lora/sql",
+			wantModel: "llama-3-8b",
+			wantHeaders: map[string]string{
+				basemodelextractor.BaseModelHeader: "llama-3-8b",
+				LoRANameHeader:                     "sql",
+			},
+		},
+		{
+			name:      "multiple adapters",
+			model:     "meta-llama/Llama-3-8B/lora/sql, finance,",
+			wantModel: "meta-llama/Llama-3-8B",
+			wantHeaders: map[string]string{
+				basemodelextractor.BaseModelHeader: "meta-llama/Llama-3-8B",
+				LoRANameHeader:                     "sql,finance",
+			},
+		},
+		{
+			name:      "custom tag",
+			loraTag:   "@",
+			model:     "llama-3-8b@sql",
+			wantModel: "llama-3-8b",
+			wantHeaders: map[string]string{
+				basemodelextractor.BaseModelHeader: "llama-3-8b",
+				LoRANameHeader:                     "sql",
+			},
+		},
+		{
+			name:        "model without the tag",
+			model:       "llama-3-8b",
+			wantModel:   "llama-3-8b",
+			wantHeaders: map[string]string{},
+		},
+		{
+			name:        "no model",
+			wantHeaders: map[string]string{},
+		},
+		{
+			name:     "missing base model",
+			model:    "/lora/sql",
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "missing adapter",
+			model:    "llama-3-8b/lora/ , ",
+			wantCode: errcommon.BadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewLoRAModelSelectorPlugin(tt.loraTag)
+			request := framework.NewInferenceRequest()
+			if tt.model != nil {
+				request.Body["model"] = tt.model
+			}
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Body["model"]; got != tt.wantModel {
+				t.Errorf("model = %v, want %v", got, tt.wantModel)
+			}
+			if diff := cmp.Diff(tt.wantHeaders, request.MutatedHeaders()); diff != "" {
+				t.Errorf("unexpected headers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagealternation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	MessageAlternationPluginType = "message-alternation"
+	messagesField                = "messages"
+	systemRole                   = "system"
+	toolRole                     = "tool"
+	mergeSeparator               = "\n\n"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &MessageAlternationPlugin{}
+
+// MessageAlternationConfig defines the JSON configuration structure for the plugin.
+type MessageAlternationConfig struct {
+	// MergeConsecutive merges consecutive messages of the same role into a single message, instead of
+	// rejecting the request.
+	MergeConsecutive bool `json:"merge_consecutive"`
+}
+
+// MessageAlternationPluginFactory defines the factory function for NewMessageAlternationPlugin.
+func MessageAlternationPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := MessageAlternationConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", MessageAlternationPluginType, err)
+		}
+	}
+
+	return NewMessageAlternationPlugin(config.MergeConsecutive).WithName(name), nil
+}
+
+// NewMessageAlternationPlugin initializes a new MessageAlternationPlugin and returns its pointer.
+func NewMessageAlternationPlugin(mergeConsecutive bool) *MessageAlternationPlugin {
+	return &MessageAlternationPlugin{
+		typedName: plugin.TypedName{
+			Type: MessageAlternationPluginType,
+			Name: MessageAlternationPluginType,
+		},
+		mergeConsecutive: mergeConsecutive,
+	}
+}
+
+// MessageAlternationPlugin enforces that the roles of chat messages alternate, e.g. user, assistant, user,
+// as required by some models. System messages are ignored, and so are tool messages, since parallel tool
+// calls legitimately produce consecutive tool messages. Requests with consecutive messages of the same
+// role are rejected with HTTP 400, or get the messages merged when MergeConsecutive is set.
+type MessageAlternationPlugin struct {
+	typedName        plugin.TypedName
+	mergeConsecutive bool
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *MessageAlternationPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *MessageAlternationPlugin) WithName(name string) *MessageAlternationPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest checks that the roles of the chat messages alternate.
+func (p *MessageAlternationPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	messages, ok := request.Body[messagesField].([]any)
+	if !ok {
+		return nil // not a chat completion request
+	}
+
+	var violations []string
+	merged := make([]any, 0, len(messages))
+	previous := -1 // index in merged of the previous message that must alternate
+	for i, raw := range messages {
+		message, ok := raw.(map[string]any)
+		if !ok {
+			merged = append(merged, raw)
+			continue
+		}
+		role, _ := message["role"].(string)
+		if role == systemRole || role == toolRole {
+			merged = append(merged, raw)
+			continue
+		}
+
+		if previous >= 0 {
+			if last := merged[previous].(map[string]any); last["role"] == role {
+				violations = append(violations, strconv.Itoa(i))
+				if p.mergeConsecutive {
+					merged[previous] = mergeMessages(last, message)
+					continue
+				}
+			}
+		}
+		merged = append(merged, raw)
+		previous = len(merged) - 1
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	if !p.mergeConsecutive {
+		return errcommon.Error{
+			Code: errcommon.BadRequest,
+			Msg:  "messages must alternate roles, found consecutive messages of the same role at positions " + strings.Join(violations, ", "),
+		}
+	}
+
+	request.SetBodyField(messagesField, merged)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("merged consecutive messages of the same role", "positions", violations)
+	return nil
+}
+
+// mergeMessages returns a copy of first with the content of second appended to its content.
+// Text contents are separated by a blank line, while contents made of parts are concatenated.
+func mergeMessages(first, second map[string]any) map[string]any {
+	merged := maps.Clone(first)
+
+	firstText, firstIsText := first["content"].(string)
+	secondText, secondIsText := second["content"].(string)
+	if firstIsText && secondIsText {
+		merged["content"] = firstText + mergeSeparator + secondText
+		return merged
+	}
+
+	merged["content"] = slices.Concat(contentParts(first["content"]), contentParts(second["content"]))
+	return merged
+}
+
+// contentParts returns the content of a message as a list of content parts.
+func contentParts(content any) []any {
+	switch c := content.(type) {
+	case string:
+		return []any{map[string]any{"type": "text", "text": c}}
+	case []any:
+		return c
+	default:
+		return nil
+	}
+}
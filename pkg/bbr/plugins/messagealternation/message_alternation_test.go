@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagealternation
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestMessageAlternationPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "merge consecutive", rawParams: json.RawMessage(`{"merge_consecutive":true}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := MessageAlternationPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func message(role string, content any) map[string]any {
+	return map[string]any{"role": role, "content": content}
+}
+
+func TestMessageAlternationPlugin(t *testing.T) {
+	tests := []struct {
+		name             string
+		mergeConsecutive bool
+		messages         []any
+		wantCode         string
+		wantMessages     []any
+		wantMutated      bool
+	}{
+		{
+			name:         "alternating messages",
+			messages:     []any{message("system", "be nice"), message("user", "hi"), message("assistant", "hello"), message("user", "bye")},
+			wantMessages: []any{message("system", "be nice"), message("user", "hi"), message("assistant", "hello"), message("user", "bye")},
+		},
+		{
+			name:         "system and tool messages are ignored",
+			messages:     []any{message("user", "hi"), message("system", "note"), message("assistant", "calling"), message("tool", "a"), message("tool", "b"), message("user", "thanks")},
+			wantMessages: []any{message("user", "hi"), message("system", "note"), message("assistant", "calling"), message("tool", "a"), message("tool", "b"), message("user", "thanks")},
+		},
+		{
+			name:     "consecutive user messages are rejected",
+			messages: []any{message("user", "hi"), message("user", "again"), message("assistant", "hello")},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:             "consecutive messages are merged",
+			mergeConsecutive: true,
+			messages:         []any{message("system", "be nice"), message("user", "hi"), message("user", "again"), message("user", "and again"), message("assistant", "hello")},
+			wantMessages:     []any{message("system", "be nice"), message("user", "hi\n\nagain\n\nand again"), message("assistant", "hello")},
+			wantMutated:      true,
+		},
+		{
+			name:             "content parts are concatenated",
+			mergeConsecutive: true,
+			messages:         []any{message("user", "hi"), message("user", []any{map[string]any{"type": "image_url", "image_url": map[string]any{"url": "https://example.com/a.png"}}})},
+			wantMessages: []any{message("user", []any{
+				map[string]any{"type": "text", "text": "hi"},
+				map[string]any{"type": "image_url", "image_url": map[string]any{"url": "https://example.com/a.png"}},
+			})},
+			wantMutated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewMessageAlternationPlugin(tt.mergeConsecutive)
+			request := framework.NewInferenceRequest()
+			request.Body["messages"] = tt.messages
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantMessages, request.Body["messages"]); diff != "" {
+				t.Errorf("messages mismatch (-want +got):\n%s", diff)
+			}
+			if got := request.BodyMutated(); got != tt.wantMutated {
+				t.Errorf("BodyMutated() = %v, want %v", got, tt.wantMutated)
+			}
+		})
+	}
+}
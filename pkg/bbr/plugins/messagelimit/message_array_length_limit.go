@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagelimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	MessageArrayLengthLimitPluginType = "message-array-length-limit"
+
+	// TruncatedHeader is set to the number of messages dropped from the conversation in truncate mode.
+	TruncatedHeader = "X-BBR-Messages-Truncated"
+
+	messagesField = "messages"
+
+	defaultMaxMessages = 100
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &MessageArrayLengthLimitPlugin{}
+
+// MessageArrayLengthLimitConfig defines the JSON configuration structure for the plugin.
+type MessageArrayLengthLimitConfig struct {
+	// MaxMessages is the maximal number of messages of a conversation. Defaults to 100.
+	MaxMessages int `json:"max_messages"`
+	// Truncate drops the oldest non-system messages of too long conversations, instead of rejecting them.
+	Truncate bool `json:"truncate"`
+}
+
+// MessageArrayLengthLimitPluginFactory defines the factory function for NewMessageArrayLengthLimitPlugin.
+func MessageArrayLengthLimitPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := MessageArrayLengthLimitConfig{MaxMessages: defaultMaxMessages}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", MessageArrayLengthLimitPluginType, err)
+		}
+	}
+
+	plugin, err := NewMessageArrayLengthLimitPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", MessageArrayLengthLimitPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewMessageArrayLengthLimitPlugin initializes a new MessageArrayLengthLimitPlugin and returns its pointer.
+func NewMessageArrayLengthLimitPlugin(config MessageArrayLengthLimitConfig) (*MessageArrayLengthLimitPlugin, error) {
+	if config.MaxMessages <= 0 {
+		return nil, errors.New("max_messages must be positive in MessageArrayLengthLimit plugin")
+	}
+
+	return &MessageArrayLengthLimitPlugin{
+		typedName: plugin.TypedName{
+			Type: MessageArrayLengthLimitPluginType,
+			Name: MessageArrayLengthLimitPluginType,
+		},
+		maxMessages: config.MaxMessages,
+		truncate:    config.Truncate,
+	}, nil
+}
+
+// MessageArrayLengthLimitPlugin limits the number of messages of chat completion requests, since some
+// model deployments become unstable with very long conversation histories. Too long conversations are
+// rejected with HTTP 400, or truncated in truncate mode, by dropping their oldest non-system messages.
+// Tool messages left without the assistant message that called the tool are dropped as well, and the
+// number of dropped messages is set in the X-BBR-Messages-Truncated header.
+type MessageArrayLengthLimitPlugin struct {
+	typedName   plugin.TypedName
+	maxMessages int
+	truncate    bool
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *MessageArrayLengthLimitPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *MessageArrayLengthLimitPlugin) WithName(name string) *MessageArrayLengthLimitPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects or truncates the request if it has too many messages.
+func (p *MessageArrayLengthLimitPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	messages, ok := request.Body[messagesField].([]any)
+	if !ok || len(messages) <= p.maxMessages {
+		return nil
+	}
+
+	tooLong := conversationTooLong(p.maxMessages, len(messages))
+	if !p.truncate {
+		return tooLong
+	}
+
+	truncated := truncate(messages, p.maxMessages)
+	if len(truncated) > p.maxMessages {
+		return tooLong // the system messages alone exceed the limit
+	}
+	dropped := len(messages) - len(truncated)
+	request.SetBodyField(messagesField, truncated)
+	request.SetHeader(TruncatedHeader, strconv.Itoa(dropped))
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("truncated a too long conversation", "messages", len(messages), "dropped", dropped)
+	return nil
+}
+
+// conversationTooLong returns the HTTP 400 response rejecting a conversation with more messages than the maximum.
+func conversationTooLong(maxMessages int, actual int) error {
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message":      "Conversation too long",
+			"max_messages": maxMessages,
+			"actual":       actual,
+		},
+	})
+	return errcommon.ImmediateResponse{
+		Code:    errcommon.BadRequest,
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    body,
+	}
+}
+
+// truncate drops the oldest non-system messages until at most maxMessages remain, followed by the tool
+// messages whose calling assistant message was dropped.
+func truncate(messages []any, maxMessages int) []any {
+	drop := len(messages) - maxMessages
+	dropping := false
+	truncated := make([]any, 0, maxMessages)
+	for _, m := range messages {
+		role := ""
+		if message, ok := m.(map[string]any); ok {
+			role, _ = message["role"].(string)
+		}
+		switch {
+		case role == "system" || role == "developer":
+			truncated = append(truncated, m)
+		case drop > 0:
+			drop--
+			dropping = true
+		case dropping && role == "tool":
+			// the assistant message calling the tool was dropped
+		default:
+			dropping = false
+			truncated = append(truncated, m)
+		}
+	}
+	return truncated
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messagelimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestMessageArrayLengthLimitPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "truncate mode", rawParams: json.RawMessage(`{"max_messages":50,"truncate":true}`)},
+		{name: "non-positive max messages", rawParams: json.RawMessage(`{"max_messages":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := MessageArrayLengthLimitPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func message(role, content string) map[string]any {
+	return map[string]any{"role": role, "content": content}
+}
+
+func TestMessageArrayLengthLimitPlugin(t *testing.T) {
+	conversation := []any{
+		message("system", "s"),
+		message("user", "u1"),
+		message("assistant", "a1"),
+		message("user", "u2"),
+		message("assistant", "a2"),
+		message("user", "u3"),
+	}
+	toolConversation := []any{
+		message("system", "s"),
+		message("user", "u1"),
+		map[string]any{"role": "assistant", "tool_calls": []any{map[string]any{"id": "call_1"}}},
+		map[string]any{"role": "tool", "tool_call_id": "call_1", "content": "42"},
+		message("assistant", "a1"),
+		message("user", "u2"),
+	}
+
+	tests := []struct {
+		name          string
+		maxMessages   int
+		truncate      bool
+		messages      []any
+		wantBody      string
+		wantMessages  []any
+		wantTruncated string
+	}{
+		{
+			name:         "within the limit",
+			maxMessages:  6,
+			messages:     conversation,
+			wantMessages: conversation,
+		},
+		{
+			name:        "too long conversation is rejected",
+			maxMessages: 4,
+			messages:    conversation,
+			wantBody:    `{"error":{"message":"Conversation too long","max_messages":4,"actual":6}}`,
+		},
+		{
+			name:          "too long conversation is truncated",
+			maxMessages:   4,
+			truncate:      true,
+			messages:      conversation,
+			wantMessages:  []any{message("system", "s"), message("user", "u2"), message("assistant", "a2"), message("user", "u3")},
+			wantTruncated: "2",
+		},
+		{
+			name:          "orphaned tool messages are dropped",
+			maxMessages:   4,
+			truncate:      true,
+			messages:      toolConversation,
+			wantMessages:  []any{message("system", "s"), message("assistant", "a1"), message("user", "u2")},
+			wantTruncated: "3",
+		},
+		{
+			name:        "system messages exceeding the limit are rejected",
+			maxMessages: 1,
+			truncate:    true,
+			messages:    []any{message("system", "s1"), message("system", "s2"), message("user", "u1")},
+			wantBody:    `{"error":{"message":"Conversation too long","max_messages":1,"actual":3}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewMessageArrayLengthLimitPlugin(MessageArrayLengthLimitConfig{MaxMessages: tt.maxMessages, Truncate: tt.truncate})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Body["messages"] = tt.messages
+
+			err = p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantBody != "" {
+				var immediate errcommon.ImmediateResponse
+				if !errors.As(err, &immediate) || immediate.Code != errcommon.BadRequest {
+					t.Fatalf("error = %v, want %s immediate response", err, errcommon.BadRequest)
+				}
+				var got, want any
+				if err := json.Unmarshal(immediate.Body, &got); err != nil {
+					t.Fatalf("body should be JSON, got %s: %v", immediate.Body, err)
+				}
+				_ = json.Unmarshal([]byte(tt.wantBody), &want)
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("body mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantMessages, request.Body["messages"]); diff != "" {
+				t.Errorf("messages mismatch (-want +got):\n%s", diff)
+			}
+			if got := request.GetHeader(TruncatedHeader); got != tt.wantTruncated {
+				t.Errorf("%s = %q, want %q", TruncatedHeader, got, tt.wantTruncated)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mlflowadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	MLflowBodyAdapterPluginType = "mlflow-body-adapter"
+	ProviderHeader              = "X-Gateway-Provider"
+	mlflowProvider              = "mlflow"
+
+	// DataframeRecordsFormat is MLflow's pandas "records" oriented input format.
+	DataframeRecordsFormat = "dataframe_records"
+	// InstancesFormat is MLflow's tensor input format.
+	InstancesFormat = "instances"
+)
+
+// generationParams are the OpenAI request fields forwarded to MLflow as inference params.
+var generationParams = []string{"temperature", "max_tokens", "top_p", "stop", "n"}
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &MLflowBodyAdapterPlugin{}
+	_ framework.ResponseProcessor = &MLflowBodyAdapterPlugin{}
+)
+
+// MLflowBodyAdapterConfig defines the JSON configuration structure for the plugin.
+type MLflowBodyAdapterConfig struct {
+	// Format is the MLflow input format, either "dataframe_records" (default) or "instances".
+	Format string `json:"format"`
+}
+
+// MLflowBodyAdapterPluginFactory defines the factory function for NewMLflowBodyAdapterPlugin.
+func MLflowBodyAdapterPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := MLflowBodyAdapterConfig{Format: DataframeRecordsFormat}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", MLflowBodyAdapterPluginType, err)
+		}
+	}
+
+	plugin, err := NewMLflowBodyAdapterPlugin(config.Format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", MLflowBodyAdapterPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewMLflowBodyAdapterPlugin initializes a new MLflowBodyAdapterPlugin and returns its pointer.
+func NewMLflowBodyAdapterPlugin(format string) (*MLflowBodyAdapterPlugin, error) {
+	if format != DataframeRecordsFormat && format != InstancesFormat {
+		return nil, fmt.Errorf("unsupported format '%s' in MLflowBodyAdapter plugin", format)
+	}
+
+	return &MLflowBodyAdapterPlugin{
+		typedName: plugin.TypedName{
+			Type: MLflowBodyAdapterPluginType,
+			Name: MLflowBodyAdapterPluginType,
+		},
+		format: format,
+	}, nil
+}
+
+// MLflowBodyAdapterPlugin converts OpenAI chat completion requests routed to an MLflow Serving
+// backend (marked with "X-Gateway-Provider: mlflow") into MLflow's scoring input format, and
+// converts the MLflow predictions in the response back into an OpenAI chat completion.
+type MLflowBodyAdapterPlugin struct {
+	typedName plugin.TypedName
+	format    string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *MLflowBodyAdapterPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *MLflowBodyAdapterPlugin) WithName(name string) *MLflowBodyAdapterPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rewrites the chat completion request into MLflow's input format.
+func (p *MLflowBodyAdapterPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	if !strings.EqualFold(request.GetHeader(ProviderHeader), mlflowProvider) {
+		return nil
+	}
+
+	messages, ok := request.Body["messages"].([]any)
+	if !ok {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "field 'messages' is required for MLflow backends"}
+	}
+
+	params := map[string]any{}
+	for _, param := range generationParams {
+		if value, ok := request.Body[param]; ok {
+			params[param] = value
+		}
+	}
+
+	body := map[string]any{
+		p.format: []any{map[string]any{"messages": messages}},
+	}
+	if len(params) > 0 {
+		body["params"] = params
+	}
+
+	model, _ := request.Body["model"].(string)
+	request.SetBody(body)
+	if cycleState != nil {
+		cycleState.Write(p.typedName.String(), model)
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("converted request to MLflow format", "format", p.format)
+
+	return nil
+}
+
+// ProcessResponse converts the MLflow predictions into an OpenAI chat completion response.
+func (p *MLflowBodyAdapterPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+
+	model, err := framework.ReadCycleStateKey[string](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not sent to an MLflow backend
+	}
+
+	predictions, ok := response.Body["predictions"].([]any)
+	if !ok {
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("MLflow response has no predictions, leaving it unchanged")
+		return nil
+	}
+
+	choices := make([]any, 0, len(predictions))
+	for i, prediction := range predictions {
+		choices = append(choices, toChoice(i, prediction))
+	}
+
+	response.SetBody(map[string]any{
+		"object":  "chat.completion",
+		"model":   model,
+		"choices": choices,
+	})
+	return nil
+}
+
+// toChoice converts a single MLflow prediction into an OpenAI choice. A prediction is either the
+// generated text, or a chat response of an MLflow ChatModel, which already has OpenAI choices.
+func toChoice(index int, prediction any) map[string]any {
+	if chatResponse, ok := prediction.(map[string]any); ok {
+		if choices, ok := chatResponse["choices"].([]any); ok && len(choices) > 0 {
+			if choice, ok := choices[0].(map[string]any); ok {
+				choice["index"] = index
+				return choice
+			}
+		}
+	}
+
+	content, ok := prediction.(string)
+	if !ok {
+		raw, _ := json.Marshal(prediction)
+		content = string(raw)
+	}
+	return map[string]any{
+		"index":         index,
+		"message":       map[string]any{"role": "assistant", "content": content},
+		"finish_reason": "stop",
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mlflowadapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+const chatRequest = `{
+	"model": "llama-3-8b",
+	"messages": [
+		{"role": "system", "content": "You are a helpful assistant."},
+		{"role": "user", "content": "What is MLflow?"}
+	],
+	"temperature": 0.1,
+	"max_tokens": 100
+}`
+
+func unmarshal(t *testing.T, raw string) map[string]any {
+	t.Helper()
+	out := map[string]any{}
+	if err := json.Unmarshal([]byte(raw), &out); err != nil {
+		t.Fatalf("failed to unmarshal %q: %v", raw, err)
+	}
+	return out
+}
+
+func TestMLflowBodyAdapterPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "instances format", rawParams: json.RawMessage(`{"format":"instances"}`)},
+		{name: "unsupported format", rawParams: json.RawMessage(`{"format":"inputs"}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := MLflowBodyAdapterPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestMLflowBodyAdapterPlugin_ProcessRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		provider string
+		want     string
+	}{
+		{
+			name:     "dataframe records",
+			format:   DataframeRecordsFormat,
+			provider: "mlflow",
+			want: `{
+				"dataframe_records": [{"messages": [
+					{"role": "system", "content": "You are a helpful assistant."},
+					{"role": "user", "content": "What is MLflow?"}
+				]}],
+				"params": {"temperature": 0.1, "max_tokens": 100}
+			}`,
+		},
+		{
+			name:     "instances",
+			format:   InstancesFormat,
+			provider: "MLflow",
+			want: `{
+				"instances": [{"messages": [
+					{"role": "system", "content": "You are a helpful assistant."},
+					{"role": "user", "content": "What is MLflow?"}
+				]}],
+				"params": {"temperature": 0.1, "max_tokens": 100}
+			}`,
+		},
+		{
+			name:     "other provider is left unchanged",
+			format:   DataframeRecordsFormat,
+			provider: "openai",
+			want:     chatRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewMLflowBodyAdapterPlugin(tt.format)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Headers["x-gateway-provider"] = tt.provider
+			request.Body = unmarshal(t, chatRequest)
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(unmarshal(t, tt.want), request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMLflowBodyAdapterPlugin_ProcessResponse(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     string
+	}{
+		{
+			name:     "text predictions",
+			response: `{"predictions": ["MLflow is an open source platform for the machine learning lifecycle."]}`,
+			want: `{
+				"object": "chat.completion",
+				"model": "llama-3-8b",
+				"choices": [{
+					"index": 0,
+					"message": {"role": "assistant", "content": "MLflow is an open source platform for the machine learning lifecycle."},
+					"finish_reason": "stop"
+				}]
+			}`,
+		},
+		{
+			name: "chat model predictions",
+			response: `{"predictions": [{
+				"id": "chatcmpl-1",
+				"object": "chat.completion",
+				"choices": [{"index": 0, "message": {"role": "assistant", "content": "MLflow tracks experiments."}, "finish_reason": "length"}]
+			}]}`,
+			want: `{
+				"object": "chat.completion",
+				"model": "llama-3-8b",
+				"choices": [{
+					"index": 0,
+					"message": {"role": "assistant", "content": "MLflow tracks experiments."},
+					"finish_reason": "length"
+				}]
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewMLflowBodyAdapterPlugin(DataframeRecordsFormat)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			request.Headers["x-gateway-provider"] = "mlflow"
+			request.Body = unmarshal(t, chatRequest)
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			response := framework.NewInferenceResponse()
+			response.Body = unmarshal(t, tt.response)
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !response.BodyMutated() {
+				t.Error("expected response body to be mutated")
+			}
+			// round-trip the body through JSON to compare numbers the way they reach the client
+			raw, err := json.Marshal(response.Body)
+			if err != nil {
+				t.Fatalf("failed to marshal response body: %v", err)
+			}
+			if diff := cmp.Diff(unmarshal(t, tt.want), unmarshal(t, string(raw))); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
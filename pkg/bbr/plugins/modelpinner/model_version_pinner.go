@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpinner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ModelVersionPinnerPluginType = "model-version-pinner"
+	PinnedFromHeader             = "X-BBR-Model-Pinned-From"
+	PinnedToHeader               = "X-BBR-Model-Pinned-To"
+	modelField                   = "model"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor = &ModelVersionPinnerPlugin{}
+	_ framework.Closer           = &ModelVersionPinnerPlugin{}
+)
+
+// ModelVersionPinnerConfig defines the JSON configuration structure for the plugin.
+type ModelVersionPinnerConfig struct {
+	// Pins maps a model alias to the model version it is pinned to, e.g. {"gpt-4-latest":"gpt-4-0613"}.
+	Pins map[string]string `json:"pins"`
+	// PinsFile is an optional path of a JSON file in the format {"pins":{...}}. When set, the pins
+	// are loaded from the file, and reloaded from it whenever the process receives SIGHUP.
+	PinsFile string `json:"pins_file"`
+}
+
+// ModelVersionPinnerPluginFactory defines the factory function for NewModelVersionPinnerPlugin.
+func ModelVersionPinnerPluginFactory(name string, rawParameters json.RawMessage, handle framework.Handle) (framework.BBRPlugin, error) {
+	var config ModelVersionPinnerConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ModelVersionPinnerPluginType, err)
+		}
+	}
+
+	plugin, err := NewModelVersionPinnerPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ModelVersionPinnerPluginType, err)
+	}
+
+	if config.PinsFile != "" && handle != nil {
+		plugin.reloadOnSIGHUP(handle.Context())
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewModelVersionPinnerPlugin initializes a new ModelVersionPinnerPlugin and returns its pointer.
+func NewModelVersionPinnerPlugin(config ModelVersionPinnerConfig) (*ModelVersionPinnerPlugin, error) {
+	p := &ModelVersionPinnerPlugin{
+		typedName: plugin.TypedName{
+			Type: ModelVersionPinnerPluginType,
+			Name: ModelVersionPinnerPluginType,
+		},
+		pinsFile: config.PinsFile,
+		pins:     config.Pins,
+	}
+
+	if p.pinsFile != "" {
+		if err := p.reload(); err != nil {
+			return nil, err
+		}
+	}
+	if len(p.pins) == 0 {
+		return nil, errors.New("pins are required in ModelVersionPinner plugin")
+	}
+
+	return p, nil
+}
+
+// ModelVersionPinnerPlugin rewrites model aliases, such as "gpt-4-latest", to the specific model
+// version they are pinned to, so the meaning of an alias does not change when new versions are released.
+type ModelVersionPinnerPlugin struct {
+	typedName plugin.TypedName
+	pinsFile  string
+	// stopReload stops reloading the pins file on SIGHUP, nil if the pins file is not reloaded
+	stopReload context.CancelFunc
+
+	lock sync.RWMutex
+	pins map[string]string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ModelVersionPinnerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ModelVersionPinnerPlugin) WithName(name string) *ModelVersionPinnerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rewrites the model field to the pinned model version, if the model is a pinned alias.
+func (p *ModelVersionPinnerPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, ok := request.Body[modelField].(string)
+	if !ok || model == "" {
+		return nil
+	}
+
+	p.lock.RLock()
+	pinned, ok := p.pins[model]
+	p.lock.RUnlock()
+	if !ok || pinned == model {
+		return nil
+	}
+
+	request.SetBodyField(modelField, pinned)
+	request.SetHeader(PinnedFromHeader, model)
+	request.SetHeader(PinnedToHeader, pinned)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("pinned model alias to a specific version", "from", model, "to", pinned)
+
+	return nil
+}
+
+// Close stops reloading the pins file on SIGHUP.
+func (p *ModelVersionPinnerPlugin) Close() error {
+	if p.stopReload != nil {
+		p.stopReload()
+	}
+	return nil
+}
+
+// reload reads the pins from the pins file and replaces the current pins.
+func (p *ModelVersionPinnerPlugin) reload() error {
+	raw, err := os.ReadFile(p.pinsFile)
+	if err != nil {
+		return fmt.Errorf("failed to read pins file '%s' - %w", p.pinsFile, err)
+	}
+	var config ModelVersionPinnerConfig
+	if err := json.Unmarshal(raw, &config); err != nil {
+		return fmt.Errorf("failed to parse pins file '%s' - %w", p.pinsFile, err)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.pins = config.Pins
+	return nil
+}
+
+// reloadOnSIGHUP reloads the pins file whenever the process receives SIGHUP, until the context is done or the
+// plugin is closed. If reloading fails, the previous pins are kept.
+func (p *ModelVersionPinnerPlugin) reloadOnSIGHUP(ctx context.Context) {
+	ctx, p.stopReload = context.WithCancel(ctx)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(signals)
+		logger := log.FromContext(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signals:
+				if err := p.reload(); err != nil {
+					logger.Error(err, "Failed to reload model version pins, keeping the previous pins")
+					continue
+				}
+				logger.Info("Reloaded model version pins", "file", p.pinsFile)
+			}
+		}
+	}()
+}
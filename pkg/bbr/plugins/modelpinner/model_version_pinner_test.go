@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelpinner
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+// fakeHandle only provides a context, which is all this plugin needs.
+type fakeHandle struct {
+	ctx context.Context
+}
+
+func (h *fakeHandle) Context() context.Context                { return h.ctx }
+func (h *fakeHandle) ClientReader() client.Reader             { return nil }
+func (h *fakeHandle) ReconcilerBuilder() *ctrlbuilder.Builder { return nil }
+
+func writePins(t *testing.T, path string, pins string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(pins), 0o600); err != nil {
+		t.Fatalf("failed to write pins file: %v", err)
+	}
+}
+
+func TestModelVersionPinnerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"pins":{"gpt-4-latest":"gpt-4-0613"}}`),
+		},
+		{
+			name:      "missing pins",
+			rawParams: json.RawMessage(`{}`),
+			wantErr:   true,
+		},
+		{
+			name:      "missing pins file",
+			rawParams: json.RawMessage(`{"pins_file":"/does/not/exist.json"}`),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ModelVersionPinnerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestModelVersionPinnerPlugin_ProcessRequest(t *testing.T) {
+	p, err := NewModelVersionPinnerPlugin(ModelVersionPinnerConfig{Pins: map[string]string{
+		"gpt-4-latest":  "gpt-4-0613",
+		"gpt-3.5-turbo": "gpt-3.5-turbo-0125",
+	}})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		model     string
+		wantModel string
+		wantPin   bool
+	}{
+		{name: "pinned alias", model: "gpt-4-latest", wantModel: "gpt-4-0613", wantPin: true},
+		{name: "another pinned alias", model: "gpt-3.5-turbo", wantModel: "gpt-3.5-turbo-0125", wantPin: true},
+		{name: "not pinned", model: "llama-3-8b", wantModel: "llama-3-8b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body[modelField] = tt.model
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Body[modelField]; got != tt.wantModel {
+				t.Errorf("Body[%q] = %q, want %q", modelField, got, tt.wantModel)
+			}
+			if !tt.wantPin {
+				if _, ok := request.Headers[PinnedFromHeader]; ok {
+					t.Errorf("expected no %q header", PinnedFromHeader)
+				}
+				return
+			}
+			if got := request.Headers[PinnedFromHeader]; got != tt.model {
+				t.Errorf("Headers[%q] = %q, want %q", PinnedFromHeader, got, tt.model)
+			}
+			if got := request.Headers[PinnedToHeader]; got != tt.wantModel {
+				t.Errorf("Headers[%q] = %q, want %q", PinnedToHeader, got, tt.wantModel)
+			}
+		})
+	}
+}
+
+func TestModelVersionPinnerPlugin_ReloadOnSIGHUP(t *testing.T) {
+	pinsFile := filepath.Join(t.TempDir(), "pins.json")
+	writePins(t, pinsFile, `{"pins":{"gpt-4-latest":"gpt-4-0613"}}`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p, err := ModelVersionPinnerPluginFactory("my-plugin", json.RawMessage(`{"pins_file":"`+pinsFile+`"}`), &fakeHandle{ctx: ctx})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	pinner := p.(*ModelVersionPinnerPlugin)
+
+	pinnedModel := func() any {
+		request := framework.NewInferenceRequest()
+		request.Body[modelField] = "gpt-4-latest"
+		if err := pinner.ProcessRequest(context.Background(), nil, request); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return request.Body[modelField]
+	}
+	if got := pinnedModel(); got != "gpt-4-0613" {
+		t.Fatalf("Body[%q] = %q, want %q", modelField, got, "gpt-4-0613")
+	}
+
+	writePins(t, pinsFile, `{"pins":{"gpt-4-latest":"gpt-4-1106"}}`)
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatalf("failed to send SIGHUP: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for pinnedModel() != "gpt-4-1106" {
+		if time.Now().After(deadline) {
+			t.Fatal("pins were not reloaded after SIGHUP")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelscheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	TimeBasedModelSchedulerPluginType = "time-based-model-scheduler"
+	ScheduledFromHeader               = "X-BBR-Model-Scheduled-From"
+	modelField                        = "model"
+
+	clockLayout = "15:04"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &TimeBasedModelSchedulerPlugin{}
+
+// TimeBasedModelSchedulerConfig defines the JSON configuration structure for the plugin.
+type TimeBasedModelSchedulerConfig struct {
+	// Windows are the time windows during which models are substituted. The first window matching
+	// the current time applies.
+	Windows []WindowConfig `json:"windows"`
+}
+
+// WindowConfig defines a daily time window and the model substitutions applied during it.
+type WindowConfig struct {
+	// Start is the time of day the window starts at, in the "HH:MM" format.
+	Start string `json:"start"`
+	// End is the time of day the window ends at (exclusive), in the "HH:MM" format. An end before
+	// the start denotes a window spanning midnight, e.g. "22:00" to "06:00".
+	End string `json:"end"`
+	// TZ is the IANA time zone of the start and end, e.g. "America/New_York". Defaults to UTC.
+	TZ string `json:"tz"`
+	// Substitutions maps a model to the model it is replaced with during the window, e.g. {"gpt-4":"gpt-3.5-turbo"}.
+	Substitutions map[string]string `json:"substitutions"`
+}
+
+// TimeBasedModelSchedulerPluginFactory defines the factory function for NewTimeBasedModelSchedulerPlugin.
+func TimeBasedModelSchedulerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config TimeBasedModelSchedulerConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", TimeBasedModelSchedulerPluginType, err)
+		}
+	}
+
+	plugin, err := NewTimeBasedModelSchedulerPlugin(config.Windows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", TimeBasedModelSchedulerPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewTimeBasedModelSchedulerPlugin initializes a new TimeBasedModelSchedulerPlugin and returns its pointer.
+func NewTimeBasedModelSchedulerPlugin(windowConfigs []WindowConfig) (*TimeBasedModelSchedulerPlugin, error) {
+	if len(windowConfigs) == 0 {
+		return nil, errors.New("windows are required in TimeBasedModelScheduler plugin")
+	}
+
+	windows := make([]window, 0, len(windowConfigs))
+	for i, config := range windowConfigs {
+		start, err := minuteOfDay(config.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start of window %d in TimeBasedModelScheduler plugin - %w", i, err)
+		}
+		end, err := minuteOfDay(config.End)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end of window %d in TimeBasedModelScheduler plugin - %w", i, err)
+		}
+		if start == end {
+			return nil, fmt.Errorf("window %d is empty in TimeBasedModelScheduler plugin", i)
+		}
+		location, err := time.LoadLocation(config.TZ) // an empty name is UTC
+		if err != nil {
+			return nil, fmt.Errorf("invalid tz of window %d in TimeBasedModelScheduler plugin - %w", i, err)
+		}
+		if len(config.Substitutions) == 0 {
+			return nil, fmt.Errorf("substitutions are required in window %d in TimeBasedModelScheduler plugin", i)
+		}
+		windows = append(windows, window{start: start, end: end, location: location, substitutions: config.Substitutions})
+	}
+
+	return &TimeBasedModelSchedulerPlugin{
+		typedName: plugin.TypedName{
+			Type: TimeBasedModelSchedulerPluginType,
+			Name: TimeBasedModelSchedulerPluginType,
+		},
+		windows: windows,
+		now:     time.Now,
+	}, nil
+}
+
+// minuteOfDay parses a time of day in the "HH:MM" format into minutes since midnight.
+func minuteOfDay(clock string) (int, error) {
+	t, err := time.Parse(clockLayout, clock)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// window is a parsed WindowConfig.
+type window struct {
+	start, end    int // minutes since midnight
+	location      *time.Location
+	substitutions map[string]string
+}
+
+// contains reports whether the time is in the window.
+func (w window) contains(t time.Time) bool {
+	local := t.In(w.location)
+	minute := local.Hour()*60 + local.Minute()
+	if w.start < w.end {
+		return minute >= w.start && minute < w.end
+	}
+	return minute >= w.start || minute < w.end // the window spans midnight
+}
+
+// TimeBasedModelSchedulerPlugin substitutes models during daily time windows, e.g. to route requests
+// to cheaper models during peak hours. The original model is set in the X-BBR-Model-Scheduled-From header.
+type TimeBasedModelSchedulerPlugin struct {
+	typedName plugin.TypedName
+	windows   []window
+	now       func() time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *TimeBasedModelSchedulerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *TimeBasedModelSchedulerPlugin) WithName(name string) *TimeBasedModelSchedulerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rewrites the model field if the first window matching the current time substitutes the model.
+func (p *TimeBasedModelSchedulerPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, ok := request.Body[modelField].(string)
+	if !ok || model == "" {
+		return nil
+	}
+
+	now := p.now()
+	for _, w := range p.windows {
+		if !w.contains(now) {
+			continue
+		}
+		substitute, ok := w.substitutions[model]
+		if !ok || substitute == model {
+			return nil
+		}
+		request.SetBodyField(modelField, substitute)
+		request.SetHeader(ScheduledFromHeader, model)
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("substituted the model for the current time window", "from", model, "to", substitute)
+		return nil
+	}
+	return nil
+}
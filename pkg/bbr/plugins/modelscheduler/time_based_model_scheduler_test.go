@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelscheduler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestTimeBasedModelSchedulerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"windows":[{"start":"09:00","end":"17:00","tz":"America/New_York","substitutions":{"gpt-4":"gpt-3.5-turbo"}}]}`)},
+		{name: "default time zone", rawParams: json.RawMessage(`{"windows":[{"start":"22:00","end":"06:00","substitutions":{"gpt-4":"gpt-3.5-turbo"}}]}`)},
+		{name: "missing windows", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "invalid start", rawParams: json.RawMessage(`{"windows":[{"start":"9am","end":"17:00","substitutions":{"gpt-4":"gpt-3.5-turbo"}}]}`), wantErr: true},
+		{name: "empty window", rawParams: json.RawMessage(`{"windows":[{"start":"09:00","end":"09:00","substitutions":{"gpt-4":"gpt-3.5-turbo"}}]}`), wantErr: true},
+		{name: "unknown time zone", rawParams: json.RawMessage(`{"windows":[{"start":"09:00","end":"17:00","tz":"Mars/Olympus","substitutions":{"gpt-4":"gpt-3.5-turbo"}}]}`), wantErr: true},
+		{name: "missing substitutions", rawParams: json.RawMessage(`{"windows":[{"start":"09:00","end":"17:00"}]}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := TimeBasedModelSchedulerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestTimeBasedModelSchedulerPlugin(t *testing.T) {
+	p, err := NewTimeBasedModelSchedulerPlugin([]WindowConfig{
+		{Start: "09:00", End: "17:00", TZ: "America/New_York", Substitutions: map[string]string{"gpt-4": "gpt-3.5-turbo"}},
+		{Start: "22:00", End: "06:00", Substitutions: map[string]string{"gpt-4": "gpt-4-batch", "llama-3": "llama-3-8b"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		now       time.Time
+		model     string
+		wantModel string
+	}{
+		{name: "peak hours in New York", now: time.Date(2026, 1, 15, 15, 0, 0, 0, time.UTC), model: "gpt-4", wantModel: "gpt-3.5-turbo"},
+		{name: "before peak hours in New York", now: time.Date(2026, 1, 15, 13, 59, 0, 0, time.UTC), model: "gpt-4", wantModel: "gpt-4"},
+		{name: "end of peak hours is exclusive", now: time.Date(2026, 1, 15, 22, 0, 0, 0, time.UTC), model: "llama-3", wantModel: "llama-3-8b"},
+		{name: "window spanning midnight", now: time.Date(2026, 1, 16, 3, 0, 0, 0, time.UTC), model: "gpt-4", wantModel: "gpt-4-batch"},
+		{name: "model not substituted in the first matching window", now: time.Date(2026, 1, 15, 15, 0, 0, 0, time.UTC), model: "llama-3", wantModel: "llama-3"},
+		{name: "outside the windows", now: time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC), model: "llama-3", wantModel: "llama-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p.now = func() time.Time { return tt.now }
+			request := framework.NewInferenceRequest()
+			request.Body["model"] = tt.model
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Body["model"]; got != tt.wantModel {
+				t.Errorf("model = %v, want %v", got, tt.wantModel)
+			}
+			wantScheduledFrom := ""
+			if tt.wantModel != tt.model {
+				wantScheduledFrom = tt.model
+			}
+			if got := request.GetHeader(ScheduledFromHeader); got != wantScheduledFrom {
+				t.Errorf("%s = %q, want %q", ScheduledFromHeader, got, wantScheduledFrom)
+			}
+		})
+	}
+}
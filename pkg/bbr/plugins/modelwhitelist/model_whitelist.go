@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelwhitelist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ModelWhitelistPluginType = "model-whitelist"
+	modelField               = "model"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ModelWhitelistPlugin{}
+
+// ModelWhitelistConfig defines the JSON configuration structure for the plugin.
+type ModelWhitelistConfig struct {
+	// Patterns are the regular expressions of the allowed models, e.g. ["gpt-4.*","llama-[0-9]+"].
+	// A model is allowed if it fully matches at least one of the patterns.
+	Patterns []string `json:"patterns"`
+}
+
+// ModelWhitelistPluginFactory defines the factory function for NewModelWhitelistPlugin.
+func ModelWhitelistPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config ModelWhitelistConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ModelWhitelistPluginType, err)
+		}
+	}
+
+	plugin, err := NewModelWhitelistPlugin(config.Patterns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ModelWhitelistPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewModelWhitelistPlugin initializes a new ModelWhitelistPlugin and returns its pointer.
+func NewModelWhitelistPlugin(patterns []string) (*ModelWhitelistPlugin, error) {
+	if len(patterns) == 0 {
+		return nil, errors.New("patterns are required in ModelWhitelist plugin")
+	}
+
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		// anchor the pattern, so it has to match the whole model name
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s' in ModelWhitelist plugin - %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+
+	return &ModelWhitelistPlugin{
+		typedName: plugin.TypedName{
+			Type: ModelWhitelistPluginType,
+			Name: ModelWhitelistPluginType,
+		},
+		patterns: compiled,
+	}, nil
+}
+
+// ModelWhitelistPlugin rejects requests with HTTP 400 when the requested model does not match any
+// of the whitelisted patterns. Unlike validating against the served models, it does not depend on
+// any state being populated, which makes it convenient during development.
+type ModelWhitelistPlugin struct {
+	typedName plugin.TypedName
+	patterns  []*regexp.Regexp
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ModelWhitelistPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ModelWhitelistPlugin) WithName(name string) *ModelWhitelistPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if its model is not whitelisted.
+func (p *ModelWhitelistPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, ok := request.Body[modelField].(string)
+	if !ok || model == "" {
+		return nil
+	}
+
+	for _, pattern := range p.patterns {
+		if pattern.MatchString(model) {
+			return nil
+		}
+	}
+
+	metrics.RecordModelWhitelistRejection(model)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected request to a model that is not whitelisted", "model", model)
+	return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("model '%s' is not allowed", model)}
+}
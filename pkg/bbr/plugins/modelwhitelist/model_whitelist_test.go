@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelwhitelist
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestModelWhitelistPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"patterns":["gpt-4.*","llama-[0-9]+"]}`)},
+		{name: "missing patterns", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "invalid pattern", rawParams: json.RawMessage(`{"patterns":["llama-[0-9"]}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ModelWhitelistPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestModelWhitelistPlugin_ProcessRequest(t *testing.T) {
+	p, err := NewModelWhitelistPlugin([]string{"gpt-4.*", "claude-.*", "llama-[0-9]+"})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		model    string
+		wantCode string
+	}{
+		{model: "gpt-4"},
+		{model: "gpt-4-turbo"},
+		{model: "claude-instant"},
+		{model: "llama-3"},
+		{model: "llama-3-8b", wantCode: errcommon.BadRequest},
+		{model: "my-gpt-4", wantCode: errcommon.BadRequest},
+		{model: "mistral", wantCode: errcommon.BadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body[modelField] = tt.model
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+				t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallelcompletions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ParallelCompletionsLimitPluginType = "parallel-completions-limit"
+	modelField                         = "model"
+	nField                             = "n"
+	streamField                        = "stream"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ParallelCompletionsLimitPlugin{}
+
+// ParallelCompletionsLimitConfig defines the JSON configuration structure for the plugin.
+type ParallelCompletionsLimitConfig struct {
+	// MaxN maps a model name to the maximal number of completions it returns for a request, e.g. {"llama-3-70b": 4}.
+	MaxN map[string]int `json:"max_n"`
+	// DefaultMaxN is the maximal number of completions of models missing from max_n. Defaults to 0, no limit.
+	DefaultMaxN int `json:"default_max_n"`
+}
+
+// ParallelCompletionsLimitPluginFactory defines the factory function for NewParallelCompletionsLimitPlugin.
+func ParallelCompletionsLimitPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ParallelCompletionsLimitConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ParallelCompletionsLimitPluginType, err)
+		}
+	}
+
+	plugin, err := NewParallelCompletionsLimitPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ParallelCompletionsLimitPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewParallelCompletionsLimitPlugin initializes a new ParallelCompletionsLimitPlugin and returns its pointer.
+func NewParallelCompletionsLimitPlugin(config ParallelCompletionsLimitConfig) (*ParallelCompletionsLimitPlugin, error) {
+	for model, maxN := range config.MaxN {
+		if maxN <= 0 {
+			return nil, fmt.Errorf("max_n of model %q must be positive in ParallelCompletionsLimit plugin", model)
+		}
+	}
+	if config.DefaultMaxN < 0 {
+		return nil, errors.New("default_max_n must not be negative in ParallelCompletionsLimit plugin")
+	}
+
+	return &ParallelCompletionsLimitPlugin{
+		typedName: plugin.TypedName{
+			Type: ParallelCompletionsLimitPluginType,
+			Name: ParallelCompletionsLimitPluginType,
+		},
+		maxN:        config.MaxN,
+		defaultMaxN: config.DefaultMaxN,
+	}, nil
+}
+
+// ParallelCompletionsLimitPlugin rejects requests with HTTP 400 when their n parameter, the number of
+// completions to return, exceeds the limit of the requested model, since some models cap the number of
+// completions they generate simultaneously. Streamed requests are additionally limited to a single
+// completion, since most backends do not support streaming multiple completions.
+type ParallelCompletionsLimitPlugin struct {
+	typedName   plugin.TypedName
+	maxN        map[string]int
+	defaultMaxN int
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ParallelCompletionsLimitPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ParallelCompletionsLimitPlugin) WithName(name string) *ParallelCompletionsLimitPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if its n parameter exceeds the limit of its model.
+func (p *ParallelCompletionsLimitPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	n := 1
+	if rawN, ok := request.Body[nField]; ok && rawN != nil {
+		value, ok := rawN.(float64)
+		if !ok || value != math.Trunc(value) || value < 1 {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: "n must be a positive integer"}
+		}
+		n = int(value)
+	}
+
+	if stream, _ := request.Body[streamField].(bool); stream && n > 1 {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "n must be 1 for streamed requests"}
+	}
+
+	model, _ := request.Body[modelField].(string)
+	maxN, ok := p.maxN[model]
+	if !ok {
+		maxN = p.defaultMaxN
+	}
+	if maxN > 0 && n > maxN {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected a request exceeding the parallel completions limit", "model", model, "n", n, "maxN", maxN)
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("n must be at most %d for model %s", maxN, model)}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parallelcompletions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestParallelCompletionsLimitPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "full config", rawParams: json.RawMessage(`{"max_n":{"llama-3-70b":4},"default_max_n":8}`)},
+		{name: "non-positive max n", rawParams: json.RawMessage(`{"max_n":{"llama-3-70b":0}}`), wantErr: true},
+		{name: "negative default max n", rawParams: json.RawMessage(`{"default_max_n":-1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParallelCompletionsLimitPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestParallelCompletionsLimitPlugin(t *testing.T) {
+	p, err := NewParallelCompletionsLimitPlugin(ParallelCompletionsLimitConfig{
+		MaxN:        map[string]int{"llama-3-70b": 4},
+		DefaultMaxN: 8,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		body    map[string]any
+		wantMsg string
+	}{
+		{name: "default n", body: map[string]any{"model": "llama-3-70b"}},
+		{name: "n within the model limit", body: map[string]any{"model": "llama-3-70b", "n": 4.0}},
+		{name: "n above the model limit", body: map[string]any{"model": "llama-3-70b", "n": 5.0}, wantMsg: "n must be at most 4 for model llama-3-70b"},
+		{name: "n within the default limit", body: map[string]any{"model": "other", "n": 8.0}},
+		{name: "n above the default limit", body: map[string]any{"model": "other", "n": 9.0}, wantMsg: "n must be at most 8 for model other"},
+		{name: "streamed single completion", body: map[string]any{"model": "llama-3-70b", "n": 1.0, "stream": true}},
+		{name: "streamed multiple completions", body: map[string]any{"model": "llama-3-70b", "n": 2.0, "stream": true}, wantMsg: "n must be 1 for streamed requests"},
+		{name: "fractional n", body: map[string]any{"model": "llama-3-70b", "n": 1.5}, wantMsg: "n must be a positive integer"},
+		{name: "zero n", body: map[string]any{"model": "llama-3-70b", "n": 0.0}, wantMsg: "n must be a positive integer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != errcommon.BadRequest {
+				t.Errorf("CanonicalCode = %q, want %q", got, errcommon.BadRequest)
+			}
+			var e errcommon.Error
+			if !errors.As(err, &e) || e.Msg != tt.wantMsg {
+				t.Errorf("error = %v, want message %q", err, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestParallelCompletionsLimitPluginWithoutDefault(t *testing.T) {
+	p, err := NewParallelCompletionsLimitPlugin(ParallelCompletionsLimitConfig{MaxN: map[string]int{"llama-3-70b": 4}})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	request := framework.NewInferenceRequest()
+	request.Body = map[string]any{"model": "other", "n": 128.0}
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Errorf("unexpected error for a model without a limit: %v", err)
+	}
+}
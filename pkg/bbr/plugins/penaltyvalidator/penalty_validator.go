@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package penaltyvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	PenaltyValidatorPluginType = "penalty-validator"
+
+	// ClampedHeader lists the comma separated penalty fields that were clamped into the valid range.
+	ClampedHeader = "X-BBR-Penalty-Clamped"
+
+	minPenalty = -2.0
+	maxPenalty = 2.0
+)
+
+// penaltyFields are the validated body fields, in the order they are reported.
+var penaltyFields = []string{"frequency_penalty", "presence_penalty"}
+
+// compile-time type validation
+var _ framework.RequestProcessor = &PenaltyValidatorPlugin{}
+
+// PenaltyValidatorConfig defines the JSON configuration structure for the plugin.
+type PenaltyValidatorConfig struct {
+	// Clamp clamps out of range penalties to the nearest valid value, instead of rejecting the request.
+	Clamp bool `json:"clamp"`
+}
+
+// PenaltyValidatorPluginFactory defines the factory function for NewPenaltyValidatorPlugin.
+func PenaltyValidatorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := PenaltyValidatorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", PenaltyValidatorPluginType, err)
+		}
+	}
+
+	return NewPenaltyValidatorPlugin(config.Clamp).WithName(name), nil
+}
+
+// NewPenaltyValidatorPlugin initializes a new PenaltyValidatorPlugin and returns its pointer.
+func NewPenaltyValidatorPlugin(clamp bool) *PenaltyValidatorPlugin {
+	return &PenaltyValidatorPlugin{
+		typedName: plugin.TypedName{
+			Type: PenaltyValidatorPluginType,
+			Name: PenaltyValidatorPluginType,
+		},
+		clamp: clamp,
+	}
+}
+
+// PenaltyValidatorPlugin validates that the frequency_penalty and presence_penalty parameters are in the
+// range [-2.0, 2.0] required by the OpenAI API, since clients sometimes send values like 5.0.
+// Out of range penalties are rejected with HTTP 400 naming the offending fields, or clamped in clamp mode.
+type PenaltyValidatorPlugin struct {
+	typedName plugin.TypedName
+	clamp     bool
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *PenaltyValidatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *PenaltyValidatorPlugin) WithName(name string) *PenaltyValidatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the penalties of the request, and clamps them in clamp mode.
+func (p *PenaltyValidatorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	outOfRange := []string{}
+	for _, field := range penaltyFields {
+		rawPenalty, ok := request.Body[field]
+		if !ok || rawPenalty == nil {
+			continue
+		}
+		penalty, ok := rawPenalty.(float64)
+		if !ok {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: field + " must be a number"}
+		}
+		if penalty < minPenalty || penalty > maxPenalty {
+			outOfRange = append(outOfRange, field)
+		}
+	}
+	if len(outOfRange) == 0 {
+		return nil
+	}
+
+	if !p.clamp {
+		return errcommon.Error{
+			Code: errcommon.BadRequest,
+			Msg:  fmt.Sprintf("%s must be in the range [%.1f, %.1f]", strings.Join(outOfRange, ", "), minPenalty, maxPenalty),
+		}
+	}
+
+	for _, field := range outOfRange {
+		request.SetBodyField(field, min(max(request.Body[field].(float64), minPenalty), maxPenalty))
+	}
+	request.SetHeader(ClampedHeader, strings.Join(outOfRange, ","))
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("clamped the request penalties", "fields", outOfRange)
+	return nil
+}
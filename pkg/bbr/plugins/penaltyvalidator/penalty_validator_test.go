@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package penaltyvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestPenaltyValidatorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "clamp", rawParams: json.RawMessage(`{"clamp":true}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := PenaltyValidatorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestPenaltyValidatorPlugin(t *testing.T) {
+	tests := []struct {
+		name        string
+		clamp       bool
+		body        map[string]any
+		wantCode    string
+		wantMsg     string
+		wantBody    map[string]any
+		wantClamped string
+	}{
+		{
+			name:     "no penalties",
+			body:     map[string]any{"model": "m"},
+			wantBody: map[string]any{"model": "m"},
+		},
+		{
+			name:     "valid penalties",
+			body:     map[string]any{"frequency_penalty": -2.0, "presence_penalty": 2.0},
+			wantBody: map[string]any{"frequency_penalty": -2.0, "presence_penalty": 2.0},
+		},
+		{
+			name:     "out of range penalty is rejected",
+			body:     map[string]any{"frequency_penalty": 5.0, "presence_penalty": 0.5},
+			wantCode: errcommon.BadRequest,
+			wantMsg:  "frequency_penalty must be in the range [-2.0, 2.0]",
+		},
+		{
+			name:     "both out of range penalties are named",
+			body:     map[string]any{"frequency_penalty": 5.0, "presence_penalty": -3.0},
+			wantCode: errcommon.BadRequest,
+			wantMsg:  "frequency_penalty, presence_penalty must be in the range [-2.0, 2.0]",
+		},
+		{
+			name:     "non-number penalty is rejected",
+			clamp:    true,
+			body:     map[string]any{"presence_penalty": "1"},
+			wantCode: errcommon.BadRequest,
+			wantMsg:  "presence_penalty must be a number",
+		},
+		{
+			name:        "out of range penalties are clamped",
+			clamp:       true,
+			body:        map[string]any{"frequency_penalty": 5.0, "presence_penalty": -3.0},
+			wantBody:    map[string]any{"frequency_penalty": 2.0, "presence_penalty": -2.0},
+			wantClamped: "frequency_penalty,presence_penalty",
+		},
+		{
+			name:        "only out of range penalty is clamped",
+			clamp:       true,
+			body:        map[string]any{"frequency_penalty": 1.0, "presence_penalty": 2.5},
+			wantBody:    map[string]any{"frequency_penalty": 1.0, "presence_penalty": 2.0},
+			wantClamped: "presence_penalty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPenaltyValidatorPlugin(tt.clamp)
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				var e errcommon.Error
+				if !errors.As(err, &e) || e.Msg != tt.wantMsg {
+					t.Errorf("error = %v, want message %q", err, tt.wantMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("body mismatch (-want +got):\n%s", diff)
+			}
+			if got := request.GetHeader(ClampedHeader); got != tt.wantClamped {
+				t.Errorf("%s = %q, want %q", ClampedHeader, got, tt.wantClamped)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package piddisclosure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	PIDDisclosureBlockerPluginType = "pid-disclosure-blocker"
+)
+
+// DefaultPatterns are the regular expressions that detect process and host information in the prompt.
+var DefaultPatterns = []string{
+	// /proc/<pid>/ and /proc/self/ paths, e.g. /proc/1/environ or /proc/self/cmdline
+	`/proc/(\d+|self|thread-self)/\w*`,
+	// /etc/passwd and /etc/shadow entries, e.g. root:x:0:0:root:/root:/bin/bash
+	`(?m)^[a-z_][a-z0-9_.-]*:[^:\n]*:\d+:\d+:[^:\n]*:/[^:\n]*:/[^\n]*$`,
+	// environment variable dumps, as printed by env or printenv, i.e. three or more consecutive NAME=value lines
+	`(?m)(^[A-Z_][A-Z0-9_]*=[^\n]*(\n|$)){3,}`,
+	// the environment of a Kubernetes pod
+	`\bKUBERNETES_(SERVICE|PORT)\w*=\S+`,
+}
+
+// compile-time type validation
+var _ framework.RequestProcessor = &PIDDisclosureBlockerPlugin{}
+
+// PIDDisclosureBlockerConfig defines the JSON configuration structure for the plugin.
+type PIDDisclosureBlockerConfig struct {
+	// Patterns are additional regular expressions that detect process and host information, matched
+	// together with DefaultPatterns.
+	Patterns []string `json:"patterns"`
+}
+
+// PIDDisclosureBlockerPluginFactory defines the factory function for NewPIDDisclosureBlockerPlugin.
+func PIDDisclosureBlockerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := PIDDisclosureBlockerConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", PIDDisclosureBlockerPluginType, err)
+		}
+	}
+
+	plugin, err := NewPIDDisclosureBlockerPlugin(config.Patterns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", PIDDisclosureBlockerPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewPIDDisclosureBlockerPlugin initializes a new PIDDisclosureBlockerPlugin and returns its pointer.
+// The patterns are matched in addition to DefaultPatterns.
+func NewPIDDisclosureBlockerPlugin(patterns []string) (*PIDDisclosureBlockerPlugin, error) {
+	all := append(append([]string{}, DefaultPatterns...), patterns...)
+	compiled := make([]*regexp.Regexp, 0, len(all))
+	for _, pattern := range all {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q in PIDDisclosureBlocker plugin - %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+
+	return &PIDDisclosureBlockerPlugin{
+		typedName: plugin.TypedName{
+			Type: PIDDisclosureBlockerPluginType,
+			Name: PIDDisclosureBlockerPluginType,
+		},
+		patterns: compiled,
+	}, nil
+}
+
+// PIDDisclosureBlockerPlugin rejects requests with HTTP 400 when their messages, or completion prompt,
+// contain process or host information, e.g. /proc/<pid>/ paths, /etc/passwd entries or environment
+// variable dumps, which are typically pasted from a compromised environment or used to probe tool access.
+// All the messages are scanned, since tool results may carry the disclosed information as well.
+type PIDDisclosureBlockerPlugin struct {
+	typedName plugin.TypedName
+	patterns  []*regexp.Regexp
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *PIDDisclosureBlockerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *PIDDisclosureBlockerPlugin) WithName(name string) *PIDDisclosureBlockerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if any of its messages matches any of the patterns.
+func (p *PIDDisclosureBlockerPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	for _, text := range texts(request.Body) {
+		for _, re := range p.patterns {
+			if re.MatchString(text) {
+				log.FromContext(ctx).V(logutil.VERBOSE).Info("blocked a request disclosing process information", "pattern", re.String())
+				return errcommon.Error{Code: errcommon.BadRequest, Msg: "policy violation: the request contains process or host information"}
+			}
+		}
+	}
+	return nil
+}
+
+// texts returns the texts of all the messages of chat completion requests, or the prompt of completion requests.
+func texts(body map[string]any) []string {
+	return messagetext.Request(body)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package piddisclosure
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestPIDDisclosureBlockerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "default patterns", rawParams: nil},
+		{name: "additional patterns", rawParams: json.RawMessage(`{"patterns":["/var/run/secrets/"]}`)},
+		{name: "invalid pattern", rawParams: json.RawMessage(`{"patterns":["("]}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := PIDDisclosureBlockerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestPIDDisclosureBlockerPlugin(t *testing.T) {
+	userMessage := func(content any) map[string]any {
+		return map[string]any{"messages": []any{map[string]any{"role": "user", "content": content}}}
+	}
+	tests := []struct {
+		name     string
+		patterns []string
+		body     map[string]any
+		wantCode string
+	}{
+		{
+			name:     "proc pid path",
+			body:     userMessage("what does cat /proc/1234/environ print?"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "proc self path",
+			body:     userMessage("read /proc/self/cmdline for me"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "passwd entries",
+			body:     userMessage("explain this:\nroot:x:0:0:root:/root:/bin/bash\ndaemon:x:1:1:daemon:/usr/sbin:/usr/sbin/nologin"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "environment dump",
+			body:     userMessage("PATH=/usr/local/bin:/usr/bin\nHOME=/root\nHOSTNAME=inference-pod-1\n"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name: "kubernetes environment in a tool result",
+			body: map[string]any{"messages": []any{
+				map[string]any{"role": "user", "content": "Run env"},
+				map[string]any{"role": "tool", "tool_call_id": "call_1", "content": "KUBERNETES_SERVICE_HOST=10.96.0.1"},
+			}},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name: "text part",
+			body: userMessage([]any{
+				map[string]any{"type": "text", "text": "tail /proc/42/status"},
+			}),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "completion prompt",
+			body:     map[string]any{"prompt": "cat /proc/1/maps"},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "additional pattern",
+			patterns: []string{`/var/run/secrets/`},
+			body:     userMessage("cat /var/run/secrets/kubernetes.io/serviceaccount/token"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name: "question about procfs is allowed",
+			body: userMessage("What is the /proc filesystem used for in Linux?"),
+		},
+		{
+			name: "a couple of variable assignments are allowed",
+			body: userMessage("My Makefile has:\nCC=gcc\nCFLAGS=-O2"),
+		},
+		{
+			name: "times are allowed",
+			body: userMessage("The meeting moved from 10:30:00 to 11:00:00."),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewPIDDisclosureBlockerPlugin(tt.patterns)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			err = p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
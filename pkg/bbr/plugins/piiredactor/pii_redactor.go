@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package piiredactor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	PIIRedactorPluginType = "pii-redactor"
+)
+
+// DefaultPatterns are the patterns redacted when none are configured, in the order they are applied.
+// Credit card numbers and SSNs are redacted before phone numbers, and phone numbers before ZIP codes,
+// since the later patterns match parts of the earlier ones.
+var DefaultPatterns = []RedactionPattern{
+	{Name: "EMAIL", Pattern: `[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`},
+	{Name: "CREDIT_CARD", Pattern: `\b(?:\d[ -]?){12,18}\d\b`},
+	{Name: "SSN", Pattern: `\b\d{3}-\d{2}-\d{4}\b`},
+	{Name: "PHONE", Pattern: `(?:\+\d{1,3}[ .-]?)?\(?\b\d{3}\)?[ .-]?\d{3}[ .-]\d{4}\b`},
+	{Name: "ZIP", Pattern: `\b\d{5}(?:-\d{4})?\b`},
+}
+
+// stringLiteral matches a JSON string literal, including its quotes and escape sequences.
+var stringLiteral = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &PIIRedactorPlugin{}
+
+// RedactionPattern is a pattern of sensitive data, and the name of its redaction token.
+type RedactionPattern struct {
+	// Name is the name of the redaction token, e.g. EMAIL for [EMAIL].
+	Name string `json:"name"`
+	// Pattern is the regular expression matching the sensitive data.
+	Pattern string `json:"pattern"`
+}
+
+// PIIRedactorConfig defines the JSON configuration structure for the plugin.
+type PIIRedactorConfig struct {
+	// Patterns replace the default patterns when set, and are applied in order.
+	Patterns []RedactionPattern `json:"patterns"`
+}
+
+// PIIRedactorPluginFactory defines the factory function for NewPIIRedactorPlugin.
+func PIIRedactorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config PIIRedactorConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", PIIRedactorPluginType, err)
+		}
+	}
+
+	if config.Patterns == nil {
+		config.Patterns = DefaultPatterns
+	}
+
+	plugin, err := NewPIIRedactorPlugin(config.Patterns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", PIIRedactorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewPIIRedactorPlugin initializes a new PIIRedactorPlugin and returns its pointer.
+func NewPIIRedactorPlugin(patterns []RedactionPattern) (*PIIRedactorPlugin, error) {
+	if len(patterns) == 0 {
+		return nil, errors.New("patterns must not be empty in PIIRedactor plugin")
+	}
+
+	redactors := make([]redactor, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern.Name == "" {
+			return nil, errors.New("pattern name is required in PIIRedactor plugin")
+		}
+		re, err := regexp.Compile(pattern.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q of %s in PIIRedactor plugin - %w", pattern.Pattern, pattern.Name, err)
+		}
+		redactors = append(redactors, redactor{token: "[" + pattern.Name + "]", pattern: re})
+	}
+
+	return &PIIRedactorPlugin{
+		typedName: plugin.TypedName{
+			Type: PIIRedactorPluginType,
+			Name: PIIRedactorPluginType,
+		},
+		redactors: redactors,
+	}, nil
+}
+
+// redactor replaces the matches of a pattern with its redaction token.
+type redactor struct {
+	token   string
+	pattern *regexp.Regexp
+}
+
+// PIIRedactorPlugin replaces sensitive data in the request body, such as e-mail addresses, phone numbers,
+// SSNs, credit card numbers and US ZIP codes, with redaction tokens like [EMAIL]. It redacts the raw body
+// bytes, within the JSON strings only, so that numbers like max_tokens are never redacted.
+type PIIRedactorPlugin struct {
+	typedName plugin.TypedName
+	redactors []redactor
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *PIIRedactorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *PIIRedactorPlugin) WithName(name string) *PIIRedactorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest redacts the raw request body, and replaces the request body if anything was redacted.
+func (p *PIIRedactorPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+	if request.IsBatchRequest() || request.IsMultipartRequest() {
+		return nil
+	}
+
+	bodyBytes, err := framework.ReadCycleStateKey[[]byte](cycleState, framework.RequestBodyBytesKey)
+	if err != nil {
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("raw request body is not available, skipping PII redaction")
+		return nil
+	}
+
+	redactedBytes, count, err := p.Redact(ctx, bodyBytes)
+	if err != nil {
+		return fmt.Errorf("failed to redact the request body - %w", err)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(redactedBytes, &body); err != nil {
+		return fmt.Errorf("failed to parse the redacted request body - %w", err)
+	}
+	request.SetBody(body)
+	cycleState.Write(framework.RequestBodyBytesKey, redactedBytes)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("redacted sensitive data from the request body", "count", count)
+	return nil
+}
+
+// Redact replaces the sensitive data in the JSON strings of the body with redaction tokens, and returns the
+// redacted body with the number of redactions. The rest of the body is returned as is.
+func (p *PIIRedactorPlugin) Redact(_ context.Context, bodyBytes []byte) ([]byte, int, error) {
+	count := 0
+	var err error
+	redactedBytes := stringLiteral.ReplaceAllFunc(bodyBytes, func(literal []byte) []byte {
+		if err != nil {
+			return literal
+		}
+		var text string
+		if err = json.Unmarshal(literal, &text); err != nil {
+			return literal
+		}
+		redacted, n := p.redact(text)
+		if n == 0 {
+			return literal
+		}
+		count += n
+		var redactedLiteral []byte
+		if redactedLiteral, err = json.Marshal(redacted); err != nil {
+			return literal
+		}
+		return redactedLiteral
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return redactedBytes, count, nil
+}
+
+// redact replaces the matches of the patterns in the text, and returns the number of replacements.
+func (p *PIIRedactorPlugin) redact(text string) (string, int) {
+	count := 0
+	for _, r := range p.redactors {
+		text = r.pattern.ReplaceAllStringFunc(text, func(string) string {
+			count++
+			return r.token
+		})
+	}
+	return text, count
+}
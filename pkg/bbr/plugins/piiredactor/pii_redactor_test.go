@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package piiredactor
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestPIIRedactorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "default config",
+			rawParams: nil,
+		},
+		{
+			name:      "valid patterns",
+			rawParams: json.RawMessage(`{"patterns":[{"name":"IBAN","pattern":"\\b[A-Z]{2}\\d{2}[A-Z0-9]{11,30}\\b"}]}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "empty patterns",
+			rawParams: json.RawMessage(`{"patterns":[]}`),
+			wantErr:   true,
+		},
+		{
+			name:      "missing pattern name",
+			rawParams: json.RawMessage(`{"patterns":[{"pattern":"\\d+"}]}`),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid pattern",
+			rawParams: json.RawMessage(`{"patterns":[{"name":"BAD","pattern":"("}]}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := PIIRedactorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		want      string
+		wantCount int
+	}{
+		{
+			name:      "email and phone",
+			body:      `{"messages":[{"role":"user","content":"mail jane.doe@example.com or call 555-123-4567"}]}`,
+			want:      `{"messages":[{"role":"user","content":"mail [EMAIL] or call [PHONE]"}]}`,
+			wantCount: 2,
+		},
+		{
+			name:      "ssn, credit card and zip code",
+			body:      `{"prompt":"ssn 123-45-6789, card 4111 1111 1111 1111, zip 94105"}`,
+			want:      `{"prompt":"ssn [SSN], card [CREDIT_CARD], zip [ZIP]"}`,
+			wantCount: 3,
+		},
+		{
+			name:      "escaped text",
+			body:      `{"prompt":"call me at\n555-123-4567 \"today\""}`,
+			want:      `{"prompt":"call me at\n[PHONE] \"today\""}`,
+			wantCount: 1,
+		},
+		{
+			name:      "numbers outside of strings are kept",
+			body:      `{"prompt":"hello","max_tokens":10000,"seed":94105}`,
+			want:      `{"prompt":"hello","max_tokens":10000,"seed":94105}`,
+			wantCount: 0,
+		},
+	}
+	p, err := NewPIIRedactorPlugin(DefaultPatterns)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, count, err := p.Redact(context.Background(), []byte(tt.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("unexpected redacted body (-want +got):\n%s", diff)
+			}
+			if count != tt.wantCount {
+				t.Errorf("count = %d, want %d", count, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPIIRedactorPlugin(t *testing.T) {
+	p, err := NewPIIRedactorPlugin(DefaultPatterns)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bodyBytes := []byte(`{"model":"m","prompt":"my email is jane@example.com","max_tokens":100}`)
+	request := framework.NewInferenceRequest()
+	if err := json.Unmarshal(bodyBytes, &request.Body); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cycleState := framework.NewCycleState()
+	cycleState.Write(framework.RequestBodyBytesKey, bodyBytes)
+
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !request.BodyMutated() {
+		t.Fatal("expected the body to be mutated")
+	}
+	wantBody := map[string]any{"model": "m", "prompt": "my email is [EMAIL]", "max_tokens": float64(100)}
+	if diff := cmp.Diff(wantBody, request.Body); diff != "" {
+		t.Errorf("unexpected body (-want +got):\n%s", diff)
+	}
+
+	// a body without sensitive data is not mutated
+	request = framework.NewInferenceRequest()
+	request.Body = map[string]any{"prompt": "hello"}
+	cycleState.Write(framework.RequestBodyBytesKey, []byte(`{"prompt":"hello"}`))
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if request.BodyMutated() {
+		t.Error("expected the body not to be mutated")
+	}
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package piisanitizer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/credential"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	PIISanitizerPluginType = "pii-sanitizer"
+	messagesField          = "messages"
+	promptField            = "prompt"
+	choicesField           = "choices"
+
+	// SessionIDHeader identifies the session whose entity mapping is kept across requests.
+	SessionIDHeader = "X-Session-Id"
+
+	PersonEntity     = "PERSON"
+	EmailEntity      = "EMAIL"
+	PhoneEntity      = "PHONE"
+	CreditCardEntity = "CREDIT_CARD"
+
+	defaultSessionTTLSeconds = 3600
+	maxSessions              = 10000
+)
+
+// detectors are the regex based heuristics detecting each entity, in the order they are applied.
+// Credit card numbers are detected before phone numbers, since the phone heuristic matches their digits.
+var detectors = []struct {
+	entity  string
+	pattern *regexp.Regexp
+	valid   func(string) bool
+}{
+	{entity: EmailEntity, pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)},
+	{entity: CreditCardEntity, pattern: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`), valid: luhnValid},
+	{entity: PhoneEntity, pattern: regexp.MustCompile(`(?:\+\d{1,3}[ .-]?)?\(?\b\d{3}\)?[ .-]?\d{3}[ .-]\d{4}\b`)},
+	{entity: PersonEntity, pattern: regexp.MustCompile(`\b(?:Mr|Mrs|Ms|Miss|Dr|Prof)\.?\s+[A-Z][a-z]+(?:\s+[A-Z][a-z]+)?`)},
+}
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &PIISanitizerPlugin{}
+	_ framework.ResponseProcessor = &PIISanitizerPlugin{}
+)
+
+// PIISanitizerConfig defines the JSON configuration structure for the plugin.
+type PIISanitizerConfig struct {
+	// Entities are the entities to sanitize, out of PERSON, EMAIL, PHONE and CREDIT_CARD. Defaults to all of them.
+	Entities []string `json:"entities"`
+	// StoreMapping numbers the placeholders, e.g. [EMAIL_1], and keeps the mapping from placeholders to
+	// entities, so that the placeholders in the response are replaced back with the original entities.
+	StoreMapping bool `json:"store_mapping"`
+	// SessionTTLSeconds is the duration the mapping of a session (identified by its X-Session-Id header and
+	// the credentials of the client) is kept after its last use, when StoreMapping is set.
+	SessionTTLSeconds int `json:"session_ttl_seconds"`
+}
+
+// PIISanitizerPluginFactory defines the factory function for NewPIISanitizerPlugin.
+func PIISanitizerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := PIISanitizerConfig{
+		SessionTTLSeconds: defaultSessionTTLSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", PIISanitizerPluginType, err)
+		}
+	}
+
+	plugin, err := NewPIISanitizerPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", PIISanitizerPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewPIISanitizerPlugin initializes a new PIISanitizerPlugin and returns its pointer.
+func NewPIISanitizerPlugin(config PIISanitizerConfig) (*PIISanitizerPlugin, error) {
+	entities := map[string]bool{}
+	for _, entity := range config.Entities {
+		entities[entity] = true
+	}
+	for entity := range entities {
+		if entity != PersonEntity && entity != EmailEntity && entity != PhoneEntity && entity != CreditCardEntity {
+			return nil, fmt.Errorf("unknown entity %q in PIISanitizer plugin", entity)
+		}
+	}
+	if config.StoreMapping && config.SessionTTLSeconds <= 0 {
+		return nil, errors.New("session_ttl_seconds must be positive in PIISanitizer plugin")
+	}
+
+	p := &PIISanitizerPlugin{
+		typedName: plugin.TypedName{
+			Type: PIISanitizerPluginType,
+			Name: PIISanitizerPluginType,
+		},
+		entities:     entities,
+		storeMapping: config.StoreMapping,
+	}
+	if config.StoreMapping {
+		p.sessions = expirable.NewLRU[string, *entityMapping](maxSessions, nil, time.Duration(config.SessionTTLSeconds)*time.Second)
+	}
+	return p, nil
+}
+
+// PIISanitizerPlugin replaces personally identifiable information in prompts, such as e-mail addresses,
+// phone numbers, credit card numbers and names, with placeholders like [EMAIL], before the prompts are
+// forwarded to models hosted by third parties. Entities are detected with regex based heuristics; names
+// are only detected after an honorific, e.g. "Dr. Jane Doe".
+//
+// When StoreMapping is set, the placeholders are numbered and the placeholders in the response are
+// replaced back with the original entities. The mapping is kept across the requests of a session, scoped by
+// the credentials of the client, so that a client cannot restore the entities of another client's session by
+// sending its session id. Requests without credentials have a request scoped mapping.
+type PIISanitizerPlugin struct {
+	typedName    plugin.TypedName
+	entities     map[string]bool // empty means all entities
+	storeMapping bool
+
+	lock sync.Mutex
+	// sessions maps a session, the credential hash and the session id, to its entity mapping
+	sessions *expirable.LRU[string, *entityMapping]
+}
+
+// entityMapping maps the entities of a request or a session to their numbered placeholders.
+type entityMapping struct {
+	lock         sync.Mutex
+	placeholders map[string]string // entity to placeholder
+	entities     map[string]string // placeholder to entity
+	counts       map[string]int    // entity type to the number of its placeholders
+}
+
+func newEntityMapping() *entityMapping {
+	return &entityMapping{
+		placeholders: map[string]string{},
+		entities:     map[string]string{},
+		counts:       map[string]int{},
+	}
+}
+
+// placeholder returns the numbered placeholder of the entity, assigning one if it has none.
+func (m *entityMapping) placeholder(entityType, entity string) string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if placeholder, ok := m.placeholders[entity]; ok {
+		return placeholder
+	}
+	m.counts[entityType]++
+	placeholder := fmt.Sprintf("[%s_%d]", entityType, m.counts[entityType])
+	m.placeholders[entity] = placeholder
+	m.entities[placeholder] = entity
+	return placeholder
+}
+
+// replacer returns a replacer of the placeholders with their entities.
+func (m *entityMapping) replacer() *strings.Replacer {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	oldnew := make([]string, 0, 2*len(m.entities))
+	for placeholder, entity := range m.entities {
+		oldnew = append(oldnew, placeholder, entity)
+	}
+	return strings.NewReplacer(oldnew...)
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *PIISanitizerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *PIISanitizerPlugin) WithName(name string) *PIISanitizerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest replaces the entities in the messages or the prompt of the request with placeholders.
+func (p *PIISanitizerPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	var mapping *entityMapping
+	if p.storeMapping {
+		mapping = p.sessionMapping(credential.Hash(request), request.GetHeader(SessionIDHeader))
+	}
+	sanitize := func(text string) string {
+		return p.sanitize(text, mapping)
+	}
+
+	sanitized := false
+	if messages, ok := request.Body[messagesField].([]any); ok && rewriteMessages(messages, sanitize) {
+		request.SetBodyField(messagesField, messages)
+		sanitized = true
+	}
+	switch prompt := request.Body[promptField].(type) {
+	case string:
+		if clean := sanitize(prompt); clean != prompt {
+			request.SetBodyField(promptField, clean)
+			sanitized = true
+		}
+	case []any: // a batch of prompts
+		if rewriteStrings(prompt, sanitize) {
+			request.SetBodyField(promptField, prompt)
+			sanitized = true
+		}
+	}
+
+	// the response may refer to placeholders of previous requests of the session
+	if mapping != nil && cycleState != nil {
+		cycleState.Write(p.typedName.String(), mapping)
+	}
+	if sanitized {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("sanitized PII in the request prompt")
+	}
+	return nil
+}
+
+// ProcessResponse replaces the placeholders in the choices of the response with the original entities.
+func (p *PIISanitizerPlugin) ProcessResponse(_ context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+
+	mapping, err := framework.ReadCycleStateKey[*entityMapping](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the mapping is not stored
+	}
+	choices, ok := response.Body[choicesField].([]any)
+	if !ok {
+		return nil
+	}
+
+	replacer := mapping.replacer()
+	restore := func(text string) string {
+		return replacer.Replace(text)
+	}
+	restored := false
+	for _, raw := range choices {
+		choice, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if text, ok := choice["text"].(string); ok {
+			if clean := restore(text); clean != text {
+				choice["text"] = clean
+				restored = true
+			}
+		}
+		if message, ok := choice["message"].(map[string]any); ok && rewriteMessages([]any{message}, restore) {
+			restored = true
+		}
+	}
+	if restored {
+		response.SetBodyField(choicesField, choices)
+	}
+	return nil
+}
+
+// sessionMapping returns the entity mapping of the session of the client, or a request scoped one without a
+// session or credentials.
+func (p *PIISanitizerPlugin) sessionMapping(credentialHash, sessionID string) *entityMapping {
+	if credentialHash == "" || sessionID == "" {
+		return newEntityMapping()
+	}
+	key := credentialHash + "/" + sessionID
+
+	// the lookup and the creation are a single step, so that concurrent first requests share the mapping
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if mapping, ok := p.sessions.Get(key); ok {
+		return mapping
+	}
+	mapping := newEntityMapping()
+	p.sessions.Add(key, mapping)
+	return mapping
+}
+
+// sanitize replaces the enabled entities in the text with placeholders, numbered when a mapping is given.
+func (p *PIISanitizerPlugin) sanitize(text string, mapping *entityMapping) string {
+	for _, detector := range detectors {
+		if len(p.entities) > 0 && !p.entities[detector.entity] {
+			continue
+		}
+		text = detector.pattern.ReplaceAllStringFunc(text, func(entity string) string {
+			if detector.valid != nil && !detector.valid(entity) {
+				return entity
+			}
+			if mapping == nil {
+				return "[" + detector.entity + "]"
+			}
+			return mapping.placeholder(detector.entity, entity)
+		})
+	}
+	return text
+}
+
+// rewriteMessages rewrites the content of the given chat messages in place, and returns whether any changed.
+func rewriteMessages(messages []any, rewrite func(string) string) bool {
+	rewritten := false
+	for _, m := range messages {
+		message, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch content := message["content"].(type) {
+		case string:
+			if clean := rewrite(content); clean != content {
+				message["content"] = clean
+				rewritten = true
+			}
+		case []any: // content parts, e.g. [{"type":"text","text":"..."}]
+			for _, part := range content {
+				if textPart, ok := part.(map[string]any); ok {
+					if text, ok := textPart["text"].(string); ok {
+						if clean := rewrite(text); clean != text {
+							textPart["text"] = clean
+							rewritten = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return rewritten
+}
+
+// rewriteStrings rewrites the string elements of the given slice in place, and returns whether any changed.
+func rewriteStrings(values []any, rewrite func(string) string) bool {
+	rewritten := false
+	for i, v := range values {
+		if text, ok := v.(string); ok {
+			if clean := rewrite(text); clean != text {
+				values[i] = clean
+				rewritten = true
+			}
+		}
+	}
+	return rewritten
+}
+
+// luhnValid returns whether the digits of the number pass the Luhn checksum used by credit card numbers.
+func luhnValid(number string) bool {
+	sum, digits := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if digits%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+	return digits >= 13 && digits <= 19 && sum%10 == 0
+}
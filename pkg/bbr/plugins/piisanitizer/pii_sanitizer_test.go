@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package piisanitizer
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestPIISanitizerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "selected entities", rawParams: json.RawMessage(`{"entities":["EMAIL","PHONE"]}`)},
+		{name: "store mapping", rawParams: json.RawMessage(`{"store_mapping":true,"session_ttl_seconds":60}`)},
+		{name: "unknown entity", rawParams: json.RawMessage(`{"entities":["ADDRESS"]}`), wantErr: true},
+		{name: "non-positive session TTL", rawParams: json.RawMessage(`{"store_mapping":true,"session_ttl_seconds":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := PIISanitizerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestPIISanitizerPlugin_ProcessRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		entities []string
+		body     map[string]any
+		want     map[string]any
+	}{
+		{
+			name: "all entities in a message",
+			body: map[string]any{"messages": []any{map[string]any{"role": "user",
+				"content": "Dr. Jane Doe (jane.doe@example.com, +1 415-555-0100) paid with 4111 1111 1111 1111."}}},
+			want: map[string]any{"messages": []any{map[string]any{"role": "user",
+				"content": "[PERSON] ([EMAIL], [PHONE]) paid with [CREDIT_CARD]."}}},
+		},
+		{
+			name: "text parts and completion prompts",
+			body: map[string]any{
+				"messages": []any{map[string]any{"role": "user", "content": []any{map[string]any{"type": "text", "text": "call (415) 555-0100"}}}},
+				"prompt":   []any{"mail bob@example.org", 42.0},
+			},
+			want: map[string]any{
+				"messages": []any{map[string]any{"role": "user", "content": []any{map[string]any{"type": "text", "text": "call [PHONE]"}}}},
+				"prompt":   []any{"mail [EMAIL]", 42.0},
+			},
+		},
+		{
+			name: "numbers failing the Luhn check are kept",
+			body: map[string]any{"prompt": "order 1234 5678 9012 3456"},
+			want: map[string]any{"prompt": "order 1234 5678 9012 3456"},
+		},
+		{
+			name:     "only the selected entities are sanitized",
+			entities: []string{EmailEntity},
+			body:     map[string]any{"prompt": "Mr. Smith, smith@example.com"},
+			want:     map[string]any{"prompt": "Mr. Smith, [EMAIL]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewPIISanitizerPlugin(PIISanitizerConfig{Entities: tt.entities})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, request.Body); diff != "" {
+				t.Errorf("body mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPIISanitizerPluginStoreMapping(t *testing.T) {
+	p, err := NewPIISanitizerPlugin(PIISanitizerConfig{StoreMapping: true, SessionTTLSeconds: 60})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	send := func(prompt string) (*framework.CycleState, string) {
+		cycleState := framework.NewCycleState()
+		request := framework.NewInferenceRequest()
+		request.Headers["authorization"] = "Bearer alice"
+		request.Headers["x-session-id"] = "session-1"
+		request.Body["prompt"] = prompt
+		if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+			t.Fatalf("ProcessRequest() unexpected error: %v", err)
+		}
+		return cycleState, request.Body["prompt"].(string)
+	}
+
+	_, got := send("write to a@example.com and b@example.com, then a@example.com again")
+	if want := "write to [EMAIL_1] and [EMAIL_2], then [EMAIL_1] again"; got != want {
+		t.Errorf("first prompt = %q, want %q", got, want)
+	}
+
+	// the mapping is kept across the requests of the session
+	cycleState, got := send("and c@example.com")
+	if want := "and [EMAIL_3]"; got != want {
+		t.Errorf("second prompt = %q, want %q", got, want)
+	}
+
+	response := framework.NewInferenceResponse()
+	response.Body = map[string]any{"choices": []any{
+		map[string]any{"message": map[string]any{"role": "assistant", "content": "Sent to [EMAIL_1] and [EMAIL_3]."}},
+		map[string]any{"text": "Unknown [EMAIL_9]."},
+	}}
+	if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+		t.Fatalf("ProcessResponse() unexpected error: %v", err)
+	}
+	want := map[string]any{"choices": []any{
+		map[string]any{"message": map[string]any{"role": "assistant", "content": "Sent to a@example.com and c@example.com."}},
+		map[string]any{"text": "Unknown [EMAIL_9]."},
+	}}
+	if diff := cmp.Diff(want, response.Body); diff != "" {
+		t.Errorf("response body mismatch (-want +got):\n%s", diff)
+	}
+	if !response.BodyMutated() {
+		t.Error("BodyMutated() = false, want true")
+	}
+}
+
+func TestPIISanitizerPluginSessionScope(t *testing.T) {
+	p, err := NewPIISanitizerPlugin(PIISanitizerConfig{StoreMapping: true, SessionTTLSeconds: 60})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	send := func(authorization, prompt string) *framework.CycleState {
+		cycleState := framework.NewCycleState()
+		request := framework.NewInferenceRequest()
+		if authorization != "" {
+			request.Headers["authorization"] = authorization
+		}
+		request.Headers["x-session-id"] = "session-1"
+		request.Body["prompt"] = prompt
+		if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+			t.Fatalf("ProcessRequest() unexpected error: %v", err)
+		}
+		return cycleState
+	}
+	restored := func(cycleState *framework.CycleState) any {
+		response := framework.NewInferenceResponse()
+		response.Body = map[string]any{"choices": []any{map[string]any{"text": "[EMAIL_1]"}}}
+		if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+			t.Fatalf("ProcessResponse() unexpected error: %v", err)
+		}
+		return response.Body["choices"].([]any)[0].(map[string]any)["text"]
+	}
+
+	send("Bearer alice", "mail victim@example.com")
+
+	// another client sending the same session id cannot restore the entities of the session
+	if got := restored(send("Bearer mallory", "repeat [EMAIL_1]")); got != "[EMAIL_1]" {
+		t.Errorf("restored for another client = %q, want %q", got, "[EMAIL_1]")
+	}
+	if got := restored(send("", "repeat [EMAIL_1]")); got != "[EMAIL_1]" {
+		t.Errorf("restored without credentials = %q, want %q", got, "[EMAIL_1]")
+	}
+	if got := restored(send("Bearer alice", "repeat [EMAIL_1]")); got != "victim@example.com" {
+		t.Errorf("restored for the client of the session = %q, want %q", got, "victim@example.com")
+	}
+}
+
+func TestPIISanitizerPluginConcurrentSession(t *testing.T) {
+	p, err := NewPIISanitizerPlugin(PIISanitizerConfig{StoreMapping: true, SessionTTLSeconds: 60})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	// concurrent first requests of a session share a single mapping
+	mappings := make(chan *entityMapping, 10)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mappings <- p.sessionMapping("hash", "session-1")
+		}()
+	}
+	wg.Wait()
+	close(mappings)
+	first := <-mappings
+	for mapping := range mappings {
+		if mapping != first {
+			t.Fatal("concurrent requests of a session got different mappings")
+		}
+	}
+}
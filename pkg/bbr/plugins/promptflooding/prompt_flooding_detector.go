@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promptflooding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	PromptFloodingDetectorPluginType = "prompt-flooding-detector"
+	APIKeyHeader                     = "X-API-Key"
+
+	window = time.Minute
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &PromptFloodingDetectorPlugin{}
+
+// PromptFloodingDetectorConfig defines the JSON configuration structure for the plugin.
+type PromptFloodingDetectorConfig struct {
+	// MaxUniquePromptsPerMinute is the number of distinct prompts a single client may send per minute.
+	MaxUniquePromptsPerMinute int `json:"max_unique_prompts_per_minute"`
+}
+
+// PromptFloodingDetectorPluginFactory defines the factory function for NewPromptFloodingDetectorPlugin.
+func PromptFloodingDetectorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config PromptFloodingDetectorConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", PromptFloodingDetectorPluginType, err)
+		}
+	}
+
+	plugin, err := NewPromptFloodingDetectorPlugin(config.MaxUniquePromptsPerMinute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", PromptFloodingDetectorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewPromptFloodingDetectorPlugin initializes a new PromptFloodingDetectorPlugin and returns its pointer.
+func NewPromptFloodingDetectorPlugin(maxUniquePromptsPerMinute int) (*PromptFloodingDetectorPlugin, error) {
+	if maxUniquePromptsPerMinute <= 0 {
+		return nil, errors.New("max_unique_prompts_per_minute must be positive in PromptFloodingDetector plugin")
+	}
+
+	return &PromptFloodingDetectorPlugin{
+		typedName: plugin.TypedName{
+			Type: PromptFloodingDetectorPluginType,
+			Name: PromptFloodingDetectorPluginType,
+		},
+		budget:  maxUniquePromptsPerMinute,
+		clients: map[string]map[uint64]time.Time{},
+		now:     time.Now,
+	}, nil
+}
+
+// PromptFloodingDetectorPlugin limits the number of distinct prompts each client (identified by its
+// X-API-Key header) may send in a sliding window of one minute, protecting the backends from clients
+// that flood them with trivially different prompts. Repeated prompts are not counted.
+// Requests exceeding the budget are rejected with HTTP 429.
+//
+// The prompt hashes are kept exactly rather than in a probabilistic sketch: a client never has more
+// hashes than the budget, so the memory is bounded by the budget times the number of active clients.
+type PromptFloodingDetectorPlugin struct {
+	typedName plugin.TypedName
+	budget    int
+
+	lock sync.Mutex
+	// clients maps a client to the time each of its distinct prompts was first seen in the window
+	clients   map[string]map[uint64]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *PromptFloodingDetectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *PromptFloodingDetectorPlugin) WithName(name string) *PromptFloodingDetectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if it carries a new prompt and the client exceeded its budget.
+func (p *PromptFloodingDetectorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	hash, ok := promptHash(request.Body)
+	if !ok {
+		return nil
+	}
+
+	apiKey := request.GetHeader(APIKeyHeader)
+	if !p.allow(apiKey, hash) {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("unique prompt budget exceeded", "budget", p.budget)
+		return errcommon.Error{Code: errcommon.ResourceExhausted, Msg: fmt.Sprintf("unique prompt budget of %d per minute exceeded", p.budget)}
+	}
+
+	return nil
+}
+
+// allow records the prompt for the client if it was already seen in the window or fits in the budget.
+func (p *PromptFloodingDetectorPlugin) allow(apiKey string, hash uint64) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+	p.sweep(now)
+
+	prompts, ok := p.clients[apiKey]
+	if !ok {
+		prompts = map[uint64]time.Time{}
+		p.clients[apiKey] = prompts
+	}
+	// drop the prompts that left the sliding window
+	for seen, firstSeen := range prompts {
+		if now.Sub(firstSeen) >= window {
+			delete(prompts, seen)
+		}
+	}
+
+	if _, ok := prompts[hash]; ok {
+		return true
+	}
+	if len(prompts) >= p.budget {
+		return false
+	}
+	prompts[hash] = now
+	return true
+}
+
+// sweep forgets the clients that sent no new prompts during the last window, at most once per window.
+func (p *PromptFloodingDetectorPlugin) sweep(now time.Time) {
+	if now.Sub(p.lastSweep) < window {
+		return
+	}
+	p.lastSweep = now
+	for apiKey, prompts := range p.clients {
+		active := false
+		for _, firstSeen := range prompts {
+			if now.Sub(firstSeen) < window {
+				active = true
+				break
+			}
+		}
+		if !active {
+			delete(p.clients, apiKey)
+		}
+	}
+}
+
+// promptHash hashes the prompt or messages of the request body.
+// Map keys are marshaled in sorted order, so the result is deterministic.
+func promptHash(body map[string]any) (uint64, bool) {
+	prompt, ok := body["messages"]
+	if !ok {
+		if prompt, ok = body["prompt"]; !ok {
+			return 0, false
+		}
+	}
+
+	raw, err := json.Marshal(prompt)
+	if err != nil {
+		return 0, false
+	}
+	return xxhash.Sum64(raw), true
+}
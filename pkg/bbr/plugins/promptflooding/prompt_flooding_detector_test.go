@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promptflooding
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func requestWithPrompt(apiKey string, prompt string) *framework.InferenceRequest {
+	r := framework.NewInferenceRequest()
+	r.Headers["x-api-key"] = apiKey
+	r.Body["model"] = "gpt-4"
+	r.Body["messages"] = []any{map[string]any{"role": "user", "content": prompt}}
+	return r
+}
+
+func TestPromptFloodingDetectorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"max_unique_prompts_per_minute":100}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "missing budget",
+			rawParams: json.RawMessage(`{}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := PromptFloodingDetectorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestPromptFloodingDetectorPlugin_ProcessRequest(t *testing.T) {
+	p, err := NewPromptFloodingDetectorPlugin(2)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	steps := []struct {
+		name    string
+		apiKey  string
+		prompt  string
+		advance time.Duration
+		wantErr bool
+	}{
+		{name: "first prompt within budget", apiKey: "a", prompt: "one"},
+		{name: "second prompt exhausts budget", apiKey: "a", prompt: "two"},
+		{name: "repeated prompt is allowed", apiKey: "a", prompt: "one"},
+		{name: "third unique prompt is rejected", apiKey: "a", prompt: "three", wantErr: true},
+		{name: "other client has its own budget", apiKey: "b", prompt: "three"},
+		{name: "budget is still exhausted within the window", apiKey: "a", prompt: "four", advance: 30 * time.Second, wantErr: true},
+		{name: "budget is restored after the window", apiKey: "a", prompt: "four", advance: 31 * time.Second},
+	}
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		err := p.ProcessRequest(context.Background(), nil, requestWithPrompt(step.apiKey, step.prompt))
+		if step.wantErr {
+			if err == nil {
+				t.Fatalf("%s: expected error, got nil", step.name)
+			}
+			if got := errcommon.CanonicalCode(err); got != errcommon.ResourceExhausted {
+				t.Errorf("%s: CanonicalCode = %q, want %q", step.name, got, errcommon.ResourceExhausted)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+	}
+}
+
+func TestPromptHash(t *testing.T) {
+	first, ok := promptHash(map[string]any{"prompt": "hello", "temperature": 0.1})
+	if !ok {
+		t.Fatal("promptHash() of a completion request returned false")
+	}
+	second, _ := promptHash(map[string]any{"prompt": "hello", "temperature": 0.9})
+	if first != second {
+		t.Error("promptHash() depends on fields other than the prompt")
+	}
+	if _, ok := promptHash(map[string]any{"input": "hello"}); ok {
+		t.Error("promptHash() of a request without a prompt returned true")
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promptnormalizer
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	PromptNormalizerPluginType = "prompt-normalizer"
+	messagesField              = "messages"
+	promptField                = "prompt"
+)
+
+// multipleSpaces matches runs of consecutive spaces.
+var multipleSpaces = regexp.MustCompile(` {2,}`)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &PromptNormalizerPlugin{}
+
+// PromptNormalizerPluginFactory defines the factory function for NewPromptNormalizerPlugin.
+func PromptNormalizerPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewPromptNormalizerPlugin().WithName(name), nil
+}
+
+// NewPromptNormalizerPlugin initializes a new PromptNormalizerPlugin and returns its pointer.
+func NewPromptNormalizerPlugin() *PromptNormalizerPlugin {
+	return &PromptNormalizerPlugin{
+		typedName: plugin.TypedName{
+			Type: PromptNormalizerPluginType,
+			Name: PromptNormalizerPluginType,
+		},
+	}
+}
+
+// PromptNormalizerPlugin normalizes line endings and whitespace in the prompt strings, so semantically
+// identical prompts are serialized identically, which improves the hit rate of downstream caches.
+// The normalization converts CRLF to LF, trims trailing whitespace of each line and collapses
+// consecutive spaces after the indentation of each line into a single space.
+type PromptNormalizerPlugin struct {
+	typedName plugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *PromptNormalizerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *PromptNormalizerPlugin) WithName(name string) *PromptNormalizerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest normalizes the content of the chat messages and the completion prompt.
+func (p *PromptNormalizerPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	normalized := false
+	if messages, ok := request.Body[messagesField].([]any); ok {
+		if normalizeMessages(messages) {
+			request.SetBodyField(messagesField, messages)
+			normalized = true
+		}
+	}
+
+	switch prompt := request.Body[promptField].(type) {
+	case string:
+		if clean := normalize(prompt); clean != prompt {
+			request.SetBodyField(promptField, clean)
+			normalized = true
+		}
+	case []any: // a batch of prompts
+		if normalizeStrings(prompt) {
+			request.SetBodyField(promptField, prompt)
+			normalized = true
+		}
+	}
+
+	if normalized {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("normalized whitespace in the request prompt")
+	}
+	return nil
+}
+
+// normalizeMessages normalizes the content of the given chat messages in place, and returns whether any changed.
+func normalizeMessages(messages []any) bool {
+	normalized := false
+	for _, m := range messages {
+		message, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch content := message["content"].(type) {
+		case string:
+			if clean := normalize(content); clean != content {
+				message["content"] = clean
+				normalized = true
+			}
+		case []any: // content parts, e.g. [{"type":"text","text":"..."}]
+			for _, part := range content {
+				if textPart, ok := part.(map[string]any); ok {
+					if text, ok := textPart["text"].(string); ok {
+						if clean := normalize(text); clean != text {
+							textPart["text"] = clean
+							normalized = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return normalized
+}
+
+// normalizeStrings normalizes the string elements of the given slice in place, and returns whether any changed.
+func normalizeStrings(values []any) bool {
+	normalized := false
+	for i, v := range values {
+		if text, ok := v.(string); ok {
+			if clean := normalize(text); clean != text {
+				values[i] = clean
+				normalized = true
+			}
+		}
+	}
+	return normalized
+}
+
+// normalize converts CRLF to LF, trims the trailing whitespace of each line and collapses consecutive spaces.
+// The leading indentation of each line is kept, since it is meaningful in code, e.g. in Python.
+func normalize(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		content := strings.TrimLeft(line, " \t")
+		indentation := line[:len(line)-len(content)]
+		lines[i] = indentation + multipleSpaces.ReplaceAllString(content, " ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promptnormalizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestPromptNormalizerPluginFactory(t *testing.T) {
+	p, err := PromptNormalizerPluginFactory("my-plugin", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.TypedName().Name; got != "my-plugin" {
+		t.Errorf("Name = %q, want %q", got, "my-plugin")
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "already normalized", text: "hello world\nsecond line", want: "hello world\nsecond line"},
+		{name: "CRLF line endings", text: "hello\r\nworld\r\n", want: "hello\nworld\n"},
+		{name: "trailing whitespace", text: "hello  \t\nworld ", want: "hello\nworld"},
+		{name: "multiple spaces", text: "hello    big   world", want: "hello big world"},
+		{name: "all combined", text: "a  b \r\n  c\t \r\n", want: "a b\n  c\n"},
+		{name: "indentation is kept", text: "def f():\n    return  1\n\t\tpass", want: "def f():\n    return 1\n\t\tpass"},
+		{name: "tabs inside lines are kept", text: "a\t\tb", want: "a\t\tb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalize(tt.text); got != tt.want {
+				t.Errorf("normalize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPromptNormalizerPlugin(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        map[string]any
+		wantBody    map[string]any
+		wantMutated bool
+	}{
+		{
+			name: "chat messages",
+			body: map[string]any{"messages": []any{
+				map[string]any{"role": "system", "content": "be  brief \r\n"},
+				map[string]any{"role": "user", "content": []any{
+					map[string]any{"type": "text", "text": "hi   there "},
+					map[string]any{"type": "image_url", "image_url": map[string]any{"url": "https://example.com/a.png"}},
+				}},
+			}},
+			wantBody: map[string]any{"messages": []any{
+				map[string]any{"role": "system", "content": "be brief\n"},
+				map[string]any{"role": "user", "content": []any{
+					map[string]any{"type": "text", "text": "hi there"},
+					map[string]any{"type": "image_url", "image_url": map[string]any{"url": "https://example.com/a.png"}},
+				}},
+			}},
+			wantMutated: true,
+		},
+		{
+			name:        "completion prompt",
+			body:        map[string]any{"prompt": "once  upon\r\na time "},
+			wantBody:    map[string]any{"prompt": "once upon\na time"},
+			wantMutated: true,
+		},
+		{
+			name:        "batch of completion prompts",
+			body:        map[string]any{"prompt": []any{"a  b", "c"}},
+			wantBody:    map[string]any{"prompt": []any{"a b", "c"}},
+			wantMutated: true,
+		},
+		{
+			name:     "normalized prompt is not mutated",
+			body:     map[string]any{"messages": []any{map[string]any{"role": "user", "content": "hi there"}}},
+			wantBody: map[string]any{"messages": []any{map[string]any{"role": "user", "content": "hi there"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+			if err := NewPromptNormalizerPlugin().ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+			if got := request.BodyMutated(); got != tt.wantMutated {
+				t.Errorf("BodyMutated() = %v, want %v", got, tt.wantMutated)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quantizationselector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	QuantizationSelectorPluginType = "quantization-selector"
+	PreferredQuantizationHeader    = "X-Preferred-Quantization"
+	modelField                     = "model"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &QuantizationSelectorPlugin{}
+
+// QuantizationSelectorConfig defines the JSON configuration structure for the plugin.
+type QuantizationSelectorConfig struct {
+	// Variants maps a base model to the quantization levels it is served with, e.g. {"gpt-4":["q4","q8","fp16"]}.
+	Variants map[string][]string `json:"variants"`
+}
+
+// QuantizationSelectorPluginFactory defines the factory function for NewQuantizationSelectorPlugin.
+func QuantizationSelectorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config QuantizationSelectorConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", QuantizationSelectorPluginType, err)
+		}
+	}
+
+	plugin, err := NewQuantizationSelectorPlugin(config.Variants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", QuantizationSelectorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewQuantizationSelectorPlugin initializes a new QuantizationSelectorPlugin and returns its pointer.
+func NewQuantizationSelectorPlugin(variants map[string][]string) (*QuantizationSelectorPlugin, error) {
+	if len(variants) == 0 {
+		return nil, errors.New("variants are required in QuantizationSelector plugin")
+	}
+
+	normalized := make(map[string]sets.Set[string], len(variants))
+	for model, levels := range variants {
+		normalized[model] = sets.New[string]()
+		for _, level := range levels {
+			normalized[model].Insert(strings.ToLower(strings.TrimSpace(level)))
+		}
+	}
+
+	return &QuantizationSelectorPlugin{
+		typedName: plugin.TypedName{
+			Type: QuantizationSelectorPluginType,
+			Name: QuantizationSelectorPluginType,
+		},
+		variants: normalized,
+	}, nil
+}
+
+// QuantizationSelectorPlugin rewrites the model name to a quantized variant of the model,
+// e.g. "gpt-4" to "gpt-4-q4", according to the quantization level preferred by the client.
+// If the requested variant is not served, the original model is kept.
+type QuantizationSelectorPlugin struct {
+	typedName plugin.TypedName
+	variants  map[string]sets.Set[string]
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *QuantizationSelectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *QuantizationSelectorPlugin) WithName(name string) *QuantizationSelectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rewrites the model field to the preferred quantized variant, if available.
+func (p *QuantizationSelectorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	quantization := strings.ToLower(strings.TrimSpace(request.GetHeader(PreferredQuantizationHeader)))
+	if quantization == "" {
+		return nil
+	}
+
+	model, ok := request.Body[modelField].(string)
+	if !ok || model == "" {
+		return nil
+	}
+
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	if levels, ok := p.variants[model]; !ok || !levels.Has(quantization) {
+		logger.Info("preferred quantization is not available, keeping the original model", "model", model, "quantization", quantization)
+		return nil
+	}
+
+	quantizedModel := model + "-" + quantization
+	request.SetBodyField(modelField, quantizedModel)
+	logger.Info("selected quantized model variant", "model", model, "quantizedModel", quantizedModel)
+
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quantizationselector
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestQuantizationSelectorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"variants":{"gpt-4":["q4","q8","fp16"]}}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "empty variants",
+			rawParams: json.RawMessage(`{"variants":{}}`),
+			wantErr:   true,
+		},
+		{
+			name:      "no parameters",
+			rawParams: nil,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := QuantizationSelectorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+			if got := p.TypedName().Type; got != QuantizationSelectorPluginType {
+				t.Errorf("Type = %q, want %q", got, QuantizationSelectorPluginType)
+			}
+		})
+	}
+}
+
+func TestQuantizationSelectorPlugin_ProcessRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		model       any
+		header      string
+		wantModel   any
+		wantMutated bool
+	}{
+		{
+			name:        "available quantization",
+			model:       "gpt-4",
+			header:      "q4",
+			wantModel:   "gpt-4-q4",
+			wantMutated: true,
+		},
+		{
+			name:        "header value is case-insensitive",
+			model:       "gpt-4",
+			header:      "FP16",
+			wantModel:   "gpt-4-fp16",
+			wantMutated: true,
+		},
+		{
+			name:      "unavailable quantization falls back to original model",
+			model:     "gpt-4",
+			header:    "q2",
+			wantModel: "gpt-4",
+		},
+		{
+			name:      "model without variants",
+			model:     "llama-3",
+			header:    "q4",
+			wantModel: "llama-3",
+		},
+		{
+			name:      "no preference header",
+			model:     "gpt-4",
+			wantModel: "gpt-4",
+		},
+		{
+			name:      "non-string model",
+			model:     42,
+			header:    "q4",
+			wantModel: 42,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewQuantizationSelectorPlugin(map[string][]string{"gpt-4": {"q4", "q8", "fp16"}})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+
+			request := framework.NewInferenceRequest()
+			request.Body[modelField] = tt.model
+			if tt.header != "" {
+				request.Headers["x-preferred-quantization"] = tt.header
+			}
+
+			if err := p.ProcessRequest(context.Background(), nil, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Body[modelField]; got != tt.wantModel {
+				t.Errorf("Body[%q] = %v, want %v", modelField, got, tt.wantModel)
+			}
+			if got := request.BodyMutated(); got != tt.wantMutated {
+				t.Errorf("BodyMutated() = %v, want %v", got, tt.wantMutated)
+			}
+		})
+	}
+}
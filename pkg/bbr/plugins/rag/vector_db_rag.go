@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	VectorDBRAGPluginType = "vector-db-rag"
+	messagesField         = "messages"
+	systemRole            = "system"
+	userRole              = "user"
+
+	defaultTopK            = 3
+	defaultMaxContextChars = 4000
+	defaultTimeoutSeconds  = 5
+
+	// contextPrefix introduces each retrieved document in its context message.
+	contextPrefix = "Relevant context:\n"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &VectorDBRAGPlugin{}
+
+// VectorDBRAGConfig defines the JSON configuration structure for the plugin.
+type VectorDBRAGConfig struct {
+	// EmbeddingEndpoint is the URL of the OpenAI compatible embeddings endpoint that embeds the prompt,
+	// e.g. "http://embedder.default.svc:8000/v1/embeddings".
+	EmbeddingEndpoint string `json:"embedding_endpoint"`
+	// EmbeddingModel is the model of the embedding requests.
+	EmbeddingModel string `json:"embedding_model"`
+	// VectorDB configures the vector database the documents are retrieved from.
+	VectorDB VectorDBConfig `json:"vector_db"`
+	// TopK is the number of documents retrieved per request. Defaults to 3.
+	TopK int `json:"top_k"`
+	// MaxContextChars caps the total number of characters of the injected documents. Defaults to 4000.
+	MaxContextChars int `json:"max_context_chars"`
+	// TimeoutSeconds bounds each of the embedding and search requests. Defaults to 5.
+	TimeoutSeconds int `json:"timeout_seconds"`
+}
+
+// VectorDBRAGPluginFactory defines the factory function for NewVectorDBRAGPlugin.
+func VectorDBRAGPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := VectorDBRAGConfig{
+		TopK:            defaultTopK,
+		MaxContextChars: defaultMaxContextChars,
+		TimeoutSeconds:  defaultTimeoutSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", VectorDBRAGPluginType, err)
+		}
+	}
+
+	plugin, err := NewVectorDBRAGPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", VectorDBRAGPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewVectorDBRAGPlugin initializes a new VectorDBRAGPlugin and returns its pointer.
+func NewVectorDBRAGPlugin(config VectorDBRAGConfig) (*VectorDBRAGPlugin, error) {
+	if config.EmbeddingEndpoint == "" {
+		return nil, errors.New("embedding_endpoint is required in VectorDBRAG plugin")
+	}
+	if _, err := url.ParseRequestURI(config.EmbeddingEndpoint); err != nil {
+		return nil, fmt.Errorf("invalid embedding_endpoint in VectorDBRAG plugin - %w", err)
+	}
+	store, err := newVectorStore(config.VectorDB)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vector_db in VectorDBRAG plugin - %w", err)
+	}
+	if config.TopK <= 0 {
+		return nil, errors.New("top_k must be positive in VectorDBRAG plugin")
+	}
+	if config.MaxContextChars <= 0 {
+		return nil, errors.New("max_context_chars must be positive in VectorDBRAG plugin")
+	}
+	if config.TimeoutSeconds <= 0 {
+		return nil, errors.New("timeout_seconds must be positive in VectorDBRAG plugin")
+	}
+
+	return &VectorDBRAGPlugin{
+		typedName: plugin.TypedName{
+			Type: VectorDBRAGPluginType,
+			Name: VectorDBRAGPluginType,
+		},
+		embeddingEndpoint: config.EmbeddingEndpoint,
+		embeddingModel:    config.EmbeddingModel,
+		store:             store,
+		topK:              config.TopK,
+		maxContextChars:   config.MaxContextChars,
+		client:            &http.Client{Timeout: time.Duration(config.TimeoutSeconds) * time.Second},
+	}, nil
+}
+
+// VectorDBRAGPlugin augments chat completion requests with documents retrieved from a vector database.
+// The last user message is embedded with an embeddings endpoint, the top K nearest documents are
+// searched in Qdrant or Weaviate, and each is prepended to the messages as a system message, within a
+// total of max_context_chars characters. If the retrieval fails, the request is forwarded unchanged.
+type VectorDBRAGPlugin struct {
+	typedName         plugin.TypedName
+	embeddingEndpoint string
+	embeddingModel    string
+	store             VectorStore
+	topK              int
+	maxContextChars   int
+	client            *http.Client
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *VectorDBRAGPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *VectorDBRAGPlugin) WithName(name string) *VectorDBRAGPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest prepends the documents relevant to the last user message to the messages.
+func (p *VectorDBRAGPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	messages, ok := request.Body[messagesField].([]any)
+	if !ok {
+		return nil
+	}
+	query := lastUserText(messages)
+	if query == "" {
+		return nil
+	}
+
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	documents, err := p.retrieve(ctx, query)
+	if err != nil {
+		logger.Info("failed to retrieve context documents, forwarding the request unchanged", "error", err.Error())
+		return nil
+	}
+	contextMessages := p.contextMessages(documents)
+	if len(contextMessages) == 0 {
+		return nil
+	}
+
+	request.SetBodyField(messagesField, append(contextMessages, messages...))
+	logger.Info("injected retrieved context documents", "documents", len(contextMessages))
+	return nil
+}
+
+// retrieve embeds the query and returns the texts of the documents nearest to it.
+func (p *VectorDBRAGPlugin) retrieve(ctx context.Context, query string) ([]string, error) {
+	request := map[string]any{"input": query}
+	if p.embeddingModel != "" {
+		request["model"] = p.embeddingModel
+	}
+	var response struct {
+		Data []struct {
+			Embedding []float64 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := postJSON(ctx, p.client, p.embeddingEndpoint, request, &response); err != nil {
+		return nil, fmt.Errorf("embedding failed - %w", err)
+	}
+	if len(response.Data) == 0 || len(response.Data[0].Embedding) == 0 {
+		return nil, errors.New("embedding failed - empty embedding")
+	}
+
+	documents, err := p.store.Search(ctx, p.client, response.Data[0].Embedding, p.topK)
+	if err != nil {
+		return nil, fmt.Errorf("search failed - %w", err)
+	}
+	return documents, nil
+}
+
+// contextMessages returns a system message per document, truncating the documents to max_context_chars in total.
+func (p *VectorDBRAGPlugin) contextMessages(documents []string) []any {
+	remaining := p.maxContextChars
+	var messages []any
+	for _, document := range documents {
+		if remaining <= 0 {
+			break
+		}
+		if len(document) > remaining {
+			document = truncate(document, remaining)
+		}
+		remaining -= len(document)
+		messages = append(messages, map[string]any{"role": systemRole, "content": contextPrefix + document})
+	}
+	return messages
+}
+
+// truncate returns the longest prefix of s of at most n bytes that does not split a UTF-8 sequence.
+func truncate(s string, n int) string {
+	return strings.ToValidUTF8(s[:n], "")
+}
+
+// lastUserText returns the text content of the last user message.
+func lastUserText(messages []any) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		message, ok := messages[i].(map[string]any)
+		if !ok || message["role"] != userRole {
+			continue
+		}
+		switch content := message["content"].(type) {
+		case string:
+			return content
+		case []any:
+			var texts []string
+			for _, part := range content {
+				if textPart, ok := part.(map[string]any); ok {
+					if text, ok := textPart["text"].(string); ok {
+						texts = append(texts, text)
+					}
+				}
+			}
+			return strings.Join(texts, "\n")
+		}
+		return ""
+	}
+	return ""
+}
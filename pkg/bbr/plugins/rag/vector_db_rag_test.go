@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestVectorDBRAGPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder/v1/embeddings","vector_db":{"type":"qdrant","url":"http://qdrant:6333","collection":"docs"}}`)},
+		{name: "missing embedding endpoint", rawParams: json.RawMessage(`{"vector_db":{"type":"qdrant","url":"http://qdrant:6333","collection":"docs"}}`), wantErr: true},
+		{name: "missing vector db", rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder/v1/embeddings"}`), wantErr: true},
+		{name: "non-positive top k", rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder/v1/embeddings","vector_db":{"type":"qdrant","url":"http://qdrant:6333","collection":"docs"},"top_k":0}`), wantErr: true},
+		{name: "non-positive max context chars", rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder/v1/embeddings","vector_db":{"type":"qdrant","url":"http://qdrant:6333","collection":"docs"},"max_context_chars":-1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := VectorDBRAGPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+// fakeStore returns fixed documents, and records the vector it was searched with.
+type fakeStore struct {
+	documents []string
+	err       error
+	vector    []float64
+}
+
+func (s *fakeStore) Search(_ context.Context, _ *http.Client, vector []float64, topK int) ([]string, error) {
+	s.vector = vector
+	return s.documents[:min(topK, len(s.documents))], s.err
+}
+
+func TestVectorDBRAGPlugin(t *testing.T) {
+	var embedded string
+	embedder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		embedded, _ = request["input"].(string)
+		_, _ = w.Write([]byte(`{"data":[{"embedding":[0.5,0.25]}]}`))
+	}))
+	defer embedder.Close()
+
+	system := map[string]any{"role": "system", "content": "You are helpful."}
+	question := map[string]any{"role": "user", "content": []any{map[string]any{"type": "text", "text": "What is BBR?"}}}
+	tests := []struct {
+		name            string
+		documents       []string
+		storeErr        error
+		maxContextChars int
+		wantInjected    []string
+	}{
+		{
+			name:            "documents are prepended",
+			documents:       []string{"BBR is body based routing.", "It runs as an ext-proc.", "Ignored beyond top k."},
+			maxContextChars: 1000,
+			wantInjected:    []string{"BBR is body based routing.", "It runs as an ext-proc."},
+		},
+		{
+			name:            "documents are capped",
+			documents:       []string{"0123456789", "abcdefghij"},
+			maxContextChars: 15,
+			wantInjected:    []string{"0123456789", "abcde"},
+		},
+		{
+			name:      "no documents",
+			documents: []string{},
+		},
+		{
+			name:      "search failure",
+			documents: []string{},
+			storeErr:  errors.New("unavailable"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewVectorDBRAGPlugin(VectorDBRAGConfig{
+				EmbeddingEndpoint: embedder.URL,
+				VectorDB:          VectorDBConfig{Type: QdrantType, URL: "http://qdrant:6333", Collection: "docs"},
+				TopK:              2,
+				MaxContextChars:   max(tt.maxContextChars, 1),
+				TimeoutSeconds:    5,
+			})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			store := &fakeStore{documents: tt.documents, err: tt.storeErr}
+			p.store = store
+
+			request := framework.NewInferenceRequest()
+			request.Body["messages"] = []any{system, question}
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if embedded != "What is BBR?" {
+				t.Errorf("embedded input = %q, want the last user message", embedded)
+			}
+			if diff := cmp.Diff([]float64{0.5, 0.25}, store.vector); diff != "" {
+				t.Errorf("unexpected search vector (-want +got):\n%s", diff)
+			}
+
+			want := []any{}
+			for _, document := range tt.wantInjected {
+				want = append(want, map[string]any{"role": "system", "content": contextPrefix + document})
+			}
+			want = append(want, system, question)
+			if diff := cmp.Diff(want, request.Body["messages"]); diff != "" {
+				t.Errorf("unexpected messages (-want +got):\n%s", diff)
+			}
+			if got := request.BodyMutated(); got != (len(tt.wantInjected) > 0) {
+				t.Errorf("BodyMutated() = %v, want %v", got, len(tt.wantInjected) > 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rag
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	QdrantType   = "qdrant"
+	WeaviateType = "weaviate"
+
+	defaultTextField = "text"
+)
+
+// graphQLName matches the Weaviate class and property names, which are interpolated in the GraphQL query.
+var graphQLName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// VectorStore searches the documents nearest to a query embedding.
+type VectorStore interface {
+	// Search returns the texts of the topK documents nearest to the vector, the nearest first.
+	Search(ctx context.Context, client *http.Client, vector []float64, topK int) ([]string, error)
+}
+
+// VectorDBConfig defines the JSON configuration structure of the vector database.
+type VectorDBConfig struct {
+	// Type is either "qdrant" or "weaviate".
+	Type string `json:"type"`
+	// URL is the base URL of the vector database, e.g. "http://qdrant.default.svc:6333".
+	URL string `json:"url"`
+	// Collection is the Qdrant collection, or the Weaviate class, of the documents.
+	Collection string `json:"collection"`
+	// TextField is the payload field (Qdrant) or property (Weaviate) holding the text of the documents.
+	// Defaults to "text".
+	TextField string `json:"text_field"`
+}
+
+// newVectorStore creates the vector store described by the configuration.
+func newVectorStore(config VectorDBConfig) (VectorStore, error) {
+	if _, err := url.ParseRequestURI(config.URL); err != nil {
+		return nil, fmt.Errorf("invalid vector_db url - %w", err)
+	}
+	if config.Collection == "" {
+		return nil, errors.New("vector_db collection is required")
+	}
+	if config.TextField == "" {
+		config.TextField = defaultTextField
+	}
+	baseURL := strings.TrimSuffix(config.URL, "/")
+
+	switch config.Type {
+	case QdrantType:
+		return &qdrantStore{
+			searchURL: baseURL + "/collections/" + url.PathEscape(config.Collection) + "/points/search",
+			textField: config.TextField,
+		}, nil
+	case WeaviateType:
+		if !graphQLName.MatchString(config.Collection) || !graphQLName.MatchString(config.TextField) {
+			return nil, errors.New("vector_db collection and text_field must be GraphQL names for weaviate")
+		}
+		return &weaviateStore{
+			graphQLURL: baseURL + "/v1/graphql",
+			class:      config.Collection,
+			textField:  config.TextField,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown vector_db type %q, must be %q or %q", config.Type, QdrantType, WeaviateType)
+	}
+}
+
+// qdrantStore searches a Qdrant collection with its REST API.
+type qdrantStore struct {
+	searchURL string
+	textField string
+}
+
+// Search returns the texts of the topK points nearest to the vector.
+func (s *qdrantStore) Search(ctx context.Context, client *http.Client, vector []float64, topK int) ([]string, error) {
+	request := map[string]any{"vector": vector, "limit": topK, "with_payload": []string{s.textField}}
+	var response struct {
+		Result []struct {
+			Payload map[string]any `json:"payload"`
+		} `json:"result"`
+	}
+	if err := postJSON(ctx, client, s.searchURL, request, &response); err != nil {
+		return nil, err
+	}
+
+	texts := make([]string, 0, len(response.Result))
+	for _, point := range response.Result {
+		if text, ok := point.Payload[s.textField].(string); ok && text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return texts, nil
+}
+
+// weaviateStore searches a Weaviate class with its GraphQL API.
+type weaviateStore struct {
+	graphQLURL string
+	class      string
+	textField  string
+}
+
+// Search returns the texts of the topK objects nearest to the vector.
+func (s *weaviateStore) Search(ctx context.Context, client *http.Client, vector []float64, topK int) ([]string, error) {
+	rawVector, err := json.Marshal(vector)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf("{ Get { %s(nearVector: {vector: %s}, limit: %d) { %s } } }", s.class, rawVector, topK, s.textField)
+
+	var response struct {
+		Data struct {
+			Get map[string][]map[string]any `json:"Get"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := postJSON(ctx, client, s.graphQLURL, map[string]any{"query": query}, &response); err != nil {
+		return nil, err
+	}
+	if len(response.Errors) > 0 {
+		return nil, fmt.Errorf("weaviate query failed - %s", response.Errors[0].Message)
+	}
+
+	objects := response.Data.Get[s.class]
+	texts := make([]string, 0, len(objects))
+	for _, object := range objects {
+		if text, ok := object[s.textField].(string); ok && text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return texts, nil
+}
+
+// postJSON posts the request as JSON to the endpoint, and parses the JSON response into out.
+func postJSON(ctx context.Context, client *http.Client, endpoint string, request any, out any) error {
+	raw, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	raw, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return json.Unmarshal(raw, out)
+}
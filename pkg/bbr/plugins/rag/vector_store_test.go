@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestQdrantStore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/collections/docs/points/search" {
+			t.Errorf("path = %q, want the search path of the collection", r.URL.Path)
+		}
+		var request map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		if diff := cmp.Diff(map[string]any{"vector": []any{0.1, 0.2}, "limit": 2.0, "with_payload": []any{"body"}}, request); diff != "" {
+			t.Errorf("unexpected request (-want +got):\n%s", diff)
+		}
+		_, _ = w.Write([]byte(`{"result":[{"payload":{"body":"first"}},{"payload":{"other":"x"}},{"payload":{"body":"second"}}]}`))
+	}))
+	defer server.Close()
+
+	store, err := newVectorStore(VectorDBConfig{Type: QdrantType, URL: server.URL + "/", Collection: "docs", TextField: "body"})
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	texts, err := store.Search(context.Background(), server.Client(), []float64{0.1, 0.2}, 2)
+	if err != nil {
+		t.Fatalf("Search() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"first", "second"}, texts); diff != "" {
+		t.Errorf("unexpected texts (-want +got):\n%s", diff)
+	}
+}
+
+func TestWeaviateStore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/graphql" {
+			t.Errorf("path = %q, want %q", r.URL.Path, "/v1/graphql")
+		}
+		var request map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		want := "{ Get { Docs(nearVector: {vector: [0.1,0.2]}, limit: 3) { text } } }"
+		if diff := cmp.Diff(want, request["query"]); diff != "" {
+			t.Errorf("unexpected query (-want +got):\n%s", diff)
+		}
+		_, _ = w.Write([]byte(`{"data":{"Get":{"Docs":[{"text":"first"},{"text":"second"}]}}}`))
+	}))
+	defer server.Close()
+
+	store, err := newVectorStore(VectorDBConfig{Type: WeaviateType, URL: server.URL, Collection: "Docs"})
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	texts, err := store.Search(context.Background(), server.Client(), []float64{0.1, 0.2}, 3)
+	if err != nil {
+		t.Fatalf("Search() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"first", "second"}, texts); diff != "" {
+		t.Errorf("unexpected texts (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewVectorStore(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  VectorDBConfig
+		wantErr bool
+	}{
+		{name: "qdrant", config: VectorDBConfig{Type: QdrantType, URL: "http://qdrant:6333", Collection: "docs"}},
+		{name: "weaviate", config: VectorDBConfig{Type: WeaviateType, URL: "http://weaviate:8080", Collection: "Docs"}},
+		{name: "unknown type", config: VectorDBConfig{Type: "pinecone", URL: "http://pinecone", Collection: "docs"}, wantErr: true},
+		{name: "invalid url", config: VectorDBConfig{Type: QdrantType, URL: "qdrant", Collection: "docs"}, wantErr: true},
+		{name: "missing collection", config: VectorDBConfig{Type: QdrantType, URL: "http://qdrant:6333"}, wantErr: true},
+		{name: "weaviate class injection", config: VectorDBConfig{Type: WeaviateType, URL: "http://weaviate:8080", Collection: "Docs } }"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newVectorStore(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newVectorStore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimiter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/credential"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	TokenBucketLimiterPluginType = "token-bucket-limiter"
+	RetryAfterHeader             = "Retry-After"
+)
+
+// compile-time type validation
+var _ framework.RateLimiter = &TokenBucketLimiterPlugin{}
+
+// TokenBucketLimiterConfig defines the JSON configuration structure for the plugin.
+type TokenBucketLimiterConfig struct {
+	// RequestsPerSecond is the rate at which the bucket of each client is refilled.
+	RequestsPerSecond float64 `json:"requests_per_second"`
+	// Burst is the size of the bucket of each client, i.e. the number of requests it may send at once.
+	Burst int `json:"burst"`
+	// KeyHeader is the request header identifying the client, e.g. set by the jwks-validator plugin. It should
+	// be set by a trusted party.
+	KeyHeader string `json:"key_header"`
+	// KeyField optionally identifies the client by a body field instead, e.g. "user". Since clients choose the
+	// body, they can evade their limit by changing it, so it should only be used with trusted clients.
+	// KeyHeader takes precedence over it.
+	KeyField string `json:"key_field"`
+}
+
+// TokenBucketLimiterPluginFactory defines the factory function for NewTokenBucketLimiterPlugin.
+func TokenBucketLimiterPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config TokenBucketLimiterConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", TokenBucketLimiterPluginType, err)
+		}
+	}
+
+	plugin, err := NewTokenBucketLimiterPlugin(config.RequestsPerSecond, config.Burst, config.KeyField, config.KeyHeader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", TokenBucketLimiterPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewTokenBucketLimiterPlugin initializes a new TokenBucketLimiterPlugin and returns its pointer. The clients are
+// identified by the keyHeader request header if set, by the keyField body field if set, and by the hash of their
+// credentials otherwise.
+func NewTokenBucketLimiterPlugin(requestsPerSecond float64, burst int, keyField string, keyHeader string) (*TokenBucketLimiterPlugin, error) {
+	if requestsPerSecond <= 0 {
+		return nil, errors.New("requests_per_second must be positive in TokenBucketLimiter plugin")
+	}
+	if burst <= 0 {
+		return nil, errors.New("burst must be positive in TokenBucketLimiter plugin")
+	}
+
+	return &TokenBucketLimiterPlugin{
+		typedName: plugin.TypedName{
+			Type: TokenBucketLimiterPluginType,
+			Name: TokenBucketLimiterPluginType,
+		},
+		limit:     rate.Limit(requestsPerSecond),
+		burst:     burst,
+		keyField:  keyField,
+		keyHeader: keyHeader,
+		// once idle for that long, the bucket of a client is full again, like a new bucket
+		idleTimeout: time.Duration(float64(burst) / requestsPerSecond * float64(time.Second)),
+		limiters:    map[string]*clientLimiter{},
+		now:         time.Now,
+	}, nil
+}
+
+// TokenBucketLimiterPlugin rate limits the requests of each client with a token bucket. By default the clients are
+// identified by their credentials, the Authorization and X-API-Key headers, which should be validated by an
+// earlier plugin, e.g. authentication-gate, so that clients cannot get fresh buckets with made-up credentials;
+// requests without credentials share a single bucket. A trusted header or, explicitly, a body field may
+// identify the clients instead. Requests exceeding the rate are rejected with HTTP 429 and a Retry-After header. In a chain,
+// the plugin should be configured before the plugins that should not process rejected requests; in a plugins
+// DAG, it runs before all the plugins it does not depend on.
+// The buckets of the clients idle long enough to refill them are forgotten.
+type TokenBucketLimiterPlugin struct {
+	typedName   plugin.TypedName
+	limit       rate.Limit
+	burst       int
+	keyField    string
+	keyHeader   string
+	idleTimeout time.Duration
+
+	mu sync.Mutex
+	// limiters holds the bucket of each client key.
+	limiters  map[string]*clientLimiter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// clientLimiter is the bucket of a client, and the time of its last request.
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *TokenBucketLimiterPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *TokenBucketLimiterPlugin) WithName(name string) *TokenBucketLimiterPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if its client exceeded its rate.
+func (p *TokenBucketLimiterPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	var key string
+	switch {
+	case p.keyHeader != "":
+		key = request.GetHeader(p.keyHeader)
+	case p.keyField != "":
+		if value, ok := request.Body[p.keyField]; ok {
+			key = fmt.Sprintf("%v", value)
+		}
+	default:
+		key = credential.Hash(request)
+	}
+
+	allowed, retryAfterSecs, err := p.Allow(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("rate limit exceeded", "key", key, "retryAfterSecs", retryAfterSecs)
+		return errcommon.ErrorWithHeaders{
+			Err:     errcommon.Error{Code: errcommon.ResourceExhausted, Msg: "rate limit exceeded"},
+			Headers: map[string]string{RetryAfterHeader: strconv.Itoa(retryAfterSecs)},
+		}
+	}
+	return nil
+}
+
+// Allow takes a token from the bucket of the client. If the bucket is empty, it returns the number of seconds
+// until the next token, rounded up.
+func (p *TokenBucketLimiterPlugin) Allow(_ context.Context, key string) (bool, int, error) {
+	now := p.now()
+	p.mu.Lock()
+	p.sweep(now)
+	client, ok := p.limiters[key]
+	if !ok {
+		client = &clientLimiter{limiter: rate.NewLimiter(p.limit, p.burst)}
+		p.limiters[key] = client
+	}
+	client.lastSeen = now
+	limiter := client.limiter
+	p.mu.Unlock()
+
+	reservation := limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return true, 0, nil
+	}
+	// the request is rejected, so it doesn't consume the token
+	reservation.CancelAt(now)
+	return false, int(math.Ceil(delay.Seconds())), nil
+}
+
+// sweep forgets the clients idle for the idle timeout, whose buckets are full again, at most once per idle
+// timeout. It must be called with the lock held.
+func (p *TokenBucketLimiterPlugin) sweep(now time.Time) {
+	if now.Sub(p.lastSweep) < p.idleTimeout {
+		return
+	}
+	p.lastSweep = now
+	for key, client := range p.limiters {
+		if now.Sub(client.lastSeen) >= p.idleTimeout {
+			delete(p.limiters, key)
+		}
+	}
+}
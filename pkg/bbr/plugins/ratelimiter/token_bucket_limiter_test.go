@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimiter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func requestFromUser(user string) *framework.InferenceRequest {
+	r := framework.NewInferenceRequest()
+	r.Body["model"] = "gpt-4"
+	if user != "" {
+		r.Body["user"] = user
+	}
+	return r
+}
+
+func TestTokenBucketLimiterPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"requests_per_second":10,"burst":20}`),
+		},
+		{
+			name:      "valid config with key field",
+			rawParams: json.RawMessage(`{"requests_per_second":0.5,"burst":1,"key_field":"tenant"}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "missing rate",
+			rawParams: json.RawMessage(`{"burst":20}`),
+			wantErr:   true,
+		},
+		{
+			name:      "missing burst",
+			rawParams: json.RawMessage(`{"requests_per_second":10}`),
+			wantErr:   true,
+		},
+		{
+			name:      "valid config with key header",
+			rawParams: json.RawMessage(`{"requests_per_second":10,"burst":20,"key_field":"","key_header":"X-Tenant-Id"}`),
+		},
+		{
+			name:      "valid config with empty key field",
+			rawParams: json.RawMessage(`{"requests_per_second":10,"burst":20,"key_field":""}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := TokenBucketLimiterPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestTokenBucketLimiterPlugin(t *testing.T) {
+	ctx := context.Background()
+	p, err := NewTokenBucketLimiterPlugin(0.5, 2, "user", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	// the burst is allowed
+	for i := range 2 {
+		if err := p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser("alice")); err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i, err)
+		}
+	}
+
+	// the bucket is empty, and refills a token in 2 seconds
+	err = p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser("alice"))
+	if errcommon.CanonicalCode(err) != errcommon.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	errWithHeaders, ok := err.(errcommon.ErrorWithHeaders)
+	if !ok {
+		t.Fatalf("expected ErrorWithHeaders, got %T", err)
+	}
+	if diff := cmp.Diff(map[string]string{RetryAfterHeader: "2"}, errWithHeaders.Headers); diff != "" {
+		t.Errorf("unexpected headers (-want +got):\n%s", diff)
+	}
+
+	// other clients have their own bucket
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser("bob")); err != nil {
+		t.Fatalf("unexpected error for another client: %v", err)
+	}
+
+	// rejected requests don't consume tokens, so a single token is available after 2 seconds
+	now = now.Add(2 * time.Second)
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser("alice")); err != nil {
+		t.Fatalf("unexpected error after refill: %v", err)
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser("alice")); errcommon.CanonicalCode(err) != errcommon.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+}
+
+func TestTokenBucketLimiterPluginWithoutKey(t *testing.T) {
+	ctx := context.Background()
+	p, err := NewTokenBucketLimiterPlugin(1, 1, "user", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	// requests without the key field share a bucket
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser("")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser("")); errcommon.CanonicalCode(err) != errcommon.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+}
+
+func TestTokenBucketLimiterPluginWithCredentials(t *testing.T) {
+	ctx := context.Background()
+	p, err := NewTokenBucketLimiterPlugin(1, 1, "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	request := func(apiKey string, user string) *framework.InferenceRequest {
+		r := requestFromUser(user)
+		if apiKey != "" {
+			r.Headers["x-api-key"] = apiKey
+		}
+		return r
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request("key-a", "alice")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// changing the body field does not evade the limit of the credentials
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request("key-a", "bob")); errcommon.CanonicalCode(err) != errcommon.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request("key-b", "alice")); err != nil {
+		t.Fatalf("unexpected error for other credentials: %v", err)
+	}
+
+	// requests without credentials share a bucket
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request("", "alice")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request("", "bob")); errcommon.CanonicalCode(err) != errcommon.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+}
+
+func TestTokenBucketLimiterPluginWithKeyHeader(t *testing.T) {
+	ctx := context.Background()
+	p, err := NewTokenBucketLimiterPlugin(1, 1, "user", "X-Tenant-Id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	request := func(tenant string, user string) *framework.InferenceRequest {
+		r := requestFromUser(user)
+		r.Headers["x-tenant-id"] = tenant
+		return r
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request("acme", "alice")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// changing the body field does not evade the limit of the tenant
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request("acme", "bob")); errcommon.CanonicalCode(err) != errcommon.ResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v", err)
+	}
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request("globex", "alice")); err != nil {
+		t.Fatalf("unexpected error for another tenant: %v", err)
+	}
+}
+
+func TestTokenBucketLimiterPluginEviction(t *testing.T) {
+	ctx := context.Background()
+	p, err := NewTokenBucketLimiterPlugin(1, 2, "user", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	for _, user := range []string{"alice", "bob", "carol"} {
+		if err := p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser(user)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// the buckets refill in 2 seconds, so the idle clients are forgotten
+	now = now.Add(2 * time.Second)
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), requestFromUser("alice")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := len(p.limiters); got != 1 {
+		t.Errorf("got %d buckets, want 1", got)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reasoningbudget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ReasoningBudgetPluginType = "reasoning-budget"
+	modelField                = "model"
+	reasoningEffortField      = "reasoning_effort"
+	maxCompletionTokensField  = "max_completion_tokens"
+	maxTokensField            = "max_tokens"
+
+	defaultReasoningEffort = "medium"
+	defaultMaxEffort       = "high"
+)
+
+// reasoningModel matches the names of o1/o3-style reasoning models.
+var reasoningModel = regexp.MustCompile(`^o[0-9]+`)
+
+// effortLevels ranks the supported reasoning_effort values.
+var effortLevels = map[string]int{"low": 0, "medium": 1, "high": 2}
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ReasoningBudgetPlugin{}
+
+// ReasoningBudgetConfig defines the JSON configuration structure for the plugin.
+type ReasoningBudgetConfig struct {
+	// DefaultReasoningEffort is injected when the request has no reasoning_effort. Defaults to "medium".
+	DefaultReasoningEffort string `json:"default_reasoning_effort"`
+	// MaxReasoningEffort caps the reasoning_effort requested by the client. Defaults to "high".
+	MaxReasoningEffort string `json:"max_reasoning_effort"`
+	// DefaultMaxCompletionTokens is injected when the request has neither max_completion_tokens nor max_tokens.
+	// Zero disables the injection.
+	DefaultMaxCompletionTokens int `json:"default_max_completion_tokens"`
+}
+
+// ReasoningBudgetPluginFactory defines the factory function for NewReasoningBudgetPlugin.
+func ReasoningBudgetPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ReasoningBudgetConfig{
+		DefaultReasoningEffort: defaultReasoningEffort,
+		MaxReasoningEffort:     defaultMaxEffort,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ReasoningBudgetPluginType, err)
+		}
+	}
+
+	plugin, err := NewReasoningBudgetPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ReasoningBudgetPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewReasoningBudgetPlugin initializes a new ReasoningBudgetPlugin and returns its pointer.
+func NewReasoningBudgetPlugin(config ReasoningBudgetConfig) (*ReasoningBudgetPlugin, error) {
+	defaultLevel, ok := effortLevels[config.DefaultReasoningEffort]
+	if !ok {
+		return nil, fmt.Errorf("unsupported default_reasoning_effort '%s' in ReasoningBudget plugin", config.DefaultReasoningEffort)
+	}
+	maxLevel, ok := effortLevels[config.MaxReasoningEffort]
+	if !ok {
+		return nil, fmt.Errorf("unsupported max_reasoning_effort '%s' in ReasoningBudget plugin", config.MaxReasoningEffort)
+	}
+	if defaultLevel > maxLevel {
+		return nil, errors.New("default_reasoning_effort must not exceed max_reasoning_effort in ReasoningBudget plugin")
+	}
+	if config.DefaultMaxCompletionTokens < 0 {
+		return nil, errors.New("default_max_completion_tokens must not be negative in ReasoningBudget plugin")
+	}
+
+	return &ReasoningBudgetPlugin{
+		typedName: plugin.TypedName{
+			Type: ReasoningBudgetPluginType,
+			Name: ReasoningBudgetPluginType,
+		},
+		defaultEffort:       config.DefaultReasoningEffort,
+		maxEffort:           config.MaxReasoningEffort,
+		maxCompletionTokens: config.DefaultMaxCompletionTokens,
+	}, nil
+}
+
+// ReasoningBudgetPlugin sets the reasoning parameters of requests to o1/o3-style reasoning models.
+// It injects a default reasoning_effort when absent, caps the reasoning_effort requested by the client,
+// and replaces the max_tokens parameter, which reasoning models reject, with max_completion_tokens.
+type ReasoningBudgetPlugin struct {
+	typedName           plugin.TypedName
+	defaultEffort       string
+	maxEffort           string
+	maxCompletionTokens int
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ReasoningBudgetPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ReasoningBudgetPlugin) WithName(name string) *ReasoningBudgetPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the reasoning parameters of requests to reasoning models.
+func (p *ReasoningBudgetPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	model, _ := request.Body[modelField].(string)
+	if !reasoningModel.MatchString(model) {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+
+	switch effort, ok := request.Body[reasoningEffortField].(string); {
+	case !ok:
+		request.SetBodyField(reasoningEffortField, p.defaultEffort)
+		logger.V(logutil.VERBOSE).Info("injected the default reasoning effort", "model", model, "reasoningEffort", p.defaultEffort)
+	case effortLevels[effort] > effortLevels[p.maxEffort]: // unknown values are left for the model server to reject
+		request.SetBodyField(reasoningEffortField, p.maxEffort)
+		logger.V(logutil.VERBOSE).Info("capped the reasoning effort", "model", model, "requested", effort, "reasoningEffort", p.maxEffort)
+	}
+
+	if _, ok := request.Body[maxCompletionTokensField]; !ok {
+		if maxTokens, ok := request.Body[maxTokensField]; ok {
+			request.RemoveBodyField(maxTokensField)
+			request.SetBodyField(maxCompletionTokensField, maxTokens)
+		} else if p.maxCompletionTokens > 0 {
+			request.SetBodyField(maxCompletionTokensField, p.maxCompletionTokens)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reasoningbudget
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestReasoningBudgetPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "valid config", rawParams: json.RawMessage(`{"default_reasoning_effort":"low","max_reasoning_effort":"medium","default_max_completion_tokens":4096}`)},
+		{name: "unsupported effort", rawParams: json.RawMessage(`{"default_reasoning_effort":"extreme"}`), wantErr: true},
+		{name: "default above max", rawParams: json.RawMessage(`{"default_reasoning_effort":"high","max_reasoning_effort":"low"}`), wantErr: true},
+		{name: "negative max completion tokens", rawParams: json.RawMessage(`{"default_max_completion_tokens":-1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ReasoningBudgetPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestReasoningBudgetPlugin(t *testing.T) {
+	p, err := NewReasoningBudgetPlugin(ReasoningBudgetConfig{
+		DefaultReasoningEffort:     "low",
+		MaxReasoningEffort:         "medium",
+		DefaultMaxCompletionTokens: 4096,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		body        map[string]any
+		wantBody    map[string]any
+		wantMutated bool
+	}{
+		{
+			name:        "defaults are injected",
+			body:        map[string]any{"model": "o1-preview"},
+			wantBody:    map[string]any{"model": "o1-preview", "reasoning_effort": "low", "max_completion_tokens": 4096},
+			wantMutated: true,
+		},
+		{
+			name:        "effort above max is capped",
+			body:        map[string]any{"model": "o3-mini", "reasoning_effort": "high", "max_completion_tokens": 100.0},
+			wantBody:    map[string]any{"model": "o3-mini", "reasoning_effort": "medium", "max_completion_tokens": 100.0},
+			wantMutated: true,
+		},
+		{
+			name:        "max_tokens is replaced by max_completion_tokens",
+			body:        map[string]any{"model": "o1", "reasoning_effort": "low", "max_tokens": 200.0},
+			wantBody:    map[string]any{"model": "o1", "reasoning_effort": "low", "max_completion_tokens": 200.0},
+			wantMutated: true,
+		},
+		{
+			name:     "valid request is not mutated",
+			body:     map[string]any{"model": "o1", "reasoning_effort": "medium", "max_completion_tokens": 100.0},
+			wantBody: map[string]any{"model": "o1", "reasoning_effort": "medium", "max_completion_tokens": 100.0},
+		},
+		{
+			name:     "other models are ignored",
+			body:     map[string]any{"model": "gpt-4o", "max_tokens": 200.0},
+			wantBody: map[string]any{"model": "gpt-4o", "max_tokens": 200.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+			if got := request.BodyMutated(); got != tt.wantMutated {
+				t.Errorf("BodyMutated() = %v, want %v", got, tt.wantMutated)
+			}
+		})
+	}
+}
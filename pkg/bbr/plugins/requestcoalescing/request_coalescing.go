@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcoalescing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/credential"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	RequestCoalescingPluginType = "request-coalescing"
+	CoalescedHeader             = "X-BBR-Coalesced"
+
+	defaultMaxWaitSeconds     = 30
+	defaultMaxPendingRequests = 10000
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &RequestCoalescingPlugin{}
+	_ framework.ResponseProcessor = &RequestCoalescingPlugin{}
+	_ framework.RequestCompleter  = &RequestCoalescingPlugin{}
+)
+
+// RequestCoalescingConfig defines the JSON configuration structure for the plugin.
+type RequestCoalescingConfig struct {
+	// MaxWaitSeconds is the maximum duration a request waits for the response of an identical
+	// in-flight request, before it is forwarded upstream on its own. Defaults to 30.
+	MaxWaitSeconds int `json:"max_wait_seconds"`
+	// MaxPendingRequests is the maximum number of in-flight requests that identical requests can wait for.
+	// Beyond it, requests are forwarded upstream without being coalesced. Defaults to 10000.
+	MaxPendingRequests int `json:"max_pending_requests"`
+}
+
+// RequestCoalescingPluginFactory defines the factory function for NewRequestCoalescingPlugin.
+func RequestCoalescingPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := RequestCoalescingConfig{MaxWaitSeconds: defaultMaxWaitSeconds, MaxPendingRequests: defaultMaxPendingRequests}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", RequestCoalescingPluginType, err)
+		}
+	}
+
+	plugin, err := NewRequestCoalescingPlugin(time.Duration(config.MaxWaitSeconds)*time.Second, config.MaxPendingRequests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", RequestCoalescingPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewRequestCoalescingPlugin initializes a new RequestCoalescingPlugin and returns its pointer.
+func NewRequestCoalescingPlugin(maxWait time.Duration, maxPending int) (*RequestCoalescingPlugin, error) {
+	if maxWait <= 0 {
+		return nil, errors.New("max_wait_seconds must be positive in RequestCoalescing plugin")
+	}
+	if maxPending <= 0 {
+		return nil, errors.New("max_pending_requests must be positive in RequestCoalescing plugin")
+	}
+
+	return &RequestCoalescingPlugin{
+		typedName: plugin.TypedName{
+			Type: RequestCoalescingPluginType,
+			Name: RequestCoalescingPluginType,
+		},
+		maxWait:    maxWait,
+		maxPending: maxPending,
+		pending:    map[string]*flight{},
+		now:        time.Now,
+	}, nil
+}
+
+// RequestCoalescingPlugin coalesces identical non-streaming requests that are in flight at the same
+// time. Requests are only identical if they carry the same credentials. The first request is forwarded
+// upstream, and identical requests that arrive before its response wait for it. When the response arrives,
+// it is sent to all the waiting requests as an immediate response. Only successful (2xx) responses are shared;
+// the waiting requests are forwarded upstream when the response is not successful, or when the forwarded
+// request ends without a response.
+type RequestCoalescingPlugin struct {
+	typedName  plugin.TypedName
+	maxWait    time.Duration
+	maxPending int
+
+	lock    sync.Mutex
+	pending map[string]*flight
+	now     func() time.Time
+}
+
+// flight is an in-flight request that identical requests can wait for.
+type flight struct {
+	key     string
+	started time.Time
+	done    chan struct{}
+	once    sync.Once
+	// body is the response body, set before done is closed. It is nil if the response can not be shared.
+	body []byte
+}
+
+// release sets the response body of the flight and wakes up the requests waiting for it, once.
+func (f *flight) release(body []byte) {
+	f.once.Do(func() {
+		f.body = body
+		close(f.done)
+	})
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *RequestCoalescingPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *RequestCoalescingPlugin) WithName(name string) *RequestCoalescingPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest forwards the first of identical requests, and makes the following ones wait for its response.
+func (p *RequestCoalescingPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if stream, _ := request.Body["stream"].(bool); stream {
+		return nil // streamed responses are not coalesced
+	}
+
+	// json.Marshal sorts the map keys, so identical bodies have identical keys
+	raw, err := json.Marshal(request.Body)
+	if err != nil {
+		return nil
+	}
+	key := credential.Hash(request) + "/" + strconv.FormatUint(xxhash.Sum64(raw), 16)
+
+	p.lock.Lock()
+	inFlight, ok := p.pending[key]
+	if !ok || p.expired(inFlight) {
+		// no identical request is in flight, or its response got lost
+		if !ok && len(p.pending) >= p.maxPending {
+			p.pruneExpired()
+		}
+		if !ok && len(p.pending) >= p.maxPending {
+			p.lock.Unlock()
+			return nil // too many requests in flight, forward the request without coalescing it
+		}
+		forwarded := &flight{key: key, started: p.now(), done: make(chan struct{})}
+		p.pending[key] = forwarded
+		p.lock.Unlock()
+		cycleState.Write(p.typedName.String(), forwarded)
+		return nil
+	}
+	p.lock.Unlock()
+
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	logger.Info("waiting for the response of an identical in-flight request")
+	timer := time.NewTimer(p.maxWait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		logger.Info("timed out waiting for an identical in-flight request, forwarding the request")
+		return nil
+	case <-inFlight.done:
+	}
+
+	if inFlight.body == nil {
+		return nil // the response can not be shared, forward the request
+	}
+	body := make([]byte, len(inFlight.body))
+	copy(body, inFlight.body)
+	return errcommon.ImmediateResponse{
+		Headers: map[string]string{"content-type": "application/json", CoalescedHeader: "true"},
+		Body:    body,
+	}
+}
+
+// ProcessResponse shares the response of a forwarded request with the identical requests waiting for it.
+func (p *RequestCoalescingPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if cycleState == nil {
+		return nil
+	}
+
+	forwarded, err := framework.ReadCycleStateKey[*flight](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not forwarded by this plugin
+	}
+
+	var body []byte
+	if response != nil && response.Body != nil && response.IsSuccess() {
+		body, _ = json.Marshal(response.Body)
+	}
+
+	p.release(cycleState, forwarded, body)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("shared the response with identical requests", "shared", body != nil)
+
+	return nil
+}
+
+// CompleteRequest releases the in-flight request if it ended without a response, e.g. when the upstream failed
+// or the client disconnected, so that the identical requests waiting for it are forwarded upstream.
+func (p *RequestCoalescingPlugin) CompleteRequest(_ context.Context, cycleState *framework.CycleState) {
+	if cycleState == nil {
+		return
+	}
+	forwarded, err := framework.ReadCycleStateKey[*flight](cycleState, p.typedName.String())
+	if err != nil {
+		return // the request was not forwarded by this plugin, or its response was already shared
+	}
+	p.release(cycleState, forwarded, nil)
+}
+
+// release removes the forwarded request from the in-flight requests and wakes up the requests waiting for it.
+func (p *RequestCoalescingPlugin) release(cycleState *framework.CycleState, forwarded *flight, body []byte) {
+	cycleState.Delete(p.typedName.String())
+
+	p.lock.Lock()
+	if p.pending[forwarded.key] == forwarded {
+		delete(p.pending, forwarded.key)
+	}
+	p.lock.Unlock()
+
+	forwarded.release(body)
+}
+
+// expired returns whether the in-flight request is older than the maximum wait, so that its response is
+// considered lost. It must be called with the lock held.
+func (p *RequestCoalescingPlugin) expired(inFlight *flight) bool {
+	return p.now().Sub(inFlight.started) > p.maxWait
+}
+
+// pruneExpired removes the expired in-flight requests. It must be called with the lock held.
+func (p *RequestCoalescingPlugin) pruneExpired() {
+	for key, inFlight := range p.pending {
+		if p.expired(inFlight) {
+			delete(p.pending, key)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestcoalescing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestRequestCoalescingPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "valid config", rawParams: json.RawMessage(`{"max_wait_seconds":10}`)},
+		{name: "invalid max wait", rawParams: json.RawMessage(`{"max_wait_seconds":0}`), wantErr: true},
+		{name: "invalid max pending requests", rawParams: json.RawMessage(`{"max_pending_requests":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := RequestCoalescingPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func newRequest(prompt string) *framework.InferenceRequest {
+	request := framework.NewInferenceRequest()
+	request.Body["model"] = "llama"
+	request.Body["prompt"] = prompt
+	return request
+}
+
+// startFollower processes an identical request in the background, once the leader is in flight.
+func startFollower(ctx context.Context, p *RequestCoalescingPlugin, prompt string) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- p.ProcessRequest(ctx, framework.NewCycleState(), newRequest(prompt))
+	}()
+	return result
+}
+
+func TestRequestCoalescingPlugin(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       string
+		response     map[string]any
+		wantCode     string
+		wantResponse string
+	}{
+		{
+			name:         "response is shared",
+			status:       "200",
+			response:     map[string]any{"choices": []any{map[string]any{"text": "hi"}}},
+			wantCode:     errcommon.OK,
+			wantResponse: `{"choices":[{"text":"hi"}]}`,
+		},
+		{
+			name:     "error response is not shared",
+			status:   "503",
+			response: map[string]any{"error": map[string]any{"message": "oops"}},
+		},
+		{
+			name:     "error response without an error field is not shared",
+			status:   "400",
+			response: map[string]any{"object": "error", "message": "oops"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewRequestCoalescingPlugin(time.Minute, 10)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			ctx := context.Background()
+
+			leaderState := framework.NewCycleState()
+			if err := p.ProcessRequest(ctx, leaderState, newRequest("hello")); err != nil {
+				t.Fatalf("unexpected error for the first request: %v", err)
+			}
+			// a different request is not coalesced
+			if err := p.ProcessRequest(ctx, framework.NewCycleState(), newRequest("bye")); err != nil {
+				t.Fatalf("unexpected error for a different request: %v", err)
+			}
+
+			follower := startFollower(ctx, p, "hello")
+			select {
+			case err := <-follower:
+				t.Fatalf("identical request did not wait for the first one: %v", err)
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			response := framework.NewInferenceResponse()
+			response.Headers[framework.StatusHeader] = tt.status
+			response.Body = tt.response
+			if err := p.ProcessResponse(ctx, leaderState, response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			err = <-follower
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var immediate errcommon.ImmediateResponse
+			if !errors.As(err, &immediate) {
+				t.Fatalf("expected an immediate response, got %v", err)
+			}
+			if got := string(immediate.Body); got != tt.wantResponse {
+				t.Errorf("Body = %s, want %s", got, tt.wantResponse)
+			}
+			if got := immediate.Headers[CoalescedHeader]; got != "true" {
+				t.Errorf("Headers[%q] = %q, want %q", CoalescedHeader, got, "true")
+			}
+		})
+	}
+}
+
+func TestRequestCoalescingPlugin_WaitTimeout(t *testing.T) {
+	p, err := NewRequestCoalescingPlugin(20*time.Millisecond, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), newRequest("hello")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the response of the first request never arrives, so the identical request is forwarded
+	if err := <-startFollower(context.Background(), p, "hello"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRequestCoalescingPlugin_Cancellation(t *testing.T) {
+	p, err := NewRequestCoalescingPlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), newRequest("hello")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	follower := startFollower(ctx, p, "hello")
+	cancel()
+	if err := <-follower; !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRequestCoalescingPlugin_Streaming(t *testing.T) {
+	p, err := NewRequestCoalescingPlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	for range 2 {
+		request := newRequest("hello")
+		request.Body["stream"] = true
+		if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+			t.Fatalf("streaming requests should not be coalesced: %v", err)
+		}
+	}
+}
+
+func TestRequestCoalescingPlugin_Credentials(t *testing.T) {
+	p, err := NewRequestCoalescingPlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	ctx := context.Background()
+
+	request := newRequest("hello")
+	request.Headers["Authorization"] = "Bearer alice"
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// an identical request of another client is forwarded without waiting
+	request = newRequest("hello")
+	request.Headers["Authorization"] = "Bearer bob"
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := len(p.pending); got != 2 {
+		t.Errorf("pending requests = %d, want 2", got)
+	}
+}
+
+func TestRequestCoalescingPlugin_CompleteRequest(t *testing.T) {
+	p, err := NewRequestCoalescingPlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	ctx := context.Background()
+
+	leaderState := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, leaderState, newRequest("hello")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	follower := startFollower(ctx, p, "hello")
+	select {
+	case err := <-follower:
+		t.Fatalf("identical request did not wait for the first one: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// the first request ends without a response, e.g. the upstream failed
+	p.CompleteRequest(ctx, leaderState)
+	if err := <-follower; err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if got := len(p.pending); got != 0 {
+		t.Errorf("pending requests = %d, want 0", got)
+	}
+	// completing the request again, or after its response, is a no-op
+	p.CompleteRequest(ctx, leaderState)
+}
+
+func TestRequestCoalescingPlugin_MaxPendingRequests(t *testing.T) {
+	p, err := NewRequestCoalescingPlugin(time.Minute, 1)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	if err := p.ProcessRequest(ctx, framework.NewCycleState(), newRequest("hello")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the map is full, so the request is forwarded without being coalesced
+	state := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, state, newRequest("bye")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := framework.ReadCycleStateKey[*flight](state, p.TypedName().String()); err == nil {
+		t.Error("request was coalesced beyond the maximum pending requests")
+	}
+
+	// once the first request expired, it is pruned to make room
+	now = now.Add(2 * time.Minute)
+	state = framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, state, newRequest("bye")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := framework.ReadCycleStateKey[*flight](state, p.TypedName().String()); err != nil {
+		t.Errorf("request was not coalesced after the expired requests were pruned: %v", err)
+	}
+	if got := len(p.pending); got != 1 {
+		t.Errorf("pending requests = %d, want 1", got)
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestdedup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	DeduplicatorPluginType = "deduplicator"
+	// DeduplicationKeyHeader is the cache key of the response, set on cached and replayed responses, which can
+	// be used to evict the response from the cache.
+	DeduplicationKeyHeader = "X-BBR-Deduplication-Key"
+
+	defaultDeduplicatorTTLSeconds = 60
+	defaultDeduplicatorMaxEntries = 1000
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &DeduplicatorPlugin{}
+	_ framework.ResponseProcessor = &DeduplicatorPlugin{}
+	_ framework.CacheEvictor      = &DeduplicatorPlugin{}
+)
+
+// cacheKeyFields are the body fields that determine the model output of a request: the model, the prompt,
+// the sampling parameters, the tools and output format, and the end user.
+var cacheKeyFields = []string{
+	"model", "prompt", "messages", "temperature", "top_p", "top_k", "seed", "n", "stop",
+	"max_tokens", "max_completion_tokens", "presence_penalty", "frequency_penalty", "logit_bias",
+	"tools", "tool_choice", "functions", "function_call", "response_format", "user",
+}
+
+// DeduplicatorConfig defines the JSON configuration structure for the plugin.
+type DeduplicatorConfig struct {
+	// TTLSeconds is the duration a response is replayed to identical requests. Defaults to 60.
+	TTLSeconds int `json:"ttl_seconds"`
+	// MaxEntries bounds the number of responses kept in memory. Defaults to 1000.
+	MaxEntries int `json:"max_entries"`
+}
+
+// DeduplicatorPluginFactory defines the factory function for NewDeduplicatorPlugin.
+func DeduplicatorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := DeduplicatorConfig{
+		TTLSeconds: defaultDeduplicatorTTLSeconds,
+		MaxEntries: defaultDeduplicatorMaxEntries,
+	}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", DeduplicatorPluginType, err)
+		}
+	}
+
+	plugin, err := NewDeduplicatorPlugin(time.Duration(config.TTLSeconds)*time.Second, config.MaxEntries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", DeduplicatorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewDeduplicatorPlugin initializes a new DeduplicatorPlugin and returns its pointer.
+func NewDeduplicatorPlugin(ttl time.Duration, maxEntries int) (*DeduplicatorPlugin, error) {
+	if ttl <= 0 {
+		return nil, errors.New("ttl must be positive in Deduplicator plugin")
+	}
+	if maxEntries <= 0 {
+		return nil, errors.New("maxEntries must be positive in Deduplicator plugin")
+	}
+
+	return &DeduplicatorPlugin{
+		typedName: plugin.TypedName{
+			Type: DeduplicatorPluginType,
+			Name: DeduplicatorPluginType,
+		},
+		responses: expirable.NewLRU[uint64, []byte](maxEntries, nil, ttl),
+	}, nil
+}
+
+// DeduplicatorPlugin answers requests that are identical to a recent request, e.g. retries of clients that
+// timed out, with the response of that request, bypassing the backend. Requests are identified by a hash of
+// their credentials and of the body fields determining their output. Only the responses of deterministic
+// requests, i.e. requests with temperature 0, are cached, by the response chain; only successful (2xx)
+// responses are cached, and streamed responses are not.
+type DeduplicatorPlugin struct {
+	typedName plugin.TypedName
+	// responses maps a request cache key to the response body of the request. The LRU is thread safe.
+	responses *expirable.LRU[uint64, []byte]
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *DeduplicatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *DeduplicatorPlugin) WithName(name string) *DeduplicatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest answers the request with the cached response of an identical request, if any.
+// Otherwise, it keeps the cache key of the request for ProcessResponse.
+func (p *DeduplicatorPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if stream, _ := request.Body["stream"].(bool); stream {
+		return nil // streamed responses are not cached
+	}
+	if temperature, ok := request.Body["temperature"].(float64); !ok || temperature != 0 {
+		return nil // the response is sampled, identical requests may get different responses
+	}
+
+	key, err := requestFingerprint(request, cacheKeyFields)
+	if err != nil {
+		return fmt.Errorf("failed to compute request cache key - %w", err)
+	}
+
+	body, found := p.responses.Get(key)
+	if !found {
+		cycleState.Write(p.typedName.String(), key)
+		return nil
+	}
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("replaying the cached response of an identical request", "key", formatKey(key))
+	replayed := make([]byte, len(body))
+	copy(replayed, body)
+	return errcommon.ImmediateResponse{
+		Headers: map[string]string{
+			"content-type":         "application/json",
+			DuplicateRequestHeader: "true",
+			DeduplicationKeyHeader: formatKey(key),
+		},
+		Body: replayed,
+	}
+}
+
+// ProcessResponse caches the response of a request that was forwarded to the backend.
+func (p *DeduplicatorPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+	key, err := framework.ReadCycleStateKey[uint64](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request is not cacheable
+	}
+	if !response.IsSuccess() || response.IsEventStream() {
+		return nil
+	}
+
+	body, err := json.Marshal(response.Body)
+	if err != nil {
+		return nil
+	}
+	p.responses.Add(key, body)
+	response.SetHeader(DeduplicationKeyHeader, formatKey(key))
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("cached the response", "key", formatKey(key))
+	return nil
+}
+
+// Evict removes the cached response with the given key, as set in the DeduplicationKeyHeader, or all the
+// cached responses if the key is empty.
+func (p *DeduplicatorPlugin) Evict(key string) int {
+	if key == "" {
+		evicted := p.responses.Len()
+		p.responses.Purge()
+		return evicted
+	}
+	parsed, err := strconv.ParseUint(key, 16, 64)
+	if err != nil || !p.responses.Remove(parsed) {
+		return 0
+	}
+	return 1
+}
+
+func formatKey(key uint64) string {
+	return fmt.Sprintf("%016x", key)
+}
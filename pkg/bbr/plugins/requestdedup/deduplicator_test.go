@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestdedup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestDeduplicatorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "defaults",
+			rawParams: nil,
+		},
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"ttl_seconds":10,"max_entries":100}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "zero ttl",
+			rawParams: json.RawMessage(`{"ttl_seconds":0}`),
+			wantErr:   true,
+		},
+		{
+			name:      "negative max entries",
+			rawParams: json.RawMessage(`{"max_entries":-1}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := DeduplicatorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+			if got := p.TypedName().Type; got != DeduplicatorPluginType {
+				t.Errorf("Type = %q, want %q", got, DeduplicatorPluginType)
+			}
+		})
+	}
+}
+
+// process runs the request through the plugin, and the given response when the request is forwarded.
+// It returns the immediate response of the plugin, if any.
+func process(t *testing.T, p *DeduplicatorPlugin, body map[string]any, responseBody map[string]any) *errcommon.ImmediateResponse {
+	t.Helper()
+	return processRequest(t, p, newRequest("req", body), responseBody)
+}
+
+// processRequest is process for a request with headers.
+func processRequest(t *testing.T, p *DeduplicatorPlugin, request *framework.InferenceRequest, responseBody map[string]any) *errcommon.ImmediateResponse {
+	t.Helper()
+	return processWithStatus(t, p, request, "200", responseBody)
+}
+
+// processWithStatus is processRequest for a response with the given status code.
+func processWithStatus(t *testing.T, p *DeduplicatorPlugin, request *framework.InferenceRequest, status string, responseBody map[string]any) *errcommon.ImmediateResponse {
+	t.Helper()
+	cycleState := framework.NewCycleState()
+	err := p.ProcessRequest(context.Background(), cycleState, request)
+	var immediate errcommon.ImmediateResponse
+	if errors.As(err, &immediate) {
+		return &immediate
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	response := framework.NewInferenceResponse()
+	response.Headers[framework.StatusHeader] = status
+	response.Body = responseBody
+	if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return nil
+}
+
+func TestDeduplicatorPlugin(t *testing.T) {
+	body := map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "max_tokens": 10.0}
+	response := map[string]any{"id": "cmpl-1", "choices": []any{map[string]any{"text": "hi"}}}
+
+	tests := []struct {
+		name         string
+		first        map[string]any
+		firstStatus  string
+		firstResp    map[string]any
+		second       map[string]any
+		wantReplayed bool
+	}{
+		{
+			name:         "identical request",
+			first:        body,
+			firstResp:    response,
+			second:       body,
+			wantReplayed: true,
+		},
+		{
+			name:         "ignored fields differ",
+			first:        body,
+			firstResp:    response,
+			second:       map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "max_tokens": 10.0, "stream": false},
+			wantReplayed: true,
+		},
+		{
+			name:      "sampling parameters differ",
+			first:     body,
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "max_tokens": 20.0},
+		},
+		{
+			name:      "tools differ",
+			first:     body,
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "max_tokens": 10.0, "tools": []any{"search"}},
+		},
+		{
+			name:      "response format differs",
+			first:     body,
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "max_tokens": 10.0, "response_format": map[string]any{"type": "json_object"}},
+		},
+		{
+			name:      "end user differs",
+			first:     body,
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "max_tokens": 10.0, "user": "bob"},
+		},
+		{
+			name:      "sampled requests are not cached",
+			first:     map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.7},
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.7},
+		},
+		{
+			name:      "requests with the default temperature are not cached",
+			first:     map[string]any{"model": "llama", "prompt": "hello"},
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello"},
+		},
+		{
+			name:        "error responses are not cached",
+			first:       body,
+			firstStatus: "503",
+			firstResp:   map[string]any{"error": map[string]any{"message": "overloaded"}},
+			second:      body,
+		},
+		{
+			name:        "error responses without an error field are not cached",
+			first:       body,
+			firstStatus: "400",
+			firstResp:   map[string]any{"object": "error", "message": "invalid prompt"},
+			second:      body,
+		},
+		{
+			name:      "streamed requests are not cached",
+			first:     map[string]any{"model": "llama", "prompt": "hello", "stream": true},
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "stream": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewDeduplicatorPlugin(time.Minute, 10)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			firstStatus := tt.firstStatus
+			if firstStatus == "" {
+				firstStatus = "200"
+			}
+			if immediate := processWithStatus(t, p, newRequest("req", tt.first), firstStatus, tt.firstResp); immediate != nil {
+				t.Fatal("first request should be forwarded")
+			}
+			immediate := process(t, p, tt.second, response)
+			if !tt.wantReplayed {
+				if immediate != nil {
+					t.Errorf("second request should be forwarded, got replayed response %s", immediate.Body)
+				}
+				return
+			}
+			if immediate == nil {
+				t.Fatal("second request should be answered with the cached response")
+			}
+			var replayed map[string]any
+			if err := json.Unmarshal(immediate.Body, &replayed); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if replayed["id"] != "cmpl-1" {
+				t.Errorf("replayed body = %s, want the cached response", immediate.Body)
+			}
+			if got := immediate.Headers[DuplicateRequestHeader]; got != "true" {
+				t.Errorf("Headers[%q] = %q, want %q", DuplicateRequestHeader, got, "true")
+			}
+			if immediate.Headers[DeduplicationKeyHeader] == "" {
+				t.Errorf("Headers[%q] is empty", DeduplicationKeyHeader)
+			}
+		})
+	}
+}
+
+func TestDeduplicatorPlugin_Credentials(t *testing.T) {
+	body := map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0}
+	response := map[string]any{"id": "cmpl-1"}
+	p, err := NewDeduplicatorPlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	withAPIKey := func(apiKey string) *framework.InferenceRequest {
+		request := newRequest("req", body)
+		request.Headers["X-API-Key"] = apiKey
+		return request
+	}
+
+	if immediate := processRequest(t, p, withAPIKey("alice"), response); immediate != nil {
+		t.Fatal("first request should be forwarded")
+	}
+	if immediate := processRequest(t, p, withAPIKey("bob"), response); immediate != nil {
+		t.Error("the identical request of another client should be forwarded")
+	}
+	if immediate := processRequest(t, p, withAPIKey("alice"), response); immediate == nil {
+		t.Error("the identical request of the same client should be answered with the cached response")
+	}
+}
+
+func TestDeduplicatorPlugin_Evict(t *testing.T) {
+	p, err := NewDeduplicatorPlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	response := map[string]any{"id": "cmpl-1"}
+	first := map[string]any{"model": "llama", "prompt": "first", "temperature": 0.0}
+	second := map[string]any{"model": "llama", "prompt": "second", "temperature": 0.0}
+	process(t, p, first, response)
+	process(t, p, second, response)
+
+	immediate := process(t, p, first, response)
+	if immediate == nil {
+		t.Fatal("request should be answered with the cached response")
+	}
+	key := immediate.Headers[DeduplicationKeyHeader]
+
+	if got := p.Evict("not-a-key"); got != 0 {
+		t.Errorf("Evict(invalid key) = %d, want 0", got)
+	}
+	if got := p.Evict(key); got != 1 {
+		t.Errorf("Evict(%q) = %d, want 1", key, got)
+	}
+	if got := p.Evict(key); got != 0 {
+		t.Errorf("Evict(%q) twice = %d, want 0", key, got)
+	}
+	if immediate := process(t, p, first, response); immediate != nil {
+		t.Error("evicted request should be forwarded")
+	}
+	// the forwarded request cached its response again
+	if got := p.Evict(""); got != 2 {
+		t.Errorf("Evict(all) = %d, want 2", got)
+	}
+	if immediate := process(t, p, second, response); immediate != nil {
+		t.Error("evicted request should be forwarded")
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestdedup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/credential"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	RequestDeduplicationPluginType = "request-deduplication"
+	DuplicateRequestHeader         = "X-BBR-Duplicate-Request"
+	DuplicateOfHeader              = "X-BBR-Duplicate-Of"
+
+	defaultTTLSeconds = 5
+	defaultMaxEntries = 10000
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &RequestDeduplicationPlugin{}
+
+// RequestDeduplicationConfig defines the JSON configuration structure for the plugin.
+type RequestDeduplicationConfig struct {
+	// TTLSeconds is the window in which an identical request is considered a duplicate.
+	TTLSeconds int `json:"ttl_seconds"`
+	// MaxEntries bounds the number of request fingerprints kept in memory.
+	MaxEntries int `json:"max_entries"`
+}
+
+// RequestDeduplicationPluginFactory defines the factory function for NewRequestDeduplicationPlugin.
+func RequestDeduplicationPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := RequestDeduplicationConfig{
+		TTLSeconds: defaultTTLSeconds,
+		MaxEntries: defaultMaxEntries,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", RequestDeduplicationPluginType, err)
+		}
+	}
+
+	plugin, err := NewRequestDeduplicationPlugin(time.Duration(config.TTLSeconds)*time.Second, config.MaxEntries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", RequestDeduplicationPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewRequestDeduplicationPlugin initializes a new RequestDeduplicationPlugin and returns its pointer.
+func NewRequestDeduplicationPlugin(ttl time.Duration, maxEntries int) (*RequestDeduplicationPlugin, error) {
+	if ttl <= 0 {
+		return nil, errors.New("ttl must be positive in RequestDeduplication plugin")
+	}
+	if maxEntries <= 0 {
+		return nil, errors.New("maxEntries must be positive in RequestDeduplication plugin")
+	}
+
+	return &RequestDeduplicationPlugin{
+		typedName: plugin.TypedName{
+			Type: RequestDeduplicationPluginType,
+			Name: RequestDeduplicationPluginType,
+		},
+		seen: expirable.NewLRU[uint64, string](maxEntries, nil, ttl),
+	}, nil
+}
+
+// RequestDeduplicationPlugin marks requests that are identical to a request seen within a short window.
+// Two requests are identical if they share the model, prompt (or messages), temperature and seed, and the
+// credentials, so that the request ids of a client are never disclosed to other clients.
+// The plugin only annotates the request; backends decide whether to short-circuit processing.
+type RequestDeduplicationPlugin struct {
+	typedName plugin.TypedName
+	// seen maps a request fingerprint to the request id of the first request with that fingerprint.
+	seen *expirable.LRU[uint64, string]
+	// lock makes the lookup and insertion of a fingerprint atomic.
+	lock sync.Mutex
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *RequestDeduplicationPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *RequestDeduplicationPlugin) WithName(name string) *RequestDeduplicationPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the duplicate headers if an identical request was seen within the TTL.
+func (p *RequestDeduplicationPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	fingerprint, err := requestFingerprint(request, fingerprintFields)
+	if err != nil {
+		return fmt.Errorf("failed to compute request fingerprint - %w", err)
+	}
+
+	p.lock.Lock()
+	firstRequestID, found := p.seen.Get(fingerprint)
+	if !found {
+		p.seen.Add(fingerprint, request.GetHeader(reqcommon.RequestIdHeaderKey))
+	}
+	p.lock.Unlock()
+
+	if !found {
+		return nil
+	}
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("detected duplicate request", "duplicateOf", firstRequestID)
+	request.SetHeader(DuplicateRequestHeader, "true")
+	if firstRequestID != "" {
+		request.SetHeader(DuplicateOfHeader, firstRequestID)
+	}
+
+	return nil
+}
+
+// fingerprintFields are the body fields that identify a duplicate request.
+var fingerprintFields = []string{"model", "prompt", "messages", "temperature", "seed"}
+
+// requestFingerprint hashes the credentials of the request and the given body fields, e.g. the fields that
+// determine the model output of a request. Map keys are marshaled in sorted order, so the result is deterministic.
+func requestFingerprint(request *framework.InferenceRequest, keys []string) (uint64, error) {
+	fields := map[string]any{}
+	for _, key := range keys {
+		if value, ok := request.Body[key]; ok {
+			fields[key] = value
+		}
+	}
+
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return 0, err
+	}
+	digest := xxhash.New()
+	_, _ = digest.WriteString(credential.Hash(request))
+	_, _ = digest.Write(raw)
+	return digest.Sum64(), nil
+}
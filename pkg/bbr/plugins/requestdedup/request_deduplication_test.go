@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestdedup
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	reqcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/request"
+)
+
+func newRequest(requestID string, body map[string]any) *framework.InferenceRequest {
+	r := framework.NewInferenceRequest()
+	r.Headers[reqcommon.RequestIdHeaderKey] = requestID
+	for k, v := range body {
+		r.Body[k] = v
+	}
+	return r
+}
+
+func TestRequestDeduplicationPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "defaults",
+			rawParams: nil,
+		},
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"ttl_seconds":10,"max_entries":100}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "zero ttl",
+			rawParams: json.RawMessage(`{"ttl_seconds":0}`),
+			wantErr:   true,
+		},
+		{
+			name:      "negative max entries",
+			rawParams: json.RawMessage(`{"max_entries":-1}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := RequestDeduplicationPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+			if got := p.TypedName().Type; got != RequestDeduplicationPluginType {
+				t.Errorf("Type = %q, want %q", got, RequestDeduplicationPluginType)
+			}
+		})
+	}
+}
+
+func TestRequestDeduplicationPlugin_ProcessRequest(t *testing.T) {
+	body := map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "seed": 42}
+
+	tests := []struct {
+		name          string
+		second        map[string]any
+		secondHeaders map[string]string
+		wantDuplicate bool
+	}{
+		{
+			name:          "identical request",
+			second:        body,
+			wantDuplicate: true,
+		},
+		{
+			name:   "different prompt",
+			second: map[string]any{"model": "llama", "prompt": "bye", "temperature": 0.0, "seed": 42},
+		},
+		{
+			name:   "different seed",
+			second: map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "seed": 7},
+		},
+		{
+			name:          "ignored fields differ",
+			second:        map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "seed": 42, "user": "bob"},
+			wantDuplicate: true,
+		},
+		{
+			name:          "identical request of another client",
+			second:        body,
+			secondHeaders: map[string]string{"Authorization": "Bearer bob"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewRequestDeduplicationPlugin(time.Minute, 10)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+
+			first := newRequest("req-1", body)
+			if err := p.ProcessRequest(context.Background(), nil, first); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, ok := first.Headers[DuplicateRequestHeader]; ok {
+				t.Errorf("first request should not be marked as duplicate")
+			}
+
+			second := newRequest("req-2", tt.second)
+			for k, v := range tt.secondHeaders {
+				second.Headers[k] = v
+			}
+			if err := p.ProcessRequest(context.Background(), nil, second); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantDuplicate {
+				if got := second.Headers[DuplicateRequestHeader]; got != "true" {
+					t.Errorf("Headers[%q] = %q, want %q", DuplicateRequestHeader, got, "true")
+				}
+				if got := second.Headers[DuplicateOfHeader]; got != "req-1" {
+					t.Errorf("Headers[%q] = %q, want %q", DuplicateOfHeader, got, "req-1")
+				}
+			} else if _, ok := second.Headers[DuplicateRequestHeader]; ok {
+				t.Errorf("request should not be marked as duplicate")
+			}
+		})
+	}
+}
+
+func TestRequestDeduplicationPlugin_RequestIDHeaderCase(t *testing.T) {
+	body := map[string]any{"model": "llama", "prompt": "hello"}
+	p, err := NewRequestDeduplicationPlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	first := framework.NewInferenceRequest()
+	first.Headers["X-Request-Id"] = "req-1"
+	first.Body = body
+	if err := p.ProcessRequest(context.Background(), nil, first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second := newRequest("req-2", body)
+	if err := p.ProcessRequest(context.Background(), nil, second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := second.Headers[DuplicateOfHeader]; got != "req-1" {
+		t.Errorf("Headers[%q] = %q, want %q", DuplicateOfHeader, got, "req-1")
+	}
+}
+
+func TestRequestDeduplicationPlugin_TTLExpiry(t *testing.T) {
+	p, err := NewRequestDeduplicationPlugin(50*time.Millisecond, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	body := map[string]any{"model": "llama", "prompt": "hello"}
+
+	if err := p.ProcessRequest(context.Background(), nil, newRequest("req-1", body)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	second := newRequest("req-2", body)
+	if err := p.ProcessRequest(context.Background(), nil, second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := second.Headers[DuplicateRequestHeader]; ok {
+		t.Errorf("request after TTL expiry should not be marked as duplicate")
+	}
+}
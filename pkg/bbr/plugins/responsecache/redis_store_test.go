@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responsecache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	store := NewRedisStore(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+	ctx := context.Background()
+
+	if _, found, err := store.Get(ctx, "key"); err != nil || found {
+		t.Fatalf("Get() = found %v, error %v, want not found", found, err)
+	}
+	if err := store.Set(ctx, "key", []byte(`{"id":"cmpl-1"}`), time.Minute); err != nil {
+		t.Fatalf("Set() unexpected error: %v", err)
+	}
+	body, found, err := store.Get(ctx, "key")
+	if err != nil || !found {
+		t.Fatalf("Get() = found %v, error %v, want found", found, err)
+	}
+	if string(body) != `{"id":"cmpl-1"}` {
+		t.Errorf("Get() = %s, want %s", body, `{"id":"cmpl-1"}`)
+	}
+
+	// Redis expires the response after its TTL
+	server.FastForward(2 * time.Minute)
+	if _, found, err := store.Get(ctx, "key"); err != nil || found {
+		t.Errorf("Get() after the TTL = found %v, error %v, want not found", found, err)
+	}
+
+	server.Close()
+	if _, _, err := store.Get(ctx, "key"); err == nil {
+		t.Error("Get() on an unavailable server should fail")
+	}
+}
+
+func TestResponseCachePlugin_RedisStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	body := map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0}
+	response := map[string]any{"id": "cmpl-1"}
+
+	// the replicas share the cached responses
+	newReplica := func() *ResponseCachePlugin {
+		p, err := NewResponseCachePlugin(time.Minute, 10)
+		if err != nil {
+			t.Fatalf("failed to create plugin: %v", err)
+		}
+		return p.WithStore(NewRedisStore(redis.NewClient(&redis.Options{Addr: server.Addr()})))
+	}
+	if immediate, _ := process(t, newReplica(), body, response); immediate != nil {
+		t.Fatal("first request should be forwarded")
+	}
+	if immediate, _ := process(t, newReplica(), body, response); immediate == nil {
+		t.Error("second request should be answered with the response cached by another replica")
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responsecache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/redis/go-redis/v9"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/etag"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ResponseCachePluginType = "response-cache"
+	CacheHeader             = "X-BBR-Cache"
+
+	defaultTTLSeconds = 300
+	defaultMaxEntries = 1000
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &ResponseCachePlugin{}
+	_ framework.ResponseProcessor = &ResponseCachePlugin{}
+	_ ResponseCacheStore          = &memoryStore{}
+	_ ResponseCacheStore          = &RedisStore{}
+)
+
+// ResponseCacheStore stores response bodies keyed by the ETag of their request, e.g. in memory or in Redis
+// to share the cache between BBR replicas.
+type ResponseCacheStore interface {
+	// Get returns the response body cached with the key, and whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set caches the response body with the key for the given TTL.
+	Set(ctx context.Context, key string, body []byte, ttl time.Duration) error
+}
+
+// ResponseCacheConfig defines the JSON configuration structure for the plugin.
+type ResponseCacheConfig struct {
+	// TTLSeconds is the duration a response is cached. Defaults to 300.
+	TTLSeconds int `json:"ttl_seconds"`
+	// MaxEntries bounds the number of responses kept in memory by the default store. Defaults to 1000.
+	MaxEntries int `json:"max_entries"`
+	// RedisAddress is the host:port address of a Redis server storing the responses, shared between BBR
+	// replicas. Defaults to storing the responses in memory.
+	RedisAddress string `json:"redis_address"`
+}
+
+// ResponseCachePluginFactory defines the factory function for NewResponseCachePlugin.
+func ResponseCachePluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ResponseCacheConfig{
+		TTLSeconds: defaultTTLSeconds,
+		MaxEntries: defaultMaxEntries,
+	}
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ResponseCachePluginType, err)
+		}
+	}
+
+	plugin, err := NewResponseCachePlugin(time.Duration(config.TTLSeconds)*time.Second, config.MaxEntries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ResponseCachePluginType, err)
+	}
+	if config.RedisAddress != "" {
+		plugin = plugin.WithStore(NewRedisStore(redis.NewClient(&redis.Options{Addr: config.RedisAddress})))
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewResponseCachePlugin initializes a new ResponseCachePlugin, caching responses in memory, and returns its
+// pointer.
+func NewResponseCachePlugin(ttl time.Duration, maxEntries int) (*ResponseCachePlugin, error) {
+	if ttl <= 0 {
+		return nil, errors.New("ttl must be positive in ResponseCache plugin")
+	}
+	if maxEntries <= 0 {
+		return nil, errors.New("maxEntries must be positive in ResponseCache plugin")
+	}
+
+	return &ResponseCachePlugin{
+		typedName: plugin.TypedName{
+			Type: ResponseCachePluginType,
+			Name: ResponseCachePluginType,
+		},
+		ttl:   ttl,
+		store: &memoryStore{responses: expirable.NewLRU[string, []byte](maxEntries, nil, ttl)},
+	}, nil
+}
+
+// ResponseCachePlugin answers deterministic requests, i.e. requests with temperature 0, with the cached response
+// of an identical request, bypassing the backend. Requests are identical if their bodies are, since any field,
+// e.g. max_tokens, tools or response_format, may change the response, and if they carry the same credentials,
+// so that clients are never answered with the responses of other clients. Cached responses are returned with
+// the key of the request in the ETag header. Responses are cached by the response chain; only successful (2xx)
+// responses are cached, and streamed responses are not. When the store is unavailable, requests are forwarded to the backend.
+type ResponseCachePlugin struct {
+	typedName plugin.TypedName
+	ttl       time.Duration
+	store     ResponseCacheStore
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ResponseCachePlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ResponseCachePlugin) WithName(name string) *ResponseCachePlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// WithStore sets the store of the cached responses, e.g. a store backed by Redis, replacing the in-memory store.
+func (p *ResponseCachePlugin) WithStore(store ResponseCacheStore) *ResponseCachePlugin {
+	p.store = store
+	return p
+}
+
+// ProcessRequest answers the request with the cached response of an identical request, if any.
+// Otherwise, it keeps the ETag of deterministic requests for ProcessResponse.
+func (p *ResponseCachePlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if stream, _ := request.Body["stream"].(bool); stream {
+		return nil // streamed responses are not cached
+	}
+	key, ok := etag.ComputeETag(request)
+	if !ok {
+		return nil // the response is not reproducible
+	}
+
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	body, found, err := p.store.Get(ctx, key)
+	if err != nil {
+		logger.Info("failed to read the response cache, forwarding the request", "error", err.Error())
+	}
+	if err != nil || !found {
+		cycleState.Write(p.typedName.String(), key)
+		return nil
+	}
+
+	logger.Info("answering with the cached response", "etag", key)
+	return errcommon.ImmediateResponse{
+		Headers: map[string]string{
+			"content-type":  "application/json",
+			etag.ETagHeader: key,
+			CacheHeader:     "HIT",
+		},
+		Body: body,
+	}
+}
+
+// ProcessResponse caches the response of a deterministic request that was forwarded to the backend.
+func (p *ResponseCachePlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+	key, err := framework.ReadCycleStateKey[string](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request is not cacheable
+	}
+	if !response.IsSuccess() || response.IsEventStream() {
+		return nil
+	}
+
+	body, err := json.Marshal(response.Body)
+	if err != nil {
+		return nil
+	}
+	response.SetHeader(etag.ETagHeader, key)
+	if err := p.store.Set(ctx, key, body, p.ttl); err != nil {
+		log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "failed to cache the response", "etag", key)
+		return nil
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("cached the response", "etag", key)
+	return nil
+}
+
+// memoryStore is the default in-process ResponseCacheStore, an LRU cache bounded in size whose entries all
+// expire after the TTL of the plugin.
+type memoryStore struct {
+	responses *expirable.LRU[string, []byte]
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	body, found := s.responses.Get(key)
+	if !found {
+		return nil, false, nil
+	}
+	// callers may mutate the body, so they get a copy
+	copied := make([]byte, len(body))
+	copy(copied, body)
+	return copied, true, nil
+}
+
+// Set caches the response body; the LRU expires it after the TTL it was created with, the TTL of the plugin.
+func (s *memoryStore) Set(_ context.Context, key string, body []byte, _ time.Duration) error {
+	s.responses.Add(key, body)
+	return nil
+}
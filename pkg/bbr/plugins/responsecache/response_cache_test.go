@@ -0,0 +1,309 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responsecache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/etag"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestResponseCachePluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "defaults",
+			rawParams: nil,
+		},
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"ttl_seconds":10,"max_entries":100}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "redis store",
+			rawParams: json.RawMessage(`{"redis_address":"localhost:6379"}`),
+		},
+		{
+			name:      "zero ttl",
+			rawParams: json.RawMessage(`{"ttl_seconds":0}`),
+			wantErr:   true,
+		},
+		{
+			name:      "negative max entries",
+			rawParams: json.RawMessage(`{"max_entries":-1}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ResponseCachePluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+			if got := p.TypedName().Type; got != ResponseCachePluginType {
+				t.Errorf("Type = %q, want %q", got, ResponseCachePluginType)
+			}
+		})
+	}
+}
+
+// newRequest returns a request with the given body, sent with the given API key, if any.
+func newRequest(body map[string]any, apiKey string) *framework.InferenceRequest {
+	request := framework.NewInferenceRequest()
+	for k, v := range body {
+		request.Body[k] = v
+	}
+	if apiKey != "" {
+		request.Headers["X-API-Key"] = apiKey
+	}
+	return request
+}
+
+// process runs the request through the plugin, and the given response when the request is forwarded.
+// It returns the immediate response of the plugin, if any, and the headers of the forwarded response.
+func process(t *testing.T, p *ResponseCachePlugin, body map[string]any, responseBody map[string]any) (*errcommon.ImmediateResponse, map[string]string) {
+	t.Helper()
+	return processRequest(t, p, newRequest(body, ""), responseBody)
+}
+
+// processRequest is process for a request with headers.
+func processRequest(t *testing.T, p *ResponseCachePlugin, request *framework.InferenceRequest, responseBody map[string]any) (*errcommon.ImmediateResponse, map[string]string) {
+	t.Helper()
+	return processWithStatus(t, p, request, "200", responseBody)
+}
+
+// processWithStatus is processRequest for a response with the given status code.
+func processWithStatus(t *testing.T, p *ResponseCachePlugin, request *framework.InferenceRequest, status string, responseBody map[string]any) (*errcommon.ImmediateResponse, map[string]string) {
+	t.Helper()
+	cycleState := framework.NewCycleState()
+	err := p.ProcessRequest(context.Background(), cycleState, request)
+	var immediate errcommon.ImmediateResponse
+	if errors.As(err, &immediate) {
+		return &immediate, nil
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	response := framework.NewInferenceResponse()
+	response.Headers[framework.StatusHeader] = status
+	response.Body = responseBody
+	if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return nil, response.MutatedHeaders()
+}
+
+func TestResponseCachePlugin(t *testing.T) {
+	body := map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0}
+	response := map[string]any{"id": "cmpl-1", "choices": []any{map[string]any{"text": "hi"}}}
+
+	tests := []struct {
+		name        string
+		first       map[string]any
+		firstStatus string
+		firstResp   map[string]any
+		second      map[string]any
+		wantCached  bool
+	}{
+		{
+			name:       "identical deterministic request",
+			first:      body,
+			firstResp:  response,
+			second:     body,
+			wantCached: true,
+		},
+		{
+			name:      "different prompt",
+			first:     body,
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "bye", "temperature": 0.0},
+		},
+		{
+			name:      "different max tokens",
+			first:     body,
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "max_tokens": 5.0},
+		},
+		{
+			name:      "different tools",
+			first:     map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "tools": []any{"a"}},
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "tools": []any{"b"}},
+		},
+		{
+			name:      "non-zero temperature",
+			first:     map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.7},
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.7},
+		},
+		{
+			name:        "error responses are not cached",
+			first:       body,
+			firstStatus: "503",
+			firstResp:   map[string]any{"error": map[string]any{"message": "overloaded"}},
+			second:      body,
+		},
+		{
+			name:        "error responses without an error field are not cached",
+			first:       body,
+			firstStatus: "400",
+			firstResp:   map[string]any{"object": "error", "message": "invalid prompt"},
+			second:      body,
+		},
+		{
+			name:      "streamed requests are not cached",
+			first:     map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "stream": true},
+			firstResp: response,
+			second:    map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0, "stream": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewResponseCachePlugin(time.Minute, 10)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			firstStatus := tt.firstStatus
+			if firstStatus == "" {
+				firstStatus = "200"
+			}
+			immediate, headers := processWithStatus(t, p, newRequest(tt.first, ""), firstStatus, tt.firstResp)
+			if immediate != nil {
+				t.Fatal("first request should be forwarded")
+			}
+			immediate, _ = process(t, p, tt.second, response)
+			if !tt.wantCached {
+				if immediate != nil {
+					t.Errorf("second request should be forwarded, got cached response %s", immediate.Body)
+				}
+				return
+			}
+			if immediate == nil {
+				t.Fatal("second request should be answered with the cached response")
+			}
+			var cached map[string]any
+			if err := json.Unmarshal(immediate.Body, &cached); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cached["id"] != "cmpl-1" {
+				t.Errorf("cached body = %s, want the first response", immediate.Body)
+			}
+			wantETag, _ := etag.ComputeETag(newRequest(tt.second, ""))
+			if got := immediate.Headers[etag.ETagHeader]; got != wantETag {
+				t.Errorf("Headers[%q] = %q, want %q", etag.ETagHeader, got, wantETag)
+			}
+			if got := headers[etag.ETagHeader]; got != wantETag {
+				t.Errorf("forwarded response Headers[%q] = %q, want %q", etag.ETagHeader, got, wantETag)
+			}
+		})
+	}
+}
+
+func TestResponseCachePlugin_Credentials(t *testing.T) {
+	body := map[string]any{"model": "llama", "prompt": "hello", "temperature": 0.0}
+	response := map[string]any{"id": "cmpl-1"}
+	p, err := NewResponseCachePlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	if immediate, _ := processRequest(t, p, newRequest(body, "alice"), response); immediate != nil {
+		t.Fatal("first request should be forwarded")
+	}
+	if immediate, _ := processRequest(t, p, newRequest(body, "bob"), response); immediate != nil {
+		t.Error("the request of another client should be forwarded")
+	}
+	if immediate, _ := processRequest(t, p, newRequest(body, ""), response); immediate != nil {
+		t.Error("the request without credentials should be forwarded")
+	}
+	if immediate, _ := processRequest(t, p, newRequest(body, "alice"), response); immediate == nil {
+		t.Error("the identical request of the same client should be answered with the cached response")
+	}
+}
+
+// fakeStore is a ResponseCacheStore recording the TTLs of the cached responses, and failing on demand.
+type fakeStore struct {
+	responses map[string][]byte
+	ttls      []time.Duration
+	err       error
+}
+
+func (s *fakeStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	body, found := s.responses[key]
+	return body, found, s.err
+}
+
+func (s *fakeStore) Set(_ context.Context, key string, body []byte, ttl time.Duration) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.responses[key] = body
+	s.ttls = append(s.ttls, ttl)
+	return nil
+}
+
+func TestResponseCachePlugin_WithStore(t *testing.T) {
+	body := map[string]any{"model": "llama", "messages": []any{map[string]any{"role": "user", "content": "hi"}}, "temperature": 0.0}
+	response := map[string]any{"id": "cmpl-1"}
+	store := &fakeStore{responses: map[string][]byte{}, err: errors.New("connection refused")}
+	p, err := NewResponseCachePlugin(time.Minute, 10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	p = p.WithStore(store)
+
+	// an unavailable store neither fails nor answers requests
+	for range 2 {
+		if immediate, _ := process(t, p, body, response); immediate != nil {
+			t.Fatal("request should be forwarded when the store is unavailable")
+		}
+	}
+
+	store.err = nil
+	if immediate, _ := process(t, p, body, response); immediate != nil {
+		t.Fatal("first request should be forwarded")
+	}
+	if len(store.ttls) != 1 || store.ttls[0] != time.Minute {
+		t.Errorf("store TTLs = %v, want [%v]", store.ttls, time.Minute)
+	}
+	if immediate, _ := process(t, p, body, response); immediate == nil {
+		t.Error("second request should be answered with the cached response")
+	}
+}
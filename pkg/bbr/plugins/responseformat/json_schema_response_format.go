@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responseformat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	JSONSchemaResponseFormatPluginType = "json-schema-response-format"
+
+	defaultCacheSize = 1024
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &JSONSchemaResponseFormatPlugin{}
+
+// JSONSchemaResponseFormatConfig defines the JSON configuration structure for the plugin.
+type JSONSchemaResponseFormatConfig struct {
+	// CacheSize is the number of distinct schemas whose validation result is cached. Defaults to 1024.
+	CacheSize int `json:"cache_size"`
+}
+
+// JSONSchemaResponseFormatPluginFactory defines the factory function for NewJSONSchemaResponseFormatPlugin.
+func JSONSchemaResponseFormatPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := JSONSchemaResponseFormatConfig{CacheSize: defaultCacheSize}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", JSONSchemaResponseFormatPluginType, err)
+		}
+	}
+
+	plugin, err := NewJSONSchemaResponseFormatPlugin(config.CacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", JSONSchemaResponseFormatPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewJSONSchemaResponseFormatPlugin initializes a new JSONSchemaResponseFormatPlugin and returns its pointer.
+func NewJSONSchemaResponseFormatPlugin(cacheSize int) (*JSONSchemaResponseFormatPlugin, error) {
+	if cacheSize <= 0 {
+		return nil, errors.New("cache_size must be positive in JSONSchemaResponseFormat plugin")
+	}
+	cache, err := lru.New[uint64, []string](cacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONSchemaResponseFormatPlugin{
+		typedName: plugin.TypedName{
+			Type: JSONSchemaResponseFormatPluginType,
+			Name: JSONSchemaResponseFormatPluginType,
+		},
+		violations: cache,
+	}, nil
+}
+
+// JSONSchemaResponseFormatPlugin validates the schema of json_schema response formats against the JSON
+// Schema meta-schema, and rejects invalid schemas with HTTP 400 listing their violations, instead of letting
+// the backend fail on them. The validation results are cached by the hash of the schema, since clients
+// typically send the same schema in many requests.
+type JSONSchemaResponseFormatPlugin struct {
+	typedName plugin.TypedName
+	// violations maps the hash of a schema to its violations
+	violations *lru.Cache[uint64, []string]
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *JSONSchemaResponseFormatPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *JSONSchemaResponseFormatPlugin) WithName(name string) *JSONSchemaResponseFormatPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the schema of the response format of the request, if it is json_schema.
+func (p *JSONSchemaResponseFormatPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	responseFormat, _ := request.Body[responseFormatField].(map[string]any)
+	if responseFormat["type"] != jsonSchemaFormat {
+		return nil
+	}
+	jsonSchema, _ := responseFormat[jsonSchemaFormat].(map[string]any)
+	schema, ok := jsonSchema["schema"]
+	if !ok {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "response_format.json_schema.schema is required"}
+	}
+
+	// map keys are marshaled in sorted order, so identical schemas have identical hashes
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "response_format.json_schema.schema is not valid JSON"}
+	}
+	hash := xxhash.Sum64(raw)
+	violations, ok := p.violations.Get(hash)
+	if !ok {
+		violations = validateSchema(schema)
+		p.violations.Add(hash, violations)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected an invalid response format schema", "violations", len(violations))
+	return errcommon.Error{Code: errcommon.BadRequest, Msg: "response_format.json_schema.schema is not a valid JSON Schema: " + strings.Join(violations, "; ")}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responseformat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestJSONSchemaResponseFormatPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "default config", rawParams: nil},
+		{name: "custom cache size", rawParams: json.RawMessage(`{"cache_size":10}`)},
+		{name: "non-positive cache size", rawParams: json.RawMessage(`{"cache_size":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := JSONSchemaResponseFormatPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestJSONSchemaResponseFormatPlugin(t *testing.T) {
+	jsonSchema := func(schema any) map[string]any {
+		return map[string]any{"type": "json_schema", "json_schema": map[string]any{"name": "answer", "schema": schema}}
+	}
+	tests := []struct {
+		name           string
+		responseFormat any
+		wantMsg        string
+	}{
+		{name: "no response format"},
+		{name: "json object", responseFormat: map[string]any{"type": "json_object"}},
+		{name: "valid schema", responseFormat: jsonSchema(map[string]any{"type": "object", "required": []any{"answer"}})},
+		{
+			name:           "invalid schema",
+			responseFormat: jsonSchema(map[string]any{"type": "object", "properties": map[string]any{"answer": map[string]any{"type": "text"}}}),
+			wantMsg:        "response_format.json_schema.schema is not a valid JSON Schema: /properties/answer/type: must be one of [null boolean object array number string integer]",
+		},
+		{
+			name:           "missing schema",
+			responseFormat: map[string]any{"type": "json_schema", "json_schema": map[string]any{"name": "answer"}},
+			wantMsg:        "response_format.json_schema.schema is required",
+		},
+	}
+	p, err := NewJSONSchemaResponseFormatPlugin(10)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the second request is validated from the cache
+			for range 2 {
+				request := framework.NewInferenceRequest()
+				if tt.responseFormat != nil {
+					request.Body["response_format"] = tt.responseFormat
+				}
+
+				err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+				if tt.wantMsg == "" {
+					if err != nil {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					continue
+				}
+				var e errcommon.Error
+				if !errors.As(err, &e) || e.Code != errcommon.BadRequest {
+					t.Fatalf("error = %v, want a %s error", err, errcommon.BadRequest)
+				}
+				if diff := cmp.Diff(tt.wantMsg, e.Msg); diff != "" {
+					t.Errorf("unexpected message (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+	if got := p.violations.Len(); got != 2 {
+		t.Errorf("cached schemas = %d, want 2", got)
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responseformat
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// schemaTypes are the primitive types of the JSON Schema type keyword.
+var schemaTypes = []string{"null", "boolean", "object", "array", "number", "string", "integer"}
+
+// schemaValidator checks the value of a keyword, and reports its violations to the metaValidator.
+type schemaValidator func(v *metaValidator, pointer string, value any)
+
+// keywordValidators maps the JSON Schema keywords to the validators of their values, following the
+// meta-schema of JSON Schema draft 2020-12, and the keywords of the earlier drafts that are still in use.
+// Unknown keywords are allowed, as in the meta-schema.
+var keywordValidators map[string]schemaValidator
+
+// init fills keywordValidators, which can not be initialized in its declaration since the schema validators refer to it.
+func init() {
+	keywordValidators = map[string]schemaValidator{
+		// core
+		"$schema":  isString,
+		"$id":      isString,
+		"$ref":     isString,
+		"$anchor":  isString,
+		"$comment": isString,
+		"$defs":    isSchemaMap,
+		// applicators
+		"allOf":                 isSchemaArray,
+		"anyOf":                 isSchemaArray,
+		"oneOf":                 isSchemaArray,
+		"not":                   isSchema,
+		"if":                    isSchema,
+		"then":                  isSchema,
+		"else":                  isSchema,
+		"dependentSchemas":      isSchemaMap,
+		"prefixItems":           isSchemaArray,
+		"items":                 isSchemaOrSchemaArray,
+		"additionalItems":       isSchema,
+		"contains":              isSchema,
+		"properties":            isSchemaMap,
+		"patternProperties":     isSchemaMap,
+		"additionalProperties":  isSchema,
+		"propertyNames":         isSchema,
+		"unevaluatedItems":      isSchema,
+		"unevaluatedProperties": isSchema,
+		"definitions":           isSchemaMap,
+		// validation
+		"type":              isType,
+		"enum":              isArray,
+		"multipleOf":        isPositiveNumber,
+		"maximum":           isNumber,
+		"exclusiveMaximum":  isNumber,
+		"minimum":           isNumber,
+		"exclusiveMinimum":  isNumber,
+		"maxLength":         isNonNegativeInteger,
+		"minLength":         isNonNegativeInteger,
+		"pattern":           isString,
+		"maxItems":          isNonNegativeInteger,
+		"minItems":          isNonNegativeInteger,
+		"uniqueItems":       isBoolean,
+		"maxContains":       isNonNegativeInteger,
+		"minContains":       isNonNegativeInteger,
+		"maxProperties":     isNonNegativeInteger,
+		"minProperties":     isNonNegativeInteger,
+		"required":          isUniqueStringArray,
+		"dependentRequired": isUniqueStringArrayMap,
+		// meta-data and format
+		"title":       isString,
+		"description": isString,
+		"deprecated":  isBoolean,
+		"readOnly":    isBoolean,
+		"writeOnly":   isBoolean,
+		"examples":    isArray,
+		"format":      isString,
+	}
+}
+
+// metaValidator collects the violations of a schema against the JSON Schema meta-schema.
+type metaValidator struct {
+	violations []string
+}
+
+// validateSchema validates a schema against the JSON Schema meta-schema, and returns its violations.
+func validateSchema(schema any) []string {
+	v := &metaValidator{}
+	isSchema(v, "", schema)
+	return v.violations
+}
+
+func (v *metaValidator) report(pointer, format string, args ...any) {
+	if pointer == "" {
+		pointer = "/"
+	}
+	v.violations = append(v.violations, pointer+": "+fmt.Sprintf(format, args...))
+}
+
+// child returns the JSON pointer of a member, escaping "~" and "/" as required by RFC 6901.
+func child(pointer string, member any) string {
+	token := strings.NewReplacer("~", "~0", "/", "~1").Replace(fmt.Sprint(member))
+	return pointer + "/" + token
+}
+
+func isSchema(v *metaValidator, pointer string, value any) {
+	switch schema := value.(type) {
+	case bool:
+	case map[string]any:
+		keywords := make([]string, 0, len(schema))
+		for keyword := range schema {
+			keywords = append(keywords, keyword)
+		}
+		sort.Strings(keywords) // report the violations in a deterministic order
+		for _, keyword := range keywords {
+			if validate, ok := keywordValidators[keyword]; ok {
+				validate(v, child(pointer, keyword), schema[keyword])
+			}
+		}
+	default:
+		v.report(pointer, "must be a schema object or boolean")
+	}
+}
+
+func isSchemaArray(v *metaValidator, pointer string, value any) {
+	schemas, ok := value.([]any)
+	if !ok || len(schemas) == 0 {
+		v.report(pointer, "must be a non-empty array of schemas")
+		return
+	}
+	for i, schema := range schemas {
+		isSchema(v, child(pointer, i), schema)
+	}
+}
+
+func isSchemaOrSchemaArray(v *metaValidator, pointer string, value any) {
+	if _, ok := value.([]any); ok {
+		isSchemaArray(v, pointer, value)
+		return
+	}
+	isSchema(v, pointer, value)
+}
+
+func isSchemaMap(v *metaValidator, pointer string, value any) {
+	schemas, ok := value.(map[string]any)
+	if !ok {
+		v.report(pointer, "must be an object of schemas")
+		return
+	}
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		isSchema(v, child(pointer, name), schemas[name])
+	}
+}
+
+func isType(v *metaValidator, pointer string, value any) {
+	switch t := value.(type) {
+	case string:
+		if !slices.Contains(schemaTypes, t) {
+			v.report(pointer, "must be one of %v", schemaTypes)
+		}
+	case []any:
+		if len(t) == 0 {
+			v.report(pointer, "must not be empty")
+			return
+		}
+		seen := map[string]bool{}
+		for i, item := range t {
+			name, ok := item.(string)
+			if !ok || !slices.Contains(schemaTypes, name) {
+				v.report(child(pointer, i), "must be one of %v", schemaTypes)
+				continue
+			}
+			if seen[name] {
+				v.report(child(pointer, i), "must be unique")
+			}
+			seen[name] = true
+		}
+	default:
+		v.report(pointer, "must be a type name or an array of type names")
+	}
+}
+
+func isString(v *metaValidator, pointer string, value any) {
+	if _, ok := value.(string); !ok {
+		v.report(pointer, "must be a string")
+	}
+}
+
+func isBoolean(v *metaValidator, pointer string, value any) {
+	if _, ok := value.(bool); !ok {
+		v.report(pointer, "must be a boolean")
+	}
+}
+
+func isArray(v *metaValidator, pointer string, value any) {
+	if _, ok := value.([]any); !ok {
+		v.report(pointer, "must be an array")
+	}
+}
+
+func isNumber(v *metaValidator, pointer string, value any) {
+	if _, ok := value.(float64); !ok {
+		v.report(pointer, "must be a number")
+	}
+}
+
+func isPositiveNumber(v *metaValidator, pointer string, value any) {
+	if number, ok := value.(float64); !ok || number <= 0 {
+		v.report(pointer, "must be a number greater than 0")
+	}
+}
+
+func isNonNegativeInteger(v *metaValidator, pointer string, value any) {
+	if number, ok := value.(float64); !ok || number < 0 || number != math.Trunc(number) {
+		v.report(pointer, "must be a non-negative integer")
+	}
+}
+
+func isUniqueStringArray(v *metaValidator, pointer string, value any) {
+	items, ok := value.([]any)
+	if !ok {
+		v.report(pointer, "must be an array of strings")
+		return
+	}
+	seen := map[string]bool{}
+	for i, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			v.report(child(pointer, i), "must be a string")
+			continue
+		}
+		if seen[s] {
+			v.report(child(pointer, i), "must be unique")
+		}
+		seen[s] = true
+	}
+}
+
+func isUniqueStringArrayMap(v *metaValidator, pointer string, value any) {
+	arrays, ok := value.(map[string]any)
+	if !ok {
+		v.report(pointer, "must be an object of string arrays")
+		return
+	}
+	names := make([]string, 0, len(arrays))
+	for name := range arrays {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		isUniqueStringArray(v, child(pointer, name), arrays[name])
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responseformat
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema any
+		want   []string
+	}{
+		{
+			name: "valid schema",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{"type": "string", "minLength": 1.0},
+					"tags": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "uniqueItems": true},
+					"age":  map[string]any{"type": []any{"integer", "null"}, "minimum": 0.0},
+				},
+				"required":             []any{"name"},
+				"additionalProperties": false,
+				"$defs":                map[string]any{"id": map[string]any{"type": "string", "pattern": "^[a-z]+$"}},
+				"x-custom":             "unknown keywords are allowed",
+			},
+		},
+		{name: "boolean schema", schema: true},
+		{name: "non-schema", schema: "object", want: []string{"/: must be a schema object or boolean"}},
+		{
+			name: "violations",
+			schema: map[string]any{
+				"type": "dictionary",
+				"properties": map[string]any{
+					"name":  map[string]any{"minLength": -1.0},
+					"a/b~c": map[string]any{"type": []any{"string", "string"}},
+				},
+				"required":   []any{"name", "name", 3.0},
+				"anyOf":      []any{},
+				"multipleOf": 0.0,
+			},
+			want: []string{
+				"/anyOf: must be a non-empty array of schemas",
+				"/multipleOf: must be a number greater than 0",
+				"/properties/a~1b~0c/type/1: must be unique",
+				"/properties/name/minLength: must be a non-negative integer",
+				"/required/1: must be unique",
+				"/required/2: must be a string",
+				"/type: must be one of [null boolean object array number string integer]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, validateSchema(tt.schema)); diff != "" {
+				t.Errorf("unexpected violations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responseformat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ResponseFormatValidatorPluginType = "response-format-validator"
+	modelField                        = "model"
+	responseFormatField               = "response_format"
+
+	textFormat       = "text"
+	jsonObjectFormat = "json_object"
+	jsonSchemaFormat = "json_schema"
+)
+
+var knownFormats = []string{textFormat, jsonObjectFormat, jsonSchemaFormat}
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ResponseFormatValidatorPlugin{}
+
+// ResponseFormatValidatorConfig defines the JSON configuration structure for the plugin.
+type ResponseFormatValidatorConfig struct {
+	// SupportedFormats maps a model name to the response formats it supports,
+	// e.g. {"llama-3-8b": ["text", "json_object"]}.
+	SupportedFormats map[string][]string `json:"supported_formats"`
+	// DefaultSupportedFormats are the response formats supported by models that are not in SupportedFormats.
+	// When unset, these models are assumed to support all response formats.
+	DefaultSupportedFormats []string `json:"default_supported_formats"`
+}
+
+// ResponseFormatValidatorPluginFactory defines the factory function for NewResponseFormatValidatorPlugin.
+func ResponseFormatValidatorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ResponseFormatValidatorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ResponseFormatValidatorPluginType, err)
+		}
+	}
+
+	plugin, err := NewResponseFormatValidatorPlugin(config.SupportedFormats, config.DefaultSupportedFormats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ResponseFormatValidatorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewResponseFormatValidatorPlugin initializes a new ResponseFormatValidatorPlugin and returns its pointer.
+func NewResponseFormatValidatorPlugin(supportedFormats map[string][]string, defaultSupportedFormats []string) (*ResponseFormatValidatorPlugin, error) {
+	for model, formats := range supportedFormats {
+		if model == "" {
+			return nil, errors.New("model names must not be empty in ResponseFormatValidator plugin")
+		}
+		if err := validateFormats(formats); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateFormats(defaultSupportedFormats); err != nil {
+		return nil, err
+	}
+
+	return &ResponseFormatValidatorPlugin{
+		typedName: plugin.TypedName{
+			Type: ResponseFormatValidatorPluginType,
+			Name: ResponseFormatValidatorPluginType,
+		},
+		supportedFormats:        supportedFormats,
+		defaultSupportedFormats: defaultSupportedFormats,
+	}, nil
+}
+
+// validateFormats checks that all the given response formats are known.
+func validateFormats(formats []string) error {
+	for _, format := range formats {
+		if !slices.Contains(knownFormats, format) {
+			return fmt.Errorf("unknown response format %q in ResponseFormatValidator plugin, must be one of %v", format, knownFormats)
+		}
+	}
+	return nil
+}
+
+// ResponseFormatValidatorPlugin rejects requests with HTTP 400 when the requested model does not support
+// their response_format, instead of letting the backend fail or silently ignore it. It also checks that
+// json_schema response formats carry a schema.
+type ResponseFormatValidatorPlugin struct {
+	typedName               plugin.TypedName
+	supportedFormats        map[string][]string
+	defaultSupportedFormats []string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ResponseFormatValidatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ResponseFormatValidatorPlugin) WithName(name string) *ResponseFormatValidatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the response_format of the request against the requested model.
+func (p *ResponseFormatValidatorPlugin) ProcessRequest(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	rawFormat, ok := request.Body[responseFormatField]
+	if !ok || rawFormat == nil {
+		return nil
+	}
+	responseFormat, ok := rawFormat.(map[string]any)
+	if !ok {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "response_format must be an object"}
+	}
+	format, _ := responseFormat["type"].(string)
+	if !slices.Contains(knownFormats, format) {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("response_format.type must be one of %v", knownFormats)}
+	}
+
+	model, _ := request.Body[modelField].(string)
+	supported, ok := p.supportedFormats[model]
+	if !ok {
+		supported = p.defaultSupportedFormats
+	}
+	if supported != nil && !slices.Contains(supported, format) {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("Model does not support %s format", format)}
+	}
+
+	if format == jsonSchemaFormat {
+		jsonSchema, _ := responseFormat[jsonSchemaFormat].(map[string]any)
+		if _, ok := jsonSchema["schema"].(map[string]any); !ok {
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: "response_format.json_schema.schema must be a JSON schema object"}
+		}
+	}
+
+	return nil
+}
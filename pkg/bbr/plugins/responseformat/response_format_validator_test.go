@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responseformat
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestResponseFormatValidatorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "valid config", rawParams: json.RawMessage(`{"supported_formats":{"llama-3-8b":["text","json_object"]},"default_supported_formats":["text"]}`)},
+		{name: "unknown format", rawParams: json.RawMessage(`{"supported_formats":{"llama-3-8b":["xml"]}}`), wantErr: true},
+		{name: "unknown default format", rawParams: json.RawMessage(`{"default_supported_formats":["yaml"]}`), wantErr: true},
+		{name: "empty model name", rawParams: json.RawMessage(`{"supported_formats":{"":["text"]}}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ResponseFormatValidatorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestResponseFormatValidatorPlugin(t *testing.T) {
+	p, err := NewResponseFormatValidatorPlugin(map[string][]string{
+		"llama-3-8b": {"text", "json_object"},
+		"gpt-4o":     {"text", "json_object", "json_schema"},
+	}, []string{"text"})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		model          string
+		responseFormat any
+		wantCode       string
+	}{
+		{name: "no response format", model: "llama-3-8b"},
+		{name: "supported format", model: "llama-3-8b", responseFormat: map[string]any{"type": "json_object"}},
+		{name: "unsupported format", model: "llama-3-8b", responseFormat: map[string]any{"type": "json_schema"}, wantCode: errcommon.BadRequest},
+		{name: "default formats apply to unlisted models", model: "mistral-7b", responseFormat: map[string]any{"type": "json_object"}, wantCode: errcommon.BadRequest},
+		{name: "unknown format", model: "gpt-4o", responseFormat: map[string]any{"type": "xml"}, wantCode: errcommon.BadRequest},
+		{name: "response format is not an object", model: "gpt-4o", responseFormat: "json_object", wantCode: errcommon.BadRequest},
+		{
+			name:           "valid json schema",
+			model:          "gpt-4o",
+			responseFormat: map[string]any{"type": "json_schema", "json_schema": map[string]any{"name": "answer", "schema": map[string]any{"type": "object"}}},
+		},
+		{
+			name:           "json schema without schema",
+			model:          "gpt-4o",
+			responseFormat: map[string]any{"type": "json_schema", "json_schema": map[string]any{"name": "answer"}},
+			wantCode:       errcommon.BadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body["model"] = tt.model
+			if tt.responseFormat != nil {
+				request.Body["response_format"] = tt.responseFormat
+			}
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+				t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestResponseFormatValidatorPluginWithoutDefaults(t *testing.T) {
+	p, err := NewResponseFormatValidatorPlugin(map[string][]string{"llama-3-8b": {"text"}}, nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	request := framework.NewInferenceRequest()
+	request.Body["model"] = "gpt-4o"
+	request.Body["response_format"] = map[string]any{"type": "json_object"}
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Errorf("unlisted model was rejected: %v", err)
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responselatency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ResponseLatencyPluginType = "response-latency-slo"
+	modelField                = "model"
+
+	// RequestStartHeader carries the time the request was received, in Unix milliseconds.
+	RequestStartHeader = "X-BBR-Request-Start"
+	// DegradedHeader is set to "true" on responses that breached the SLO of their model.
+	DegradedHeader = "X-BBR-Response-Degraded"
+	// LatencyHeader carries the latency of a response that breached the SLO, in milliseconds.
+	LatencyHeader = "X-BBR-Response-Latency-Ms"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &ResponseLatencyPlugin{}
+	_ framework.ResponseProcessor = &ResponseLatencyPlugin{}
+)
+
+// ResponseLatencyConfig defines the JSON configuration structure for the plugin.
+type ResponseLatencyConfig struct {
+	// SLOs maps a model name to its latency SLO in milliseconds, e.g. {"gpt-4": 5000}.
+	SLOs map[string]int `json:"slos_ms"`
+	// DefaultSLOMs is the latency SLO of models that are not in SLOs. Zero disables the check for them.
+	DefaultSLOMs int `json:"default_slo_ms"`
+}
+
+// ResponseLatencyPluginFactory defines the factory function for NewResponseLatencyPlugin.
+func ResponseLatencyPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ResponseLatencyConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ResponseLatencyPluginType, err)
+		}
+	}
+
+	plugin, err := NewResponseLatencyPlugin(config.SLOs, config.DefaultSLOMs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ResponseLatencyPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewResponseLatencyPlugin initializes a new ResponseLatencyPlugin and returns its pointer.
+func NewResponseLatencyPlugin(slosMs map[string]int, defaultSLOMs int) (*ResponseLatencyPlugin, error) {
+	if len(slosMs) == 0 && defaultSLOMs == 0 {
+		return nil, errors.New("at least one of slos_ms and default_slo_ms is required in ResponseLatency plugin")
+	}
+	if defaultSLOMs < 0 {
+		return nil, errors.New("default_slo_ms must not be negative in ResponseLatency plugin")
+	}
+
+	slos := make(map[string]time.Duration, len(slosMs))
+	for model, slo := range slosMs {
+		if slo <= 0 {
+			return nil, fmt.Errorf("the SLO of model '%s' must be positive in ResponseLatency plugin", model)
+		}
+		slos[model] = time.Duration(slo) * time.Millisecond
+	}
+
+	return &ResponseLatencyPlugin{
+		typedName: plugin.TypedName{
+			Type: ResponseLatencyPluginType,
+			Name: ResponseLatencyPluginType,
+		},
+		slos:       slos,
+		defaultSLO: time.Duration(defaultSLOMs) * time.Millisecond,
+		now:        time.Now,
+	}, nil
+}
+
+// ResponseLatencyPlugin marks responses that took longer than the latency SLO of their model.
+// The request side stamps the start time in the X-BBR-Request-Start header, and the response side
+// sets X-BBR-Response-Degraded and X-BBR-Response-Latency-Ms when the SLO is breached.
+type ResponseLatencyPlugin struct {
+	typedName  plugin.TypedName
+	slos       map[string]time.Duration
+	defaultSLO time.Duration
+	now        func() time.Time
+}
+
+// requestState is the state passed from the request to the response processing.
+type requestState struct {
+	start time.Time
+	slo   time.Duration
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ResponseLatencyPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ResponseLatencyPlugin) WithName(name string) *ResponseLatencyPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest stamps the request start time and keeps the SLO of the requested model for the response.
+func (p *ResponseLatencyPlugin) ProcessRequest(_ context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	start := p.now()
+	request.SetHeader(RequestStartHeader, strconv.FormatInt(start.UnixMilli(), 10))
+
+	model, _ := request.Body[modelField].(string)
+	slo, ok := p.slos[model]
+	if !ok {
+		slo = p.defaultSLO
+	}
+	if slo > 0 {
+		cycleState.Write(p.typedName.String(), requestState{start: start, slo: slo})
+	}
+	return nil
+}
+
+// ProcessResponse marks the response as degraded when its latency breached the SLO.
+func (p *ResponseLatencyPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Headers == nil || cycleState == nil {
+		return nil
+	}
+
+	state, err := framework.ReadCycleStateKey[requestState](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // no SLO applies to the request
+	}
+
+	latency := p.now().Sub(state.start)
+	if latency <= state.slo {
+		return nil
+	}
+
+	response.SetHeader(DegradedHeader, "true")
+	response.SetHeader(LatencyHeader, strconv.FormatInt(latency.Milliseconds(), 10))
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("response breached the latency SLO", "latency", latency, "slo", state.slo)
+	return nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responselatency
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestResponseLatencyPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"slos_ms":{"gpt-4":5000}}`)},
+		{name: "default SLO only", rawParams: json.RawMessage(`{"default_slo_ms":3000}`)},
+		{name: "missing SLOs", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "non-positive SLO", rawParams: json.RawMessage(`{"slos_ms":{"gpt-4":0}}`), wantErr: true},
+		{name: "negative default SLO", rawParams: json.RawMessage(`{"slos_ms":{"gpt-4":5000},"default_slo_ms":-1}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ResponseLatencyPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestResponseLatencyPlugin(t *testing.T) {
+	tests := []struct {
+		name        string
+		model       string
+		latency     time.Duration
+		wantHeaders map[string]string
+	}{
+		{
+			name:    "within the SLO",
+			model:   "gpt-4",
+			latency: 4 * time.Second,
+		},
+		{
+			name:        "breached the SLO",
+			model:       "gpt-4",
+			latency:     5432 * time.Millisecond,
+			wantHeaders: map[string]string{DegradedHeader: "true", LatencyHeader: "5432"},
+		},
+		{
+			name:        "breached the default SLO",
+			model:       "llama",
+			latency:     2500 * time.Millisecond,
+			wantHeaders: map[string]string{DegradedHeader: "true", LatencyHeader: "2500"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewResponseLatencyPlugin(map[string]int{"gpt-4": 5000}, 2000)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			now := time.Now()
+			p.now = func() time.Time { return now }
+
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			request.Body[modelField] = tt.model
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected request error: %v", err)
+			}
+			if got, want := request.GetHeader(RequestStartHeader), strconv.FormatInt(now.UnixMilli(), 10); got != want {
+				t.Errorf("%s = %q, want %q", RequestStartHeader, got, want)
+			}
+
+			now = now.Add(tt.latency)
+			response := framework.NewInferenceResponse()
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("unexpected response error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantHeaders, response.Headers, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected response headers (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResponseLatencyPluginWithoutDefaultSLO(t *testing.T) {
+	p, err := NewResponseLatencyPlugin(map[string]int{"gpt-4": 5000}, 0)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	cycleState := framework.NewCycleState()
+	request := framework.NewInferenceRequest()
+	request.Body[modelField] = "llama"
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("unexpected request error: %v", err)
+	}
+	now = now.Add(time.Minute)
+	response := framework.NewInferenceResponse()
+	if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+		t.Fatalf("unexpected response error: %v", err)
+	}
+	if len(response.Headers) != 0 {
+		t.Errorf("expected no response headers for a model without SLO, got %v", response.Headers)
+	}
+}
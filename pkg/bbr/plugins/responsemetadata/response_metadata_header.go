@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responsemetadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ResponseMetadataHeaderPluginType = "response-metadata-header"
+)
+
+// compile-time type validation
+var _ framework.ResponseProcessor = &ResponseMetadataHeaderPlugin{}
+
+// ResponseMetadataHeaderConfig defines the JSON configuration structure for the plugin.
+type ResponseMetadataHeaderConfig struct {
+	// Extract maps a response header to the response body field it is extracted from. Nested fields
+	// are separated by dots, e.g. {"X-System-Fingerprint":"system_fingerprint","X-Groq-Time":"x_groq.time"}.
+	Extract map[string]string `json:"extract"`
+}
+
+// ResponseMetadataHeaderPluginFactory defines the factory function for NewResponseMetadataHeaderPlugin.
+func ResponseMetadataHeaderPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config ResponseMetadataHeaderConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ResponseMetadataHeaderPluginType, err)
+		}
+	}
+
+	plugin, err := NewResponseMetadataHeaderPlugin(config.Extract)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ResponseMetadataHeaderPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewResponseMetadataHeaderPlugin initializes a new ResponseMetadataHeaderPlugin and returns its pointer.
+func NewResponseMetadataHeaderPlugin(extract map[string]string) (*ResponseMetadataHeaderPlugin, error) {
+	if len(extract) == 0 {
+		return nil, errors.New("extract is required in ResponseMetadataHeader plugin")
+	}
+
+	fields := make(map[string][]string, len(extract))
+	for header, field := range extract {
+		if header == "" || field == "" {
+			return nil, errors.New("header and field names must not be empty in ResponseMetadataHeader plugin")
+		}
+		fields[header] = strings.Split(field, ".")
+	}
+
+	return &ResponseMetadataHeaderPlugin{
+		typedName: plugin.TypedName{
+			Type: ResponseMetadataHeaderPluginType,
+			Name: ResponseMetadataHeaderPluginType,
+		},
+		fields: fields,
+	}, nil
+}
+
+// ResponseMetadataHeaderPlugin exposes backend specific fields of the response body, such as
+// "system_fingerprint", as response headers, so clients can read them via standard header APIs.
+type ResponseMetadataHeaderPlugin struct {
+	typedName plugin.TypedName
+	// fields maps a header to the path of the body field it is extracted from
+	fields map[string][]string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ResponseMetadataHeaderPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ResponseMetadataHeaderPlugin) WithName(name string) *ResponseMetadataHeaderPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessResponse sets the configured response headers from the response body fields.
+func (p *ResponseMetadataHeaderPlugin) ProcessResponse(ctx context.Context, _ *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil {
+		return nil
+	}
+
+	for header, path := range p.fields {
+		value, ok := lookup(response.Body, path)
+		if !ok {
+			continue
+		}
+		headerValue, ok := toHeaderValue(value)
+		if !ok {
+			log.FromContext(ctx).V(logutil.VERBOSE).Info("response body field can not be converted to a header value", "header", header)
+			continue
+		}
+		response.SetHeader(header, headerValue)
+	}
+
+	return nil
+}
+
+// lookup returns the value at the given path of nested fields.
+func lookup(body map[string]any, path []string) (any, bool) {
+	var current any = body
+	for _, field := range path {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[field]; !ok {
+			return nil, false
+		}
+	}
+	return current, current != nil
+}
+
+// toHeaderValue formats a JSON value as a header value. Objects and arrays are set as JSON.
+func toHeaderValue(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(raw), true
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package responsemetadata
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestResponseMetadataHeaderPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"extract":{"X-System-Fingerprint":"system_fingerprint"}}`)},
+		{name: "missing extract", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "empty field", rawParams: json.RawMessage(`{"extract":{"X-System-Fingerprint":""}}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ResponseMetadataHeaderPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestResponseMetadataHeaderPlugin_ProcessResponse(t *testing.T) {
+	p, err := NewResponseMetadataHeaderPlugin(map[string]string{
+		"X-System-Fingerprint": "system_fingerprint",
+		"X-Groq-Time":          "x_groq.time",
+		"X-Groq-Id":            "x_groq.id",
+		"X-Usage":              "usage",
+		"X-Missing":            "does_not_exist",
+		"X-Missing-Nested":     "system_fingerprint.nested",
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	response := framework.NewInferenceResponse()
+	if err := json.Unmarshal([]byte(`{
+		"id": "chatcmpl-1",
+		"system_fingerprint": "fp_44709d6fcb",
+		"x_groq": {"id": "req_01", "time": 0.25},
+		"usage": {"prompt_tokens": 10}
+	}`), &response.Body); err != nil {
+		t.Fatalf("failed to unmarshal body: %v", err)
+	}
+
+	if err := p.ProcessResponse(context.Background(), framework.NewCycleState(), response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"X-System-Fingerprint": "fp_44709d6fcb",
+		"X-Groq-Time":          "0.25",
+		"X-Groq-Id":            "req_01",
+		"X-Usage":              `{"prompt_tokens":10}`,
+	}
+	if diff := cmp.Diff(want, response.MutatedHeaders()); diff != "" {
+		t.Errorf("unexpected headers (-want +got):\n%s", diff)
+	}
+	if response.BodyMutated() {
+		t.Error("expected the response body to be left unchanged")
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretredaction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	SecretRedactionPluginType = "secret-redaction"
+
+	// Redacted replaces the values of the redacted fields.
+	Redacted = "[REDACTED]"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &SecretRedactionPlugin{}
+
+// SecretRedactionConfig defines the JSON configuration structure for the plugin.
+type SecretRedactionConfig struct {
+	// Fields are the dot-separated paths of the body fields to redact, e.g. ["metadata.api_key","user_context.token"].
+	// A path element that resolves to an array is applied to each of its elements.
+	Fields []string `json:"fields"`
+}
+
+// SecretRedactionPluginFactory defines the factory function for NewSecretRedactionPlugin.
+func SecretRedactionPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := SecretRedactionConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", SecretRedactionPluginType, err)
+		}
+	}
+
+	plugin, err := NewSecretRedactionPlugin(config.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", SecretRedactionPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewSecretRedactionPlugin initializes a new SecretRedactionPlugin and returns its pointer.
+func NewSecretRedactionPlugin(fields []string) (*SecretRedactionPlugin, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("fields are required in SecretRedaction plugin")
+	}
+
+	paths := make([][]string, 0, len(fields))
+	for _, field := range fields {
+		path := strings.Split(field, ".")
+		for _, element := range path {
+			if element == "" {
+				return nil, fmt.Errorf("invalid field path '%s' in SecretRedaction plugin", field)
+			}
+		}
+		paths = append(paths, path)
+	}
+
+	return &SecretRedactionPlugin{
+		typedName: plugin.TypedName{
+			Type: SecretRedactionPluginType,
+			Name: SecretRedactionPluginType,
+		},
+		paths: paths,
+	}, nil
+}
+
+// SecretRedactionPlugin redacts secrets, such as API keys, from the copy of the request body that is
+// logged or persisted by other plugins. The redacted copy is written to the CycleState under
+// framework.AuditBodyKey, while the original body is still forwarded upstream. The plugin must
+// therefore run before the plugins that log the body.
+type SecretRedactionPlugin struct {
+	typedName plugin.TypedName
+	paths     [][]string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *SecretRedactionPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *SecretRedactionPlugin) WithName(name string) *SecretRedactionPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest writes a redacted copy of the request body to the CycleState, if any of the fields is set.
+func (p *SecretRedactionPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	body, _ := deepCopy(request.Body).(map[string]any)
+	redacted := 0
+	for _, path := range p.paths {
+		redacted += redact(body, path)
+	}
+	if redacted == 0 {
+		return nil
+	}
+
+	cycleState.Write(framework.AuditBodyKey, body)
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("redacted secrets from the audit copy of the request body", "fields", redacted)
+	return nil
+}
+
+// redact replaces the values at the given path with Redacted, and returns the number of replaced values.
+func redact(value any, path []string) int {
+	switch v := value.(type) {
+	case map[string]any:
+		child, ok := v[path[0]]
+		if !ok {
+			return 0
+		}
+		if len(path) == 1 {
+			v[path[0]] = Redacted
+			return 1
+		}
+		return redact(child, path[1:])
+	case []any:
+		redacted := 0
+		for _, element := range v {
+			redacted += redact(element, path)
+		}
+		return redacted
+	}
+	return 0
+}
+
+// deepCopy copies the maps and arrays of a parsed JSON value, so redacting the copy does not affect the original.
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for key, child := range v {
+			copied[key] = deepCopy(child)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(v))
+		for i, child := range v {
+			copied[i] = deepCopy(child)
+		}
+		return copied
+	}
+	return value
+}
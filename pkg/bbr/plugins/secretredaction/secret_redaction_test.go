@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretredaction
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestSecretRedactionPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"fields":["metadata.api_key","user_context.token"]}`)},
+		{name: "missing fields", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "empty path element", rawParams: json.RawMessage(`{"fields":["metadata..api_key"]}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := SecretRedactionPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestSecretRedactionPlugin(t *testing.T) {
+	p, err := NewSecretRedactionPlugin([]string{"metadata.api_key", "user_context.token", "tools.secret"})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		body          map[string]any
+		wantAuditBody map[string]any
+	}{
+		{
+			name: "secrets are redacted",
+			body: map[string]any{
+				"model":        "llama",
+				"metadata":     map[string]any{"api_key": "sk-123", "team": "a"},
+				"user_context": map[string]any{"token": "t-456"},
+				"tools":        []any{map[string]any{"secret": "s1"}, map[string]any{"name": "n"}},
+			},
+			wantAuditBody: map[string]any{
+				"model":        "llama",
+				"metadata":     map[string]any{"api_key": Redacted, "team": "a"},
+				"user_context": map[string]any{"token": Redacted},
+				"tools":        []any{map[string]any{"secret": Redacted}, map[string]any{"name": "n"}},
+			},
+		},
+		{
+			name: "no secrets",
+			body: map[string]any{"model": "llama", "metadata": map[string]any{"team": "a"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := deepCopy(tt.body)
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(original, any(request.Body)); diff != "" {
+				t.Errorf("the forwarded body must not be redacted (-want +got):\n%s", diff)
+			}
+			if request.BodyMutated() {
+				t.Error("the forwarded body must not be mutated")
+			}
+
+			auditBody, err := framework.ReadCycleStateKey[map[string]any](cycleState, framework.AuditBodyKey)
+			if tt.wantAuditBody == nil {
+				if err == nil {
+					t.Errorf("expected no audit body, got %v", auditBody)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to read the audit body: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantAuditBody, auditBody); diff != "" {
+				t.Errorf("unexpected audit body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
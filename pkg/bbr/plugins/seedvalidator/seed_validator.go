@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seedvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	SeedValidatorPluginType = "seed-validator"
+	seedField               = "seed"
+
+	// ClampedHeader is set to "true" on requests whose seed was clamped into the valid range.
+	ClampedHeader = "X-BBR-Seed-Clamped"
+
+	minSeed = 0
+	maxSeed = math.MaxInt32
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &SeedValidatorPlugin{}
+
+// SeedValidatorConfig defines the JSON configuration structure for the plugin.
+type SeedValidatorConfig struct {
+	// ClampMode clamps out of range seeds to the nearest valid value, instead of rejecting the request.
+	ClampMode bool `json:"clamp_mode"`
+}
+
+// SeedValidatorPluginFactory defines the factory function for NewSeedValidatorPlugin.
+func SeedValidatorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := SeedValidatorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", SeedValidatorPluginType, err)
+		}
+	}
+
+	return NewSeedValidatorPlugin(config.ClampMode).WithName(name), nil
+}
+
+// NewSeedValidatorPlugin initializes a new SeedValidatorPlugin and returns its pointer.
+func NewSeedValidatorPlugin(clampMode bool) *SeedValidatorPlugin {
+	return &SeedValidatorPlugin{
+		typedName: plugin.TypedName{
+			Type: SeedValidatorPluginType,
+			Name: SeedValidatorPluginType,
+		},
+		clampMode: clampMode,
+	}
+}
+
+// SeedValidatorPlugin validates that the seed parameter is an integer in the range [0, 2^31-1], which
+// many backends require. JavaScript clients often send larger seeds, e.g. derived from
+// Number.MAX_SAFE_INTEGER. Out of range seeds are rejected with HTTP 400, or clamped in clamp mode.
+type SeedValidatorPlugin struct {
+	typedName plugin.TypedName
+	clampMode bool
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *SeedValidatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *SeedValidatorPlugin) WithName(name string) *SeedValidatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates the seed of the request, and clamps it in clamp mode.
+func (p *SeedValidatorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	rawSeed, ok := request.Body[seedField]
+	if !ok || rawSeed == nil {
+		return nil
+	}
+	seed, ok := rawSeed.(float64)
+	if !ok || seed != math.Trunc(seed) {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "seed must be an integer"}
+	}
+	if seed >= minSeed && seed <= maxSeed {
+		return nil
+	}
+
+	if !p.clampMode {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("seed must be in the range [%d, %d]", minSeed, maxSeed)}
+	}
+
+	clamped := min(max(seed, minSeed), maxSeed)
+	request.SetBodyField(seedField, clamped)
+	request.SetHeader(ClampedHeader, "true")
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("clamped the request seed", "seed", seed, "clamped", clamped)
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seedvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestSeedValidatorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "clamp mode", rawParams: json.RawMessage(`{"clamp_mode":true}`)},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := SeedValidatorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestSeedValidatorPlugin(t *testing.T) {
+	tests := []struct {
+		name        string
+		clampMode   bool
+		seed        any
+		wantCode    string
+		wantSeed    any
+		wantClamped bool
+	}{
+		{name: "valid seed", seed: 42.0, wantSeed: 42.0},
+		{name: "maximal seed", seed: 2147483647.0, wantSeed: 2147483647.0},
+		{name: "too large seed is rejected", seed: 9007199254740991.0, wantCode: errcommon.BadRequest},
+		{name: "negative seed is rejected", seed: -1.0, wantCode: errcommon.BadRequest},
+		{name: "fractional seed is rejected", seed: 1.5, wantCode: errcommon.BadRequest},
+		{name: "non-number seed is rejected", seed: "42", wantCode: errcommon.BadRequest},
+		{name: "too large seed is clamped", clampMode: true, seed: 9007199254740991.0, wantSeed: 2147483647.0, wantClamped: true},
+		{name: "negative seed is clamped", clampMode: true, seed: -5.0, wantSeed: 0.0, wantClamped: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewSeedValidatorPlugin(tt.clampMode)
+			request := framework.NewInferenceRequest()
+			request.Body["seed"] = tt.seed
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Body["seed"]; got != tt.wantSeed {
+				t.Errorf("seed = %v, want %v", got, tt.wantSeed)
+			}
+			if got := request.GetHeader(ClampedHeader) == "true"; got != tt.wantClamped {
+				t.Errorf("%s set = %v, want %v", ClampedHeader, got, tt.wantClamped)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semanticselector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	SemanticModelSelectorPluginType = "semantic-model-selector"
+	// SimilarityHeader carries the cosine similarity between the prompt and the description of the selected model.
+	SimilarityHeader = "X-BBR-Semantic-Similarity"
+
+	modelField    = "model"
+	messagesField = "messages"
+	promptField   = "prompt"
+	userRole      = "user"
+
+	defaultTimeoutSeconds = 5
+
+	// the embedding of the model descriptions is retried with an exponential backoff between these intervals
+	minRetryInterval = time.Second
+	maxRetryInterval = time.Minute
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor = &SemanticModelSelectorPlugin{}
+	_ framework.Closer           = &SemanticModelSelectorPlugin{}
+)
+
+// ModelDescription describes a model that requests can be routed to.
+type ModelDescription struct {
+	// Name is the model name set in the request body when the model is selected.
+	Name string `json:"name"`
+	// Description describes the requests the model is best at, e.g. "Python and Go programming questions".
+	Description string `json:"description"`
+}
+
+// SemanticModelSelectorConfig defines the JSON configuration structure for the plugin.
+type SemanticModelSelectorConfig struct {
+	// EmbeddingEndpoint is the URL of the OpenAI compatible embeddings endpoint that embeds the model
+	// descriptions and the prompts, e.g. "http://embedder.default.svc:8000/v1/embeddings".
+	EmbeddingEndpoint string `json:"embedding_endpoint"`
+	// EmbeddingModel is the model of the embedding requests.
+	EmbeddingModel string `json:"embedding_model"`
+	// Models are the models the requests are routed to.
+	Models []ModelDescription `json:"models"`
+	// SimilarityThreshold is the minimum cosine similarity, in [-1, 1], between the prompt and the description
+	// of the best model for the request to be routed to it. Requests below the threshold keep their model.
+	SimilarityThreshold float64 `json:"similarity_threshold"`
+	// TimeoutSeconds bounds each embedding request. Defaults to 5.
+	TimeoutSeconds int `json:"timeout_seconds"`
+}
+
+// SemanticModelSelectorPluginFactory defines the factory function for NewSemanticModelSelectorPlugin.
+func SemanticModelSelectorPluginFactory(name string, rawParameters json.RawMessage, handle framework.Handle) (framework.BBRPlugin, error) {
+	config := SemanticModelSelectorConfig{
+		TimeoutSeconds: defaultTimeoutSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", SemanticModelSelectorPluginType, err)
+		}
+	}
+
+	plugin, err := NewSemanticModelSelectorPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", SemanticModelSelectorPluginType, err)
+	}
+
+	plugin.WithName(name)
+	if handle != nil {
+		plugin.embedDescriptions(handle.Context())
+	}
+
+	return plugin, nil
+}
+
+// NewSemanticModelSelectorPlugin initializes a new SemanticModelSelectorPlugin and returns its pointer.
+func NewSemanticModelSelectorPlugin(config SemanticModelSelectorConfig) (*SemanticModelSelectorPlugin, error) {
+	if config.EmbeddingEndpoint == "" {
+		return nil, errors.New("embedding_endpoint is required in SemanticModelSelector plugin")
+	}
+	if _, err := url.ParseRequestURI(config.EmbeddingEndpoint); err != nil {
+		return nil, fmt.Errorf("invalid embedding_endpoint in SemanticModelSelector plugin - %w", err)
+	}
+	if len(config.Models) == 0 {
+		return nil, errors.New("models are required in SemanticModelSelector plugin")
+	}
+	for _, model := range config.Models {
+		if model.Name == "" || strings.TrimSpace(model.Description) == "" {
+			return nil, errors.New("every model must have a name and a description in SemanticModelSelector plugin")
+		}
+	}
+	if config.SimilarityThreshold < -1 || config.SimilarityThreshold > 1 {
+		return nil, errors.New("similarity_threshold must be in the range [-1, 1] in SemanticModelSelector plugin")
+	}
+	if config.TimeoutSeconds <= 0 {
+		return nil, errors.New("timeout_seconds must be positive in SemanticModelSelector plugin")
+	}
+
+	return &SemanticModelSelectorPlugin{
+		typedName: plugin.TypedName{
+			Type: SemanticModelSelectorPluginType,
+			Name: SemanticModelSelectorPluginType,
+		},
+		embeddingEndpoint: config.EmbeddingEndpoint,
+		embeddingModel:    config.EmbeddingModel,
+		models:            config.Models,
+		threshold:         config.SimilarityThreshold,
+		client:            &http.Client{Timeout: time.Duration(config.TimeoutSeconds) * time.Second},
+		retryInterval:     minRetryInterval,
+	}, nil
+}
+
+// SemanticModelSelectorPlugin routes each request to the model whose description is the most similar to
+// the prompt. The descriptions are embedded once in the background, when the plugin is created or at the
+// latest with the first request, and kept in memory; the embedding is retried with an exponential backoff
+// until it succeeds. The last user message, or the prompt of completion requests, is embedded with every
+// request, and the model of the highest cosine similarity replaces the model of the request, if the
+// similarity reaches the threshold. If the descriptions are not embedded yet, or embedding the prompt fails,
+// the request is forwarded unchanged.
+type SemanticModelSelectorPlugin struct {
+	typedName         plugin.TypedName
+	embeddingEndpoint string
+	embeddingModel    string
+	models            []ModelDescription
+	threshold         float64
+	client            *http.Client
+	// retryInterval is the first interval between two attempts to embed the model descriptions.
+	retryInterval time.Duration
+
+	startEmbedding sync.Once
+	// stopEmbedding stops embedding the model descriptions, nil until the embedding started
+	stopEmbedding context.CancelFunc
+	// modelEmbeddings are the embeddings of the model descriptions, in the order of models, or nil until
+	// they are embedded.
+	modelEmbeddings atomic.Pointer[[][]float64]
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *SemanticModelSelectorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *SemanticModelSelectorPlugin) WithName(name string) *SemanticModelSelectorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the model of the request to the model whose description is the most similar to the prompt.
+func (p *SemanticModelSelectorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	prompt := promptText(request.Body)
+	if prompt == "" {
+		return nil
+	}
+
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	// the embedding outlives the request, but keeps its logger
+	p.embedDescriptions(context.WithoutCancel(ctx))
+	modelEmbeddings := p.modelEmbeddings.Load()
+	if modelEmbeddings == nil {
+		logger.Info("the model descriptions are not embedded yet, forwarding the request unchanged")
+		return nil
+	}
+	embeddings, err := p.embed(ctx, []string{prompt})
+	if err != nil {
+		logger.Info("failed to embed the prompt, forwarding the request unchanged", "error", err.Error())
+		return nil
+	}
+
+	best, bestSimilarity := -1, math.Inf(-1)
+	for i, modelEmbedding := range *modelEmbeddings {
+		if similarity := cosineSimilarity(embeddings[0], modelEmbedding); similarity > bestSimilarity {
+			best, bestSimilarity = i, similarity
+		}
+	}
+	if best < 0 || bestSimilarity < p.threshold {
+		logger.Info("no model is similar enough to the prompt", "similarity", bestSimilarity)
+		return nil
+	}
+
+	model := p.models[best].Name
+	if current, _ := request.Body[modelField].(string); current != model {
+		request.SetBodyField(modelField, model)
+	}
+	request.SetHeader(SimilarityHeader, strconv.FormatFloat(bestSimilarity, 'f', 2, 64))
+	logger.Info("selected the model most similar to the prompt", "model", model, "similarity", bestSimilarity)
+	return nil
+}
+
+// Close stops embedding the model descriptions, if they are not embedded yet.
+func (p *SemanticModelSelectorPlugin) Close() error {
+	p.startEmbedding.Do(func() {}) // the embedding must not start after the plugin is closed
+	if p.stopEmbedding != nil {
+		p.stopEmbedding()
+	}
+	return nil
+}
+
+// embedDescriptions starts embedding the model descriptions in the background, unless it already started.
+// Failed attempts are retried with an exponential backoff, until the descriptions are embedded, the context
+// is done or the plugin is closed.
+func (p *SemanticModelSelectorPlugin) embedDescriptions(ctx context.Context) {
+	p.startEmbedding.Do(func() {
+		ctx, p.stopEmbedding = context.WithCancel(ctx)
+		descriptions := make([]string, len(p.models))
+		for i, model := range p.models {
+			descriptions[i] = model.Description
+		}
+
+		go func() {
+			logger := log.FromContext(ctx)
+			for interval := p.retryInterval; ; interval = min(2*interval, maxRetryInterval) {
+				embeddings, err := p.embed(ctx, descriptions)
+				if err == nil {
+					p.modelEmbeddings.Store(&embeddings)
+					logger.V(logutil.DEFAULT).Info("Embedded the model descriptions", "plugin", p.typedName)
+					return
+				}
+				logger.V(logutil.DEFAULT).Info("Failed to embed the model descriptions, retrying", "plugin", p.typedName,
+					"retryInterval", interval, "error", err.Error())
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(interval):
+				}
+			}
+		}()
+	})
+}
+
+// embed returns the embeddings of the inputs, in the order of the inputs.
+func (p *SemanticModelSelectorPlugin) embed(ctx context.Context, inputs []string) ([][]float64, error) {
+	request := map[string]any{"input": inputs}
+	if p.embeddingModel != "" {
+		request["model"] = p.embeddingModel
+	}
+	raw, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.embeddingEndpoint, bytes.NewReader(raw))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	raw, err = io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	var response struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float64 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return nil, err
+	}
+	embeddings := make([][]float64, len(inputs))
+	for _, data := range response.Data {
+		if data.Index < 0 || data.Index >= len(inputs) {
+			return nil, fmt.Errorf("unexpected embedding index %d", data.Index)
+		}
+		embeddings[data.Index] = data.Embedding
+	}
+	for i, embedding := range embeddings {
+		if len(embedding) == 0 {
+			return nil, fmt.Errorf("missing embedding of input %d", i)
+		}
+	}
+	return embeddings, nil
+}
+
+// cosineSimilarity returns the cosine similarity of two vectors, or 0 if their dimensions differ or either is zero.
+func cosineSimilarity(a, b []float64) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// promptText returns the text of the last user message of chat completion requests, or the prompt of
+// completion requests.
+func promptText(body map[string]any) string {
+	if prompt, ok := body[promptField].(string); ok {
+		return prompt
+	}
+	messages, _ := body[messagesField].([]any)
+	for i := len(messages) - 1; i >= 0; i-- {
+		message, ok := messages[i].(map[string]any)
+		if !ok || message["role"] != userRole {
+			continue
+		}
+		return messagetext.Join(message["content"], "\n")
+	}
+	return ""
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semanticselector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestSemanticModelSelectorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder:8000/v1/embeddings","models":[{"name":"coder","description":"programming"}],"similarity_threshold":0.3}`),
+		},
+		{
+			name:      "missing embedding endpoint",
+			rawParams: json.RawMessage(`{"models":[{"name":"coder","description":"programming"}]}`),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid embedding endpoint",
+			rawParams: json.RawMessage(`{"embedding_endpoint":"not a url","models":[{"name":"coder","description":"programming"}]}`),
+			wantErr:   true,
+		},
+		{
+			name:      "no models",
+			rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder:8000/v1/embeddings"}`),
+			wantErr:   true,
+		},
+		{
+			name:      "model without description",
+			rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder:8000/v1/embeddings","models":[{"name":"coder"}]}`),
+			wantErr:   true,
+		},
+		{
+			name:      "threshold out of range",
+			rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder:8000/v1/embeddings","models":[{"name":"coder","description":"programming"}],"similarity_threshold":1.5}`),
+			wantErr:   true,
+		},
+		{
+			name:      "non-positive timeout",
+			rawParams: json.RawMessage(`{"embedding_endpoint":"http://embedder:8000/v1/embeddings","models":[{"name":"coder","description":"programming"}],"timeout_seconds":0}`),
+			wantErr:   true,
+		},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := SemanticModelSelectorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+// newEmbedder returns an embeddings server mapping texts about code and poetry to orthogonal vectors, and
+// counts the embedded inputs. The embeddings are returned in reverse order, with their index.
+func newEmbedder(t *testing.T, inputs *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		inputs.Add(int32(len(request.Input)))
+		var data []map[string]any
+		for i := len(request.Input) - 1; i >= 0; i-- {
+			text := strings.ToLower(request.Input[i])
+			embedding := []float64{0.1, 0.1}
+			switch {
+			case strings.Contains(text, "code") || strings.Contains(text, "python"):
+				embedding = []float64{1, 0.1}
+			case strings.Contains(text, "poem") || strings.Contains(text, "sonnet"):
+				embedding = []float64{0.1, 1}
+			case strings.Contains(text, "weather"):
+				embedding = []float64{-1, -1}
+			}
+			data = append(data, map[string]any{"index": i, "embedding": embedding})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+}
+
+// waitForEmbeddings starts embedding the model descriptions, and waits until they are embedded.
+func waitForEmbeddings(t *testing.T, p *SemanticModelSelectorPlugin) {
+	t.Helper()
+	p.embedDescriptions(context.Background())
+	deadline := time.Now().Add(5 * time.Second)
+	for p.modelEmbeddings.Load() == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("the model descriptions were not embedded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSemanticModelSelectorPlugin(t *testing.T) {
+	var inputs atomic.Int32
+	embedder := newEmbedder(t, &inputs)
+	defer embedder.Close()
+
+	p, err := NewSemanticModelSelectorPlugin(SemanticModelSelectorConfig{
+		EmbeddingEndpoint: embedder.URL,
+		Models: []ModelDescription{
+			{Name: "coder", Description: "Writing and debugging code"},
+			{Name: "poet", Description: "Writing poems and stories"},
+		},
+		SimilarityThreshold: 0.5,
+		TimeoutSeconds:      5,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	defer p.Close()
+	waitForEmbeddings(t, p)
+
+	tests := []struct {
+		name           string
+		body           map[string]any
+		wantModel      any
+		wantSimilarity string
+	}{
+		{
+			name:           "code question",
+			body:           map[string]any{"model": "auto", "messages": []any{map[string]any{"role": "user", "content": "Fix my Python script"}}},
+			wantModel:      "coder",
+			wantSimilarity: "1.00",
+		},
+		{
+			name: "last user message in text parts",
+			body: map[string]any{"model": "auto", "messages": []any{
+				map[string]any{"role": "user", "content": "Fix my Python script"},
+				map[string]any{"role": "assistant", "content": "Sure."},
+				map[string]any{"role": "user", "content": []any{map[string]any{"type": "text", "text": "Now write a sonnet about it"}}},
+			}},
+			wantModel:      "poet",
+			wantSimilarity: "1.00",
+		},
+		{
+			name:           "completion prompt",
+			body:           map[string]any{"model": "auto", "prompt": "A poem about the sea:"},
+			wantModel:      "poet",
+			wantSimilarity: "1.00",
+		},
+		{
+			name:      "below the threshold",
+			body:      map[string]any{"model": "auto", "messages": []any{map[string]any{"role": "user", "content": "How is the weather?"}}},
+			wantModel: "auto",
+		},
+		{
+			name:      "no prompt",
+			body:      map[string]any{"model": "auto"},
+			wantModel: "auto",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Body["model"]; got != tt.wantModel {
+				t.Errorf("model = %v, want %v", got, tt.wantModel)
+			}
+			if got := request.GetHeader(SimilarityHeader); got != tt.wantSimilarity {
+				t.Errorf("%s = %q, want %q", SimilarityHeader, got, tt.wantSimilarity)
+			}
+		})
+	}
+
+	// 2 descriptions, embedded once, and the prompts of the 4 requests with a prompt
+	if got := inputs.Load(); got != 6 {
+		t.Errorf("embedded inputs = %d, want %d", got, 6)
+	}
+}
+
+func TestSemanticModelSelectorPluginEmbeddingFailure(t *testing.T) {
+	var available atomic.Bool
+	var inputs atomic.Int32
+	embedder := newEmbedder(t, &inputs)
+	defer embedder.Close()
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !available.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		embedder.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer flaky.Close()
+
+	p, err := NewSemanticModelSelectorPlugin(SemanticModelSelectorConfig{
+		EmbeddingEndpoint: flaky.URL,
+		Models:            []ModelDescription{{Name: "coder", Description: "Writing code"}},
+		TimeoutSeconds:    5,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	defer p.Close()
+	p.retryInterval = 10 * time.Millisecond
+	newRequest := func() *framework.InferenceRequest {
+		request := framework.NewInferenceRequest()
+		request.Body = map[string]any{"model": "auto", "prompt": "Write some Python code"}
+		return request
+	}
+
+	request := newRequest()
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if request.BodyMutated() {
+		t.Error("expected the request to be forwarded unchanged when embedding fails")
+	}
+
+	// the first request started the embedding, which is retried until the embedder recovers
+	available.Store(true)
+	waitForEmbeddings(t, p)
+	request = newRequest()
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := request.Body["model"]; got != "coder" {
+		t.Errorf("model = %v, want %q after the embedder recovered", got, "coder")
+	}
+}
+
+func TestSemanticModelSelectorPluginClose(t *testing.T) {
+	var attempts atomic.Int32
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	p, err := NewSemanticModelSelectorPlugin(SemanticModelSelectorConfig{
+		EmbeddingEndpoint: unavailable.URL,
+		Models:            []ModelDescription{{Name: "coder", Description: "Writing code"}},
+		TimeoutSeconds:    5,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	p.retryInterval = 10 * time.Millisecond
+	p.embedDescriptions(context.Background())
+	time.Sleep(50 * time.Millisecond)
+	if err := p.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond) // an attempt in flight when the plugin was closed may still complete
+	closed := attempts.Load()
+	if closed == 0 {
+		t.Fatal("expected the embedding to be attempted before the plugin was closed")
+	}
+	time.Sleep(100 * time.Millisecond)
+	if got := attempts.Load(); got != closed {
+		t.Errorf("embedding attempts after the plugin was closed = %d, want 0", got-closed)
+	}
+}
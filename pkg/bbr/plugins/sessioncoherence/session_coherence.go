@@ -0,0 +1,228 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sessioncoherence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	SessionCoherencePluginType = "session-coherence"
+
+	// SessionIDHeader identifies the session whose prompt history the request is compared to.
+	SessionIDHeader = "X-Session-Id"
+	// CoherenceScoreHeader is set to the Jaccard similarity between the prompt and the session's recent prompts.
+	CoherenceScoreHeader = "X-BBR-Session-Coherence-Score"
+
+	messagesField = "messages"
+	promptField   = "prompt"
+
+	defaultHistorySize       = 5
+	defaultWarnThreshold     = 0.1
+	defaultSessionTTLSeconds = 3600
+	maxSessions              = 10000
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &SessionCoherencePlugin{}
+
+// SessionCoherenceConfig defines the JSON configuration structure for the plugin.
+type SessionCoherenceConfig struct {
+	// HistorySize is the number of recent prompts of a session the request is compared to. Defaults to 5.
+	HistorySize int `json:"history_size"`
+	// WarnThreshold is the coherence score below which a warning is logged. Defaults to 0.1.
+	WarnThreshold float64 `json:"warn_threshold"`
+	// RejectThreshold is the coherence score below which the request is rejected with HTTP 400.
+	// Defaults to 0, never rejecting requests.
+	RejectThreshold float64 `json:"reject_threshold"`
+	// SessionTTLSeconds is the duration the prompt history of a session is kept after its last request. Defaults to 3600.
+	SessionTTLSeconds int `json:"session_ttl_seconds"`
+}
+
+// SessionCoherencePluginFactory defines the factory function for NewSessionCoherencePlugin.
+func SessionCoherencePluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := SessionCoherenceConfig{
+		HistorySize:       defaultHistorySize,
+		WarnThreshold:     defaultWarnThreshold,
+		SessionTTLSeconds: defaultSessionTTLSeconds,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", SessionCoherencePluginType, err)
+		}
+	}
+
+	plugin, err := NewSessionCoherencePlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", SessionCoherencePluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewSessionCoherencePlugin initializes a new SessionCoherencePlugin and returns its pointer.
+func NewSessionCoherencePlugin(config SessionCoherenceConfig) (*SessionCoherencePlugin, error) {
+	if config.HistorySize <= 0 {
+		return nil, errors.New("history_size must be positive in SessionCoherence plugin")
+	}
+	if config.WarnThreshold < 0 || config.WarnThreshold > 1 {
+		return nil, errors.New("warn_threshold must be between 0 and 1 in SessionCoherence plugin")
+	}
+	if config.RejectThreshold < 0 || config.RejectThreshold > 1 {
+		return nil, errors.New("reject_threshold must be between 0 and 1 in SessionCoherence plugin")
+	}
+	if config.SessionTTLSeconds <= 0 {
+		return nil, errors.New("session_ttl_seconds must be positive in SessionCoherence plugin")
+	}
+
+	return &SessionCoherencePlugin{
+		typedName: plugin.TypedName{
+			Type: SessionCoherencePluginType,
+			Name: SessionCoherencePluginType,
+		},
+		historySize:     config.HistorySize,
+		warnThreshold:   config.WarnThreshold,
+		rejectThreshold: config.RejectThreshold,
+		sessions:        expirable.NewLRU[string, [][]string](maxSessions, nil, time.Duration(config.SessionTTLSeconds)*time.Second),
+	}, nil
+}
+
+// SessionCoherencePlugin tracks the semantic coherence of the prompts of a session, identified by its
+// X-Session-Id header, since a sudden shift of topic may indicate that the session was hijacked.
+// The coherence score of a request is the Jaccard similarity between the words of its prompt, the last
+// user message of chat completion requests, and the words of the session's recent prompts. The score is
+// set in the X-BBR-Session-Coherence-Score header, a warning is logged when it falls below the warning
+// threshold, and the request is rejected with HTTP 400 when it falls below the rejection threshold.
+// The first request of a session has no score.
+type SessionCoherencePlugin struct {
+	typedName       plugin.TypedName
+	historySize     int
+	warnThreshold   float64
+	rejectThreshold float64
+
+	lock sync.Mutex
+	// sessions maps a session ID to the words of its recent prompts, oldest first
+	sessions *expirable.LRU[string, [][]string]
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *SessionCoherencePlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *SessionCoherencePlugin) WithName(name string) *SessionCoherencePlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest scores the coherence of the request's prompt with the session's recent prompts.
+func (p *SessionCoherencePlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+	sessionID := request.GetHeader(SessionIDHeader)
+	if sessionID == "" {
+		return nil
+	}
+	words := tokenize(promptText(request.Body))
+	if len(words) == 0 {
+		return nil
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	history, _ := p.sessions.Get(sessionID)
+	if len(history) > 0 {
+		score := jaccard(words, history)
+		request.SetHeader(CoherenceScoreHeader, strconv.FormatFloat(score, 'f', 2, 64))
+
+		logger := log.FromContext(ctx)
+		if score < p.rejectThreshold {
+			logger.V(logutil.DEFAULT).Info("rejected a request incoherent with its session", "sessionID", sessionID, "score", score)
+			return errcommon.Error{Code: errcommon.BadRequest, Msg: "the request is not coherent with the prompts of its session"}
+		}
+		if score < p.warnThreshold {
+			logger.V(logutil.DEFAULT).Info("request is barely coherent with its session", "sessionID", sessionID, "score", score)
+		}
+	}
+
+	history = append(history, words)
+	if len(history) > p.historySize {
+		history = history[len(history)-p.historySize:]
+	}
+	p.sessions.Add(sessionID, history)
+	return nil
+}
+
+// jaccard returns the Jaccard similarity between the given words and the union of the words of the history.
+func jaccard(words []string, history [][]string) float64 {
+	current := sets.New(words...)
+	previous := sets.New[string]()
+	for _, prompt := range history {
+		previous.Insert(prompt...)
+	}
+	union := current.Union(previous).Len()
+	if union == 0 {
+		return 0
+	}
+	return float64(current.Intersection(previous).Len()) / float64(union)
+}
+
+// tokenize returns the distinct lowercase words of the text.
+func tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return sets.List(sets.New(words...))
+}
+
+// promptText returns the text of the last user message of chat completion requests, or the prompt of completion requests.
+func promptText(body map[string]any) string {
+	if prompt, ok := body[promptField].(string); ok {
+		return prompt
+	}
+	messages, _ := body[messagesField].([]any)
+	for i := len(messages) - 1; i >= 0; i-- {
+		message, ok := messages[i].(map[string]any)
+		if !ok || message["role"] != "user" {
+			continue
+		}
+		return messagetext.Join(message["content"], " ")
+	}
+	return ""
+}
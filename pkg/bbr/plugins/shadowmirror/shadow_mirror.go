@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shadowmirror
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ShadowMirrorPluginType = "shadow-mirror"
+	ShadowModeHeader       = "X-BBR-Shadow-Mode"
+	modelField             = "model"
+
+	defaultTimeoutSeconds = 30
+
+	// comparison results
+	resultMatch    = "match"
+	resultMismatch = "mismatch"
+	resultError    = "error"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &ShadowMirrorPlugin{}
+	_ framework.ResponseProcessor = &ShadowMirrorPlugin{}
+)
+
+// ShadowMirrorConfig defines the JSON configuration structure for the plugin.
+type ShadowMirrorConfig struct {
+	// Endpoint is the URL of the secondary endpoint the requests are mirrored to,
+	// e.g. "http://new-model.default.svc:8000/v1/chat/completions".
+	Endpoint string `json:"endpoint"`
+	// Percentage is the percentage of requests mirrored to the secondary endpoint, between 0 and 100.
+	Percentage float64 `json:"percentage"`
+	// Model optionally replaces the model of the mirrored requests, e.g. with the model being evaluated.
+	Model string `json:"model"`
+	// TimeoutSeconds bounds the mirrored request, and the wait for the primary response. Defaults to 30.
+	TimeoutSeconds int `json:"timeout_seconds"`
+}
+
+// ShadowMirrorPluginFactory defines the factory function for NewShadowMirrorPlugin.
+func ShadowMirrorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := ShadowMirrorConfig{TimeoutSeconds: defaultTimeoutSeconds}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ShadowMirrorPluginType, err)
+		}
+	}
+
+	plugin, err := NewShadowMirrorPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ShadowMirrorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewShadowMirrorPlugin initializes a new ShadowMirrorPlugin and returns its pointer.
+func NewShadowMirrorPlugin(config ShadowMirrorConfig) (*ShadowMirrorPlugin, error) {
+	if config.Endpoint == "" {
+		return nil, errors.New("endpoint is required in ShadowMirror plugin")
+	}
+	if _, err := url.ParseRequestURI(config.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid endpoint in ShadowMirror plugin - %w", err)
+	}
+	if config.Percentage < 0 || config.Percentage > 100 {
+		return nil, errors.New("percentage must be between 0 and 100 in ShadowMirror plugin")
+	}
+	if config.TimeoutSeconds <= 0 {
+		return nil, errors.New("timeout_seconds must be positive in ShadowMirror plugin")
+	}
+
+	timeout := time.Duration(config.TimeoutSeconds) * time.Second
+	return &ShadowMirrorPlugin{
+		typedName: plugin.TypedName{
+			Type: ShadowMirrorPluginType,
+			Name: ShadowMirrorPluginType,
+		},
+		endpoint:   config.Endpoint,
+		percentage: config.Percentage,
+		model:      config.Model,
+		timeout:    timeout,
+		client:     &http.Client{Timeout: timeout},
+		random:     rand.Float64,
+	}, nil
+}
+
+// ShadowMirrorPlugin mirrors a percentage of the requests to a secondary endpoint in shadow mode,
+// e.g. to evaluate a new model before switching production traffic to it. The mirrored request is
+// sent asynchronously and its response is never returned to the client. Once both responses are
+// received, they are compared and the result is recorded in the bbr_shadow_comparisons_total metric.
+type ShadowMirrorPlugin struct {
+	typedName  plugin.TypedName
+	endpoint   string
+	percentage float64
+	model      string
+	timeout    time.Duration
+	client     *http.Client
+	random     func() float64
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ShadowMirrorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ShadowMirrorPlugin) WithName(name string) *ShadowMirrorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest mirrors the sampled requests to the secondary endpoint.
+func (p *ShadowMirrorPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	if p.random()*100 >= p.percentage {
+		return nil
+	}
+
+	body, err := p.shadowBody(request.Body)
+	if err != nil {
+		log.FromContext(ctx).V(logutil.DEFAULT).Error(err, "Failed to marshal the shadow request")
+		return nil
+	}
+	model, _ := request.Body[modelField].(string)
+
+	// buffered, so delivering the primary response never blocks the response path
+	primary := make(chan generated, 1)
+	cycleState.Write(p.typedName.String(), primary)
+	request.SetHeader(ShadowModeHeader, "true")
+
+	go p.mirror(log.IntoContext(context.Background(), log.FromContext(ctx)), model, body, primary)
+	return nil
+}
+
+// ProcessResponse hands the primary response over to the comparison with the shadow response.
+func (p *ShadowMirrorPlugin) ProcessResponse(_ context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || cycleState == nil {
+		return nil
+	}
+
+	primary, err := framework.ReadCycleStateKey[chan generated](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not mirrored
+	}
+	// extract the content here, since the following plugins may mutate the response body
+	primary <- content(response.Body)
+	return nil
+}
+
+// shadowBody returns the body of the mirrored request.
+func (p *ShadowMirrorPlugin) shadowBody(body map[string]any) ([]byte, error) {
+	if p.model == "" {
+		return json.Marshal(body)
+	}
+	shadow := make(map[string]any, len(body))
+	for key, value := range body {
+		shadow[key] = value
+	}
+	shadow[modelField] = p.model
+	return json.Marshal(shadow)
+}
+
+// mirror sends the request to the secondary endpoint, and compares its response with the primary one.
+// The context is detached from the request, so the mirrored request outlives the primary one.
+func (p *ShadowMirrorPlugin) mirror(ctx context.Context, model string, body []byte, primary <-chan generated) {
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+
+	shadow, err := p.send(ctx, body)
+	if err != nil {
+		logger.Info("shadow request failed", "endpoint", p.endpoint, "error", err.Error())
+		metrics.RecordShadowComparison(model, resultError)
+		return
+	}
+
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+	select {
+	case primaryContent := <-primary:
+		result := resultMismatch
+		if shadowContent := content(shadow); primaryContent.ok && shadowContent.ok && primaryContent.text == shadowContent.text {
+			result = resultMatch
+		}
+		logger.Info("compared primary and shadow responses", "model", model, "result", result)
+		metrics.RecordShadowComparison(model, result)
+	case <-timer.C:
+		logger.Info("timed out waiting for the primary response", "model", model)
+		metrics.RecordShadowComparison(model, resultError)
+	}
+}
+
+// send posts the body to the secondary endpoint and returns the parsed response body.
+func (p *ShadowMirrorPlugin) send(ctx context.Context, body []byte) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	shadow := map[string]any{}
+	if err := json.Unmarshal(raw, &shadow); err != nil {
+		return nil, err
+	}
+	return shadow, nil
+}
+
+// generated is the content generated in a response.
+type generated struct {
+	text string
+	// ok is false if the response has no choices, e.g. an error response
+	ok bool
+}
+
+// content returns the generated content of all the choices of a completion or chat completion response.
+func content(body map[string]any) generated {
+	choices, ok := body["choices"].([]any)
+	if !ok || len(choices) == 0 {
+		return generated{}
+	}
+	var out bytes.Buffer
+	for _, c := range choices {
+		choice, ok := c.(map[string]any)
+		if !ok {
+			return generated{}
+		}
+		if message, ok := choice["message"].(map[string]any); ok {
+			text, _ := message["content"].(string)
+			out.WriteString(text)
+		} else {
+			text, _ := choice["text"].(string)
+			out.WriteString(text)
+		}
+		out.WriteByte(0) // separate the choices
+	}
+	return generated{text: out.String(), ok: true}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shadowmirror
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+)
+
+func TestShadowMirrorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"endpoint":"http://shadow:8000/v1/chat/completions","percentage":10}`)},
+		{name: "missing endpoint", rawParams: json.RawMessage(`{"percentage":10}`), wantErr: true},
+		{name: "invalid endpoint", rawParams: json.RawMessage(`{"endpoint":"not a url","percentage":10}`), wantErr: true},
+		{name: "invalid percentage", rawParams: json.RawMessage(`{"endpoint":"http://shadow:8000","percentage":150}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ShadowMirrorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+// comparisons returns the value of the shadow comparisons counter for the given model and result.
+func comparisons(t *testing.T, model, result string) float64 {
+	t.Helper()
+	families, err := crmetrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != "bbr_shadow_comparisons_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["model"] == model && labels["result"] == result {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func chatResponse(content string) map[string]any {
+	return map[string]any{"choices": []any{map[string]any{
+		"index":   0,
+		"message": map[string]any{"role": "assistant", "content": content},
+	}}}
+}
+
+func TestShadowMirrorPlugin(t *testing.T) {
+	metrics.Register()
+
+	tests := []struct {
+		name          string
+		shadowStatus  int
+		shadowContent string
+		wantResult    string
+	}{
+		{name: "responses match", shadowStatus: http.StatusOK, shadowContent: "Paris", wantResult: resultMatch},
+		{name: "responses differ", shadowStatus: http.StatusOK, shadowContent: "Lyon", wantResult: resultMismatch},
+		{name: "shadow endpoint fails", shadowStatus: http.StatusInternalServerError, wantResult: resultError},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := fmt.Sprintf("shadow-test-model-%d", i)
+			receivedModel := make(chan any, 1)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body := map[string]any{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				receivedModel <- body[modelField]
+				w.WriteHeader(tt.shadowStatus)
+				_ = json.NewEncoder(w).Encode(chatResponse(tt.shadowContent))
+			}))
+			defer server.Close()
+
+			p, err := NewShadowMirrorPlugin(ShadowMirrorConfig{Endpoint: server.URL, Percentage: 10, Model: "candidate", TimeoutSeconds: 5})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			p.random = func() float64 { return 0.05 }
+
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			request.Body[modelField] = model
+			request.Body["messages"] = []any{map[string]any{"role": "user", "content": "What is the capital of France?"}}
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.Headers[ShadowModeHeader]; got != "true" {
+				t.Errorf("Headers[%q] = %q, want %q", ShadowModeHeader, got, "true")
+			}
+			if got := request.Body[modelField]; got != model {
+				t.Errorf("primary request model = %q, want %q", got, model)
+			}
+
+			response := framework.NewInferenceResponse()
+			response.Body = chatResponse("Paris")
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := <-receivedModel; got != "candidate" {
+				t.Errorf("shadow request model = %q, want %q", got, "candidate")
+			}
+			deadline := time.Now().Add(5 * time.Second)
+			for comparisons(t, model, tt.wantResult) != 1 {
+				if time.Now().After(deadline) {
+					t.Fatalf("comparison result %q was not recorded", tt.wantResult)
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestShadowMirrorPlugin_NotSampled(t *testing.T) {
+	p, err := NewShadowMirrorPlugin(ShadowMirrorConfig{Endpoint: "http://shadow:8000", Percentage: 10, TimeoutSeconds: 5})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	p.random = func() float64 { return 0.5 }
+
+	request := framework.NewInferenceRequest()
+	request.Body[modelField] = "llama"
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := request.Headers[ShadowModeHeader]; ok {
+		t.Errorf("expected no %q header", ShadowModeHeader)
+	}
+}
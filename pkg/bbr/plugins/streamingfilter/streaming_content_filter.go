@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamingfilter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	StreamingContentFilterPluginType = "streaming-content-filter"
+
+	// FilteredToken is the content of the event injected in place of the filtered part of the stream.
+	FilteredToken = "[CONTENT_FILTERED]"
+
+	defaultWindowChars = 512
+
+	dataPrefix         = "data:"
+	doneEvent          = "data: [DONE]\n\n"
+	contentFilterState = "content_filter"
+)
+
+// compile-time type validation
+var _ framework.ResponseProcessor = &StreamingContentFilterPlugin{}
+
+// StreamingContentFilterConfig defines the JSON configuration structure for the plugin.
+type StreamingContentFilterConfig struct {
+	// Patterns are the regular expressions of content policy violations, e.g. "(?i)credit card number".
+	Patterns []string `json:"patterns"`
+	// WindowChars is the number of most recent characters of the stream that the patterns are matched
+	// against, which must be longer than any match. Defaults to 512.
+	WindowChars int `json:"window_chars"`
+}
+
+// StreamingContentFilterPluginFactory defines the factory function for NewStreamingContentFilterPlugin.
+func StreamingContentFilterPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := StreamingContentFilterConfig{WindowChars: defaultWindowChars}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", StreamingContentFilterPluginType, err)
+		}
+	}
+
+	plugin, err := NewStreamingContentFilterPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", StreamingContentFilterPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewStreamingContentFilterPlugin initializes a new StreamingContentFilterPlugin and returns its pointer.
+func NewStreamingContentFilterPlugin(config StreamingContentFilterConfig) (*StreamingContentFilterPlugin, error) {
+	if len(config.Patterns) == 0 {
+		return nil, errors.New("patterns are required in StreamingContentFilter plugin")
+	}
+	if config.WindowChars <= 0 {
+		return nil, errors.New("window_chars must be positive in StreamingContentFilter plugin")
+	}
+
+	patterns := make([]*regexp.Regexp, 0, len(config.Patterns))
+	for _, pattern := range config.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s' in StreamingContentFilter plugin - %w", pattern, err)
+		}
+		patterns = append(patterns, re)
+	}
+
+	return &StreamingContentFilterPlugin{
+		typedName: plugin.TypedName{
+			Type: StreamingContentFilterPluginType,
+			Name: StreamingContentFilterPluginType,
+		},
+		patterns:    patterns,
+		windowChars: config.WindowChars,
+	}, nil
+}
+
+// StreamingContentFilterPlugin filters content policy violations out of streamed chat completion and
+// completion responses. The generated tokens are accumulated in a sliding window of the most recent
+// characters, which the patterns are matched against after every event, so violations spanning several
+// tokens are detected. The stream is truncated before the first event completing a violation, and ends
+// with an event whose content is [CONTENT_FILTERED] and whose finish reason is content_filter, followed
+// by data: [DONE].
+// Response plugins run on the complete stream, so the client receives the truncated stream at once.
+type StreamingContentFilterPlugin struct {
+	typedName   plugin.TypedName
+	patterns    []*regexp.Regexp
+	windowChars int
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *StreamingContentFilterPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *StreamingContentFilterPlugin) WithName(name string) *StreamingContentFilterPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessResponse truncates the event stream of the response at the first content policy violation.
+func (p *StreamingContentFilterPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Headers == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+	if !response.IsEventStream() {
+		return nil
+	}
+	stream, err := framework.ReadCycleStateKey[[]byte](cycleState, framework.ResponseBodyBytesKey)
+	if err != nil {
+		return nil // the raw body is not available
+	}
+
+	window := []rune{}
+	offset := 0
+	for _, event := range bytes.SplitAfter(stream, []byte("\n\n")) {
+		chunk := parseChunk(event)
+		if chunk == nil {
+			offset += len(event)
+			continue
+		}
+		window = append(window, []rune(chunkText(chunk))...)
+		if len(window) > p.windowChars {
+			window = window[len(window)-p.windowChars:]
+		}
+		if re := p.match(string(window)); re != nil {
+			truncated := append(bytes.Clone(stream[:offset]), filteredEvent(chunk)...)
+			response.SetRawBody(append(truncated, doneEvent...))
+			log.FromContext(ctx).V(logutil.VERBOSE).Info("filtered a content policy violation from the response stream", "pattern", re.String())
+			return nil
+		}
+		offset += len(event)
+	}
+	return nil
+}
+
+// match returns the first pattern matching the given text, or nil.
+func (p *StreamingContentFilterPlugin) match(text string) *regexp.Regexp {
+	for _, re := range p.patterns {
+		if re.MatchString(text) {
+			return re
+		}
+	}
+	return nil
+}
+
+// parseChunk returns the JSON data of the given event, or nil if the event carries no JSON data, e.g. [DONE].
+func parseChunk(event []byte) map[string]any {
+	for _, line := range bytes.Split(event, []byte("\n")) {
+		data, ok := bytes.CutPrefix(bytes.TrimSpace(line), []byte(dataPrefix))
+		if !ok {
+			continue
+		}
+		var chunk map[string]any
+		if err := json.Unmarshal(bytes.TrimSpace(data), &chunk); err != nil {
+			return nil
+		}
+		return chunk
+	}
+	return nil
+}
+
+// chunkText returns the text generated in the given chunk, from the deltas of chat completion chunks
+// or the texts of completion chunks.
+func chunkText(chunk map[string]any) string {
+	text := ""
+	choices, _ := chunk["choices"].([]any)
+	for _, c := range choices {
+		choice, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if delta, ok := choice["delta"].(map[string]any); ok {
+			content, _ := delta["content"].(string)
+			text += content
+		} else if t, ok := choice["text"].(string); ok {
+			text += t
+		}
+	}
+	return text
+}
+
+// filteredEvent returns the event replacing the given violating chunk, which keeps the identifying
+// fields of the chunk, e.g. id and model, and carries the filtered token with a content_filter finish reason.
+func filteredEvent(chunk map[string]any) []byte {
+	choice := map[string]any{"index": 0, "finish_reason": contentFilterState}
+	if choices, _ := chunk["choices"].([]any); len(choices) > 0 {
+		if first, ok := choices[0].(map[string]any); ok {
+			if _, ok := first["text"]; ok {
+				choice["text"] = FilteredToken
+			}
+		}
+	}
+	if _, ok := choice["text"]; !ok {
+		choice["delta"] = map[string]any{"content": FilteredToken}
+	}
+
+	filtered := map[string]any{"choices": []any{choice}}
+	for _, field := range []string{"id", "object", "created", "model", "system_fingerprint"} {
+		if value, ok := chunk[field]; ok {
+			filtered[field] = value
+		}
+	}
+	data, _ := json.Marshal(filtered) // marshaling a map of JSON values can't fail
+	return fmt.Appendf(nil, "%s %s\n\n", dataPrefix, data)
+}
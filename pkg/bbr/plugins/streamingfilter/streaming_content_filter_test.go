@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamingfilter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestStreamingContentFilterPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"patterns":["(?i)secret code"]}`)},
+		{name: "window size", rawParams: json.RawMessage(`{"patterns":["(?i)secret code"],"window_chars":128}`)},
+		{name: "missing patterns", rawParams: json.RawMessage(`{}`), wantErr: true},
+		{name: "invalid pattern", rawParams: json.RawMessage(`{"patterns":["("]}`), wantErr: true},
+		{name: "non-positive window size", rawParams: json.RawMessage(`{"patterns":["x"],"window_chars":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := StreamingContentFilterPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestStreamingContentFilterPlugin(t *testing.T) {
+	const (
+		chatEvent1 = "data: {\"id\":\"c1\",\"model\":\"m\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\"The secret\"}}]}\n\n"
+		chatEvent2 = "data: {\"id\":\"c1\",\"model\":\"m\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\" code is 42\"}}]}\n\n"
+		chatSafe   = "data: {\"id\":\"c1\",\"model\":\"m\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\" is safe\"}}]}\n\n"
+		textEvent1 = "data: {\"id\":\"c2\",\"choices\":[{\"index\":0,\"text\":\"The secret\"}]}\n\n"
+		textEvent2 = "data: {\"id\":\"c2\",\"choices\":[{\"index\":0,\"text\":\" code is 42\"}]}\n\n"
+		done       = "data: [DONE]\n\n"
+
+		filteredChat = "data: {\"choices\":[{\"delta\":{\"content\":\"[CONTENT_FILTERED]\"},\"finish_reason\":\"content_filter\",\"index\":0}],\"id\":\"c1\",\"model\":\"m\"}\n\n"
+		filteredText = "data: {\"choices\":[{\"finish_reason\":\"content_filter\",\"index\":0,\"text\":\"[CONTENT_FILTERED]\"}],\"id\":\"c2\"}\n\n"
+	)
+
+	tests := []struct {
+		name        string
+		windowChars int
+		contentType string
+		stream      string
+		wantBody    string // empty if the body is not replaced
+	}{
+		{
+			name:        "violation spanning two tokens is filtered",
+			windowChars: 512,
+			contentType: "text/event-stream",
+			stream:      chatEvent1 + chatEvent2 + done,
+			wantBody:    chatEvent1 + filteredChat + done,
+		},
+		{
+			name:        "completion stream is filtered",
+			windowChars: 512,
+			contentType: "text/event-stream",
+			stream:      textEvent1 + textEvent2 + done,
+			wantBody:    textEvent1 + filteredText + done,
+		},
+		{
+			name:        "violation longer than the window is missed",
+			windowChars: 5,
+			contentType: "text/event-stream",
+			stream:      chatEvent1 + chatEvent2 + done,
+		},
+		{
+			name:        "safe stream is kept",
+			windowChars: 512,
+			contentType: "text/event-stream",
+			stream:      chatEvent1 + chatSafe + done,
+		},
+		{
+			name:        "non-stream response is ignored",
+			windowChars: 512,
+			contentType: "application/json",
+			stream:      chatEvent1 + chatEvent2 + done,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewStreamingContentFilterPlugin(StreamingContentFilterConfig{
+				Patterns:    []string{`(?i)secret code`},
+				WindowChars: tt.windowChars,
+			})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			response := framework.NewInferenceResponse()
+			response.Headers["content-type"] = tt.contentType
+			cycleState := framework.NewCycleState()
+			cycleState.Write(framework.ResponseBodyBytesKey, []byte(tt.stream))
+
+			if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := string(response.RawBody()); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+			if got := response.BodyMutated(); got != (tt.wantBody != "") {
+				t.Errorf("BodyMutated() = %v, want %v", got, tt.wantBody != "")
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syntheticload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	SyntheticLoadGeneratorPluginType = "synthetic-load-generator"
+
+	// SyntheticHeader activates the plugin when set to "true" on a request.
+	SyntheticHeader = "X-BBR-Synthetic"
+	// SyntheticRequestHeader is set to "true" on requests whose body was replaced with a synthetic one.
+	SyntheticRequestHeader = "X-BBR-Synthetic-Request"
+
+	streamField = "stream"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &SyntheticLoadGeneratorPlugin{}
+
+// SyntheticLoadGeneratorConfig defines the JSON configuration structure for the plugin.
+type SyntheticLoadGeneratorConfig struct {
+	// CorpusFile is the path of a text file with one prompt per line, from which synthetic requests are drawn.
+	CorpusFile string `json:"corpus_file"`
+	// TestModel is the model of the synthetic requests.
+	TestModel string `json:"test_model"`
+}
+
+// SyntheticLoadGeneratorPluginFactory defines the factory function for NewSyntheticLoadGeneratorPlugin.
+func SyntheticLoadGeneratorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := SyntheticLoadGeneratorConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", SyntheticLoadGeneratorPluginType, err)
+		}
+	}
+
+	plugin, err := NewSyntheticLoadGeneratorPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", SyntheticLoadGeneratorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewSyntheticLoadGeneratorPlugin initializes a new SyntheticLoadGeneratorPlugin and returns its pointer.
+func NewSyntheticLoadGeneratorPlugin(config SyntheticLoadGeneratorConfig) (*SyntheticLoadGeneratorPlugin, error) {
+	if config.CorpusFile == "" {
+		return nil, errors.New("corpus_file is required in SyntheticLoadGenerator plugin")
+	}
+	if config.TestModel == "" {
+		return nil, errors.New("test_model is required in SyntheticLoadGenerator plugin")
+	}
+
+	prompts, err := loadCorpus(config.CorpusFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SyntheticLoadGeneratorPlugin{
+		typedName: plugin.TypedName{
+			Type: SyntheticLoadGeneratorPluginType,
+			Name: SyntheticLoadGeneratorPluginType,
+		},
+		prompts:   prompts,
+		testModel: config.TestModel,
+		random:    rand.IntN,
+	}, nil
+}
+
+// SyntheticLoadGeneratorPlugin replaces the body of requests carrying the X-BBR-Synthetic: true header
+// with a synthetic chat completion request, whose prompt is drawn at random from a corpus file and whose
+// model is the configured test model. This allows load testing the backends during staging validation
+// without storing real user prompts. The stream field of the original request is kept, so the load
+// generator controls the response format. Since any client can set the activating header, the plugin
+// should only be configured in staging environments.
+type SyntheticLoadGeneratorPlugin struct {
+	typedName plugin.TypedName
+	prompts   []string
+	testModel string
+	random    func(n int) int
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *SyntheticLoadGeneratorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *SyntheticLoadGeneratorPlugin) WithName(name string) *SyntheticLoadGeneratorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest replaces the body of synthetic requests with a prompt from the corpus.
+func (p *SyntheticLoadGeneratorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+	if !strings.EqualFold(request.GetHeader(SyntheticHeader), "true") {
+		return nil
+	}
+
+	body := map[string]any{
+		"model": p.testModel,
+		"messages": []any{
+			map[string]any{"role": "user", "content": p.prompts[p.random(len(p.prompts))]},
+		},
+	}
+	if stream, ok := request.Body[streamField]; ok {
+		body[streamField] = stream
+	}
+	request.SetBody(body)
+	request.SetHeader(SyntheticRequestHeader, "true")
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("replaced the request with a synthetic request", "model", p.testModel)
+	return nil
+}
+
+// loadCorpus reads the non-empty lines of the corpus file as prompts.
+func loadCorpus(path string) ([]string, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read corpus file '%s' - %w", path, err)
+	}
+	prompts := []string{}
+	for _, line := range strings.Split(string(raw), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			prompts = append(prompts, line)
+		}
+	}
+	if len(prompts) == 0 {
+		return nil, fmt.Errorf("corpus file '%s' has no prompts", path)
+	}
+	return prompts, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package syntheticload
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func writeCorpus(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "corpus.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write corpus file: %v", err)
+	}
+	return path
+}
+
+func TestSyntheticLoadGeneratorPluginFactory(t *testing.T) {
+	corpus := writeCorpus(t, "What is the capital of France?\n\nSummarize the plot of Hamlet.\n")
+	empty := writeCorpus(t, "\n  \n")
+
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "valid config", rawParams: json.RawMessage(`{"corpus_file":"` + corpus + `","test_model":"load-test"}`)},
+		{name: "missing corpus file", rawParams: json.RawMessage(`{"test_model":"load-test"}`), wantErr: true},
+		{name: "missing test model", rawParams: json.RawMessage(`{"corpus_file":"` + corpus + `"}`), wantErr: true},
+		{name: "nonexistent corpus file", rawParams: json.RawMessage(`{"corpus_file":"/nonexistent","test_model":"load-test"}`), wantErr: true},
+		{name: "empty corpus file", rawParams: json.RawMessage(`{"corpus_file":"` + empty + `","test_model":"load-test"}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := SyntheticLoadGeneratorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestSyntheticLoadGeneratorPlugin(t *testing.T) {
+	p, err := NewSyntheticLoadGeneratorPlugin(SyntheticLoadGeneratorConfig{
+		CorpusFile: writeCorpus(t, "What is the capital of France?\n\nSummarize the plot of Hamlet.\n"),
+		TestModel:  "load-test",
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	p.random = func(n int) int { return n - 1 }
+
+	original := map[string]any{
+		"model":    "gpt-4o",
+		"messages": []any{map[string]any{"role": "user", "content": "real user prompt"}},
+		"stream":   true,
+	}
+
+	tests := []struct {
+		name          string
+		header        string
+		wantBody      map[string]any
+		wantSynthetic bool
+	}{
+		{
+			name:     "no header",
+			wantBody: original,
+		},
+		{
+			name:     "header not true",
+			header:   "false",
+			wantBody: original,
+		},
+		{
+			name:   "synthetic request",
+			header: "True",
+			wantBody: map[string]any{
+				"model":    "load-test",
+				"messages": []any{map[string]any{"role": "user", "content": "Summarize the plot of Hamlet."}},
+				"stream":   true,
+			},
+			wantSynthetic: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body = original
+			if tt.header != "" {
+				request.Headers["x-bbr-synthetic"] = tt.header
+			}
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("body mismatch (-want +got):\n%s", diff)
+			}
+			if got := request.GetHeader(SyntheticRequestHeader) == "true"; got != tt.wantSynthetic {
+				t.Errorf("%s set = %v, want %v", SyntheticRequestHeader, got, tt.wantSynthetic)
+			}
+			if got := request.BodyMutated(); got != tt.wantSynthetic {
+				t.Errorf("BodyMutated() = %v, want %v", got, tt.wantSynthetic)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package systemmessage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	SystemMessageRequiredPluginType = "system-message-required"
+	messagesField                   = "messages"
+	systemRole                      = "system"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &SystemMessageRequiredPlugin{}
+
+// SystemMessageRequiredConfig defines the JSON configuration structure for the plugin.
+type SystemMessageRequiredConfig struct {
+	// InjectIfMissing injects the default system message into requests without one, instead of rejecting them.
+	InjectIfMissing bool `json:"inject_if_missing"`
+	// DefaultSystemMessage is the content of the injected system message. Required when InjectIfMissing is set.
+	DefaultSystemMessage string `json:"default_system_message"`
+}
+
+// SystemMessageRequiredPluginFactory defines the factory function for NewSystemMessageRequiredPlugin.
+func SystemMessageRequiredPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config SystemMessageRequiredConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", SystemMessageRequiredPluginType, err)
+		}
+	}
+
+	plugin, err := NewSystemMessageRequiredPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", SystemMessageRequiredPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewSystemMessageRequiredPlugin initializes a new SystemMessageRequiredPlugin and returns its pointer.
+func NewSystemMessageRequiredPlugin(config SystemMessageRequiredConfig) (*SystemMessageRequiredPlugin, error) {
+	if config.InjectIfMissing && config.DefaultSystemMessage == "" {
+		return nil, errors.New("default_system_message is required when inject_if_missing is set in SystemMessageRequired plugin")
+	}
+
+	return &SystemMessageRequiredPlugin{
+		typedName: plugin.TypedName{
+			Type: SystemMessageRequiredPluginType,
+			Name: SystemMessageRequiredPluginType,
+		},
+		injectIfMissing:      config.InjectIfMissing,
+		defaultSystemMessage: config.DefaultSystemMessage,
+	}, nil
+}
+
+// SystemMessageRequiredPlugin enforces that chat completion requests start with a system message,
+// e.g. to comply with policies that apply safety constraints through the system message.
+// Requests without one are rejected with HTTP 400, or get the default system message injected.
+type SystemMessageRequiredPlugin struct {
+	typedName            plugin.TypedName
+	injectIfMissing      bool
+	defaultSystemMessage string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *SystemMessageRequiredPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *SystemMessageRequiredPlugin) WithName(name string) *SystemMessageRequiredPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest checks that the first message of a chat completion request is a system message.
+func (p *SystemMessageRequiredPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	messages, ok := request.Body[messagesField].([]any)
+	if !ok {
+		return nil // not a chat completion request
+	}
+
+	if len(messages) > 0 {
+		if first, ok := messages[0].(map[string]any); ok && first["role"] == systemRole {
+			return nil
+		}
+	}
+
+	if !p.injectIfMissing {
+		return systemMessageRequired()
+	}
+
+	injected := make([]any, 0, len(messages)+1)
+	injected = append(injected, map[string]any{"role": systemRole, "content": p.defaultSystemMessage})
+	request.SetBodyField(messagesField, append(injected, messages...))
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("injected the default system message")
+
+	return nil
+}
+
+// systemMessageRequired returns the HTTP 400 response rejecting a chat completion request without a system message.
+func systemMessageRequired() error {
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message": "A system message is required",
+		},
+	})
+	return errcommon.ImmediateResponse{
+		Code:    errcommon.BadRequest,
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    body,
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package systemmessage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestSystemMessageRequiredPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "inject if missing", rawParams: json.RawMessage(`{"inject_if_missing":true,"default_system_message":"Be safe."}`)},
+		{name: "inject without a default message", rawParams: json.RawMessage(`{"inject_if_missing":true}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := SystemMessageRequiredPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestSystemMessageRequiredPlugin_ProcessRequest(t *testing.T) {
+	system := map[string]any{"role": "system", "content": "You are a helpful assistant."}
+	user := map[string]any{"role": "user", "content": "Hello"}
+	injected := map[string]any{"role": "system", "content": "Be safe."}
+
+	tests := []struct {
+		name         string
+		config       SystemMessageRequiredConfig
+		messages     []any
+		wantCode     string
+		wantMessages []any
+	}{
+		{
+			name:         "system message present",
+			messages:     []any{system, user},
+			wantMessages: []any{system, user},
+		},
+		{
+			name:     "system message missing",
+			messages: []any{user},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "system message is not first",
+			messages: []any{user, system},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "no messages",
+			messages: []any{},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:         "inject if missing",
+			config:       SystemMessageRequiredConfig{InjectIfMissing: true, DefaultSystemMessage: "Be safe."},
+			messages:     []any{user},
+			wantMessages: []any{injected, user},
+		},
+		{
+			name:         "inject keeps an existing system message",
+			config:       SystemMessageRequiredConfig{InjectIfMissing: true, DefaultSystemMessage: "Be safe."},
+			messages:     []any{system, user},
+			wantMessages: []any{system, user},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewSystemMessageRequiredPlugin(tt.config)
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			request := framework.NewInferenceRequest()
+			request.Body["model"] = "llama"
+			request.Body[messagesField] = tt.messages
+
+			err = p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Fatalf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				var immediate errcommon.ImmediateResponse
+				if !errors.As(err, &immediate) {
+					t.Fatalf("expected an immediate response, got %v", err)
+				}
+				if got, want := string(immediate.Body), `{"error":{"message":"A system message is required"}}`; got != want {
+					t.Errorf("body = %s, want %s", got, want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantMessages, request.Body[messagesField]); diff != "" {
+				t.Errorf("unexpected messages (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSystemMessageRequiredPlugin_NotChatCompletion(t *testing.T) {
+	p, err := NewSystemMessageRequiredPlugin(SystemMessageRequiredConfig{})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	request := framework.NewInferenceRequest()
+	request.Body["prompt"] = "hello"
+	if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+		t.Errorf("unexpected error for a completion request: %v", err)
+	}
+}
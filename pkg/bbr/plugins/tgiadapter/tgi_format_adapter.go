@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tgiadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	TGIFormatAdapterPluginType = "tgi-format-adapter"
+	ProviderHeader             = "X-Gateway-Provider"
+	huggingFaceProvider        = "huggingface"
+
+	// DefaultTemplate renders each message on its own line, prefixed by its role, and prompts the assistant.
+	DefaultTemplate = "{{range .Messages}}{{.Role}}: {{.Content}}\n{{end}}assistant:"
+)
+
+// parameterNames maps the OpenAI request fields to their TGI parameter names.
+var parameterNames = map[string]string{
+	"max_tokens":  "max_new_tokens",
+	"temperature": "temperature",
+	"top_p":       "top_p",
+	"stop":        "stop",
+	"seed":        "seed",
+}
+
+// finishReasons maps the TGI finish reasons to the OpenAI ones.
+var finishReasons = map[string]string{
+	"length":        "length",
+	"eos_token":     "stop",
+	"stop_sequence": "stop",
+}
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &TGIFormatAdapterPlugin{}
+	_ framework.ResponseProcessor = &TGIFormatAdapterPlugin{}
+)
+
+// TGIFormatAdapterConfig defines the JSON configuration structure for the plugin.
+type TGIFormatAdapterConfig struct {
+	// Template is the Go template rendering the chat messages into the TGI inputs string. The messages are
+	// available as .Messages, each with a .Role and a .Content. Defaults to DefaultTemplate.
+	Template string `json:"template"`
+}
+
+// TGIFormatAdapterPluginFactory defines the factory function for NewTGIFormatAdapterPlugin.
+func TGIFormatAdapterPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := TGIFormatAdapterConfig{Template: DefaultTemplate}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", TGIFormatAdapterPluginType, err)
+		}
+	}
+
+	plugin, err := NewTGIFormatAdapterPlugin(config.Template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", TGIFormatAdapterPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewTGIFormatAdapterPlugin initializes a new TGIFormatAdapterPlugin and returns its pointer.
+func NewTGIFormatAdapterPlugin(text string) (*TGIFormatAdapterPlugin, error) {
+	if text == "" {
+		text = DefaultTemplate
+	}
+	tmpl, err := template.New(TGIFormatAdapterPluginType).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template in TGIFormatAdapter plugin - %w", err)
+	}
+
+	return &TGIFormatAdapterPlugin{
+		typedName: plugin.TypedName{
+			Type: TGIFormatAdapterPluginType,
+			Name: TGIFormatAdapterPluginType,
+		},
+		template: tmpl,
+	}, nil
+}
+
+// TGIFormatAdapterPlugin converts OpenAI chat completion requests routed to a Hugging Face Text Generation
+// Inference backend (marked with "X-Gateway-Provider: huggingface") into TGI's {"inputs","parameters"}
+// format, and converts TGI's {"generated_text"} response back into an OpenAI chat completion.
+type TGIFormatAdapterPlugin struct {
+	typedName plugin.TypedName
+	template  *template.Template
+}
+
+// message is a chat message, as rendered by the template.
+type message struct {
+	Role    string
+	Content string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *TGIFormatAdapterPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *TGIFormatAdapterPlugin) WithName(name string) *TGIFormatAdapterPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rewrites the chat completion request into TGI's format.
+func (p *TGIFormatAdapterPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	if !strings.EqualFold(request.GetHeader(ProviderHeader), huggingFaceProvider) {
+		return nil
+	}
+
+	rawMessages, ok := request.Body["messages"].([]any)
+	if !ok {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: "field 'messages' is required for TGI backends"}
+	}
+	messages := make([]message, 0, len(rawMessages))
+	for _, m := range rawMessages {
+		if chatMessage, ok := m.(map[string]any); ok {
+			role, _ := chatMessage["role"].(string)
+			messages = append(messages, message{Role: role, Content: messagetext.Join(chatMessage["content"], "")})
+		}
+	}
+
+	var inputs strings.Builder
+	if err := p.template.Execute(&inputs, map[string]any{"Messages": messages}); err != nil {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("failed to render the TGI inputs - %v", err)}
+	}
+
+	parameters := map[string]any{}
+	for openAIName, tgiName := range parameterNames {
+		if value, ok := request.Body[openAIName]; ok {
+			parameters[tgiName] = value
+		}
+	}
+	if stop, ok := parameters["stop"].(string); ok {
+		parameters["stop"] = []any{stop} // TGI expects a list of stop sequences
+	}
+	parameters["details"] = true // for the finish reason and the token counts
+
+	model, _ := request.Body["model"].(string)
+	request.SetBody(map[string]any{
+		"inputs":     inputs.String(),
+		"parameters": parameters,
+	})
+	if cycleState != nil {
+		cycleState.Write(p.typedName.String(), model)
+	}
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("converted request to TGI format", "messages", len(messages))
+
+	return nil
+}
+
+// ProcessResponse converts TGI's generated text into an OpenAI chat completion response.
+func (p *TGIFormatAdapterPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil || cycleState == nil {
+		return nil
+	}
+
+	model, err := framework.ReadCycleStateKey[string](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not sent to a TGI backend
+	}
+
+	generatedText, ok := response.Body["generated_text"].(string)
+	if !ok {
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("TGI response has no generated text, leaving it unchanged")
+		return nil
+	}
+
+	finishReason := "stop"
+	body := map[string]any{
+		"object": "chat.completion",
+		"model":  model,
+	}
+	if details, ok := response.Body["details"].(map[string]any); ok {
+		if reason, ok := finishReasons[fmt.Sprint(details["finish_reason"])]; ok {
+			finishReason = reason
+		}
+		if generatedTokens, ok := details["generated_tokens"].(float64); ok {
+			body["usage"] = map[string]any{"completion_tokens": generatedTokens}
+		}
+	}
+	body["choices"] = []any{map[string]any{
+		"index":         0,
+		"message":       map[string]any{"role": "assistant", "content": generatedText},
+		"finish_reason": finishReason,
+	}}
+
+	response.SetBody(body)
+	return nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tgiadapter
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestTGIFormatAdapterPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "custom template", rawParams: json.RawMessage(`{"template":"{{range .Messages}}<|{{.Role}}|>{{.Content}}{{end}}"}`)},
+		{name: "invalid template", rawParams: json.RawMessage(`{"template":"{{range .Messages}"}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := TGIFormatAdapterPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestTGIFormatAdapterPlugin_ProcessRequest(t *testing.T) {
+	p, err := NewTGIFormatAdapterPlugin(DefaultTemplate)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		provider string
+		body     map[string]any
+		wantBody map[string]any
+		wantCode string
+	}{
+		{
+			name:     "chat request is converted",
+			provider: "HuggingFace",
+			body: map[string]any{
+				"model": "mistral",
+				"messages": []any{
+					map[string]any{"role": "system", "content": "Be brief."},
+					map[string]any{"role": "user", "content": []any{map[string]any{"type": "text", "text": "Hi!"}}},
+				},
+				"max_tokens":  100.0,
+				"temperature": 0.7,
+				"stop":        "\n\n",
+			},
+			wantBody: map[string]any{
+				"inputs": "system: Be brief.\nuser: Hi!\nassistant:",
+				"parameters": map[string]any{
+					"max_new_tokens": 100.0,
+					"temperature":    0.7,
+					"stop":           []any{"\n\n"},
+					"details":        true,
+				},
+			},
+		},
+		{
+			name:     "other providers are ignored",
+			provider: "mlflow",
+			body:     map[string]any{"model": "mistral", "messages": []any{}},
+			wantBody: map[string]any{"model": "mistral", "messages": []any{}},
+		},
+		{
+			name:     "missing messages",
+			provider: "huggingface",
+			body:     map[string]any{"model": "mistral", "prompt": "Hi!"},
+			wantCode: errcommon.BadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Headers["x-gateway-provider"] = tt.provider
+			request.Body = tt.body
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantBody, request.Body); diff != "" {
+				t.Errorf("unexpected body (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTGIFormatAdapterPlugin_ProcessResponse(t *testing.T) {
+	p, err := NewTGIFormatAdapterPlugin(DefaultTemplate)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	cycleState := framework.NewCycleState()
+	request := framework.NewInferenceRequest()
+	request.Headers["x-gateway-provider"] = "huggingface"
+	request.Body = map[string]any{"model": "mistral", "messages": []any{map[string]any{"role": "user", "content": "Hi!"}}}
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("unexpected request error: %v", err)
+	}
+
+	response := framework.NewInferenceResponse()
+	response.Body = map[string]any{
+		"generated_text": "Hello!",
+		"details":        map[string]any{"finish_reason": "length", "generated_tokens": 2.0},
+	}
+	if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+		t.Fatalf("unexpected response error: %v", err)
+	}
+
+	want := map[string]any{
+		"object": "chat.completion",
+		"model":  "mistral",
+		"choices": []any{map[string]any{
+			"index":         0,
+			"message":       map[string]any{"role": "assistant", "content": "Hello!"},
+			"finish_reason": "length",
+		}},
+		"usage": map[string]any{"completion_tokens": 2.0},
+	}
+	if diff := cmp.Diff(want, response.Body); diff != "" {
+		t.Errorf("unexpected response body (-want +got):\n%s", diff)
+	}
+}
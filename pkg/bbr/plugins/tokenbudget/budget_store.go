@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenbudget
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	// registers the pure Go "sqlite" database/sql driver
+	_ "modernc.org/sqlite"
+)
+
+// BudgetStore persists the daily token consumption of the users, so budgets survive restarts of BBR.
+// Days are identified by their UTC date, e.g. "2026-01-31".
+type BudgetStore interface {
+	// Add atomically adds tokens to the consumption of the user on the day, and returns the new consumption.
+	// Negative tokens release tokens reserved in the consumption.
+	Add(ctx context.Context, day, user string, tokens int64) (int64, error)
+	// Get returns the consumption of the user on the day.
+	Get(ctx context.Context, day, user string) (int64, error)
+	// Prune forgets the consumption of the days before the given day.
+	Prune(ctx context.Context, before string) error
+}
+
+// compile-time type validation
+var _ BudgetStore = &SQLiteBudgetStore{}
+
+const (
+	createConsumptionTable = `CREATE TABLE IF NOT EXISTS token_consumption (
+	day TEXT NOT NULL,
+	user_hash TEXT NOT NULL,
+	tokens INTEGER NOT NULL,
+	PRIMARY KEY (day, user_hash)
+)`
+	addConsumption = `INSERT INTO token_consumption (day, user_hash, tokens) VALUES (?, ?, ?)
+ON CONFLICT (day, user_hash) DO UPDATE SET tokens = tokens + excluded.tokens
+RETURNING tokens`
+	getConsumption   = `SELECT tokens FROM token_consumption WHERE day = ? AND user_hash = ?`
+	pruneConsumption = `DELETE FROM token_consumption WHERE day < ?`
+)
+
+// SQLiteBudgetStore is a BudgetStore persisted in a local SQLite database, e.g. on a persistent volume.
+// It suits a single BBR replica; replicas share a RedisBudgetStore instead.
+type SQLiteBudgetStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteBudgetStore opens the SQLite budget store at the given path, creating it if it does not exist.
+func NewSQLiteBudgetStore(path string) (*SQLiteBudgetStore, error) {
+	if path == "" {
+		return nil, errors.New("path is required in the sqlite budget store")
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the budget store database - %w", err)
+	}
+	// SQLite has a single writer, so a single connection serializes the updates instead of failing them as busy
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(createConsumptionTable); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create the budget store table - %w", err)
+	}
+	return &SQLiteBudgetStore{db: db}, nil
+}
+
+// Add atomically adds tokens to the consumption of the user on the day, and returns the new consumption.
+func (s *SQLiteBudgetStore) Add(ctx context.Context, day, user string, tokens int64) (int64, error) {
+	var consumption int64
+	if err := s.db.QueryRowContext(ctx, addConsumption, day, user, tokens).Scan(&consumption); err != nil {
+		return 0, err
+	}
+	return consumption, nil
+}
+
+// Get returns the consumption of the user on the day.
+func (s *SQLiteBudgetStore) Get(ctx context.Context, day, user string) (int64, error) {
+	var consumption int64
+	err := s.db.QueryRowContext(ctx, getConsumption, day, user).Scan(&consumption)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil // the user consumed no tokens on the day
+	}
+	return consumption, err
+}
+
+// Prune forgets the consumption of the days before the given day.
+func (s *SQLiteBudgetStore) Prune(ctx context.Context, before string) error {
+	// dates in the ISO 8601 format sort chronologically
+	_, err := s.db.ExecContext(ctx, pruneConsumption, before)
+	return err
+}
+
+// Close closes the database.
+func (s *SQLiteBudgetStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenbudget
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestSQLiteBudgetStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "budgets.db")
+	store, err := NewSQLiteBudgetStore(path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	if _, err := store.Add(ctx, "2026-01-30", "alice", 10); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+	if _, err := store.Add(ctx, "2026-01-31", "alice", 20); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+	if got, err := store.Add(ctx, "2026-01-31", "alice", 5); err != nil || got != 25 {
+		t.Errorf("Add() = %d, %v, want 25", got, err)
+	}
+
+	if err := store.Prune(ctx, "2026-01-31"); err != nil {
+		t.Fatalf("Prune() unexpected error: %v", err)
+	}
+
+	// the consumption survives a restart
+	if err := store.Close(); err != nil {
+		t.Fatalf("failed to close store: %v", err)
+	}
+	reopened, err := NewSQLiteBudgetStore(path)
+	if err != nil {
+		t.Fatalf("failed to reopen store: %v", err)
+	}
+	defer reopened.Close()
+	for _, tc := range []struct {
+		day, user string
+		want      int64
+	}{
+		{day: "2026-01-30", user: "alice", want: 0},
+		{day: "2026-01-31", user: "alice", want: 25},
+		{day: "2026-01-31", user: "bob", want: 0},
+	} {
+		if got, err := reopened.Get(ctx, tc.day, tc.user); err != nil || got != tc.want {
+			t.Errorf("Get(%s, %s) = %d, %v, want %d", tc.day, tc.user, got, err, tc.want)
+		}
+	}
+}
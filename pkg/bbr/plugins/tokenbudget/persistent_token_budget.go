@@ -0,0 +1,429 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenbudget
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	PersistentTokenBudgetPluginType = "persistent-token-budget"
+
+	SQLiteStoreType = "sqlite"
+	RedisStoreType  = "redis"
+
+	dayLayout = "2006-01-02"
+
+	// estimatedTokensHeader is the header of the estimated prompt tokens of the request, set by a token
+	// estimator plugin running before this plugin.
+	estimatedTokensHeader    = "X-Gateway-Estimated-Tokens"
+	maxTokensField           = "max_tokens"
+	maxCompletionTokensField = "max_completion_tokens"
+)
+
+// remainingBuckets are the buckets of the remaining budget percentage reported by the
+// token_budget_remaining gauge, in decreasing order of their lower bound.
+var remainingBuckets = []struct {
+	label string
+	above float64
+}{
+	{label: "75-100", above: 75},
+	{label: "50-75", above: 50},
+	{label: "25-50", above: 25},
+	{label: "10-25", above: 10},
+	{label: "0-10", above: 0},
+}
+
+// exhaustedBucket is the bucket of the users who consumed their whole budget.
+const exhaustedBucket = "0"
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &PersistentTokenBudgetPlugin{}
+	_ framework.ResponseProcessor = &PersistentTokenBudgetPlugin{}
+	_ framework.RequestCompleter  = &PersistentTokenBudgetPlugin{}
+	_ framework.Closer            = &PersistentTokenBudgetPlugin{}
+)
+
+// PersistentTokenBudgetConfig defines the JSON configuration structure for the plugin.
+type PersistentTokenBudgetConfig struct {
+	// DailyTokenBudget is the number of tokens each user may consume per day (UTC).
+	DailyTokenBudget int64 `json:"daily_token_budget"`
+	// UserHeader is the header identifying the user of the request. It must be set or validated by a plugin
+	// running before this plugin, e.g. the claim header of the jwks-validator plugin, or X-API-Key when the
+	// authentication-gate plugin restricts the accepted api_keys. Requests without it are rejected.
+	UserHeader string `json:"user_header"`
+	// Store configures where the consumption is persisted.
+	Store StoreConfig `json:"store"`
+}
+
+// StoreConfig defines the JSON configuration structure of the budget store.
+type StoreConfig struct {
+	// Type is either "sqlite" or "redis".
+	Type string `json:"type"`
+	// Path is the database file of the "sqlite" store.
+	Path string `json:"path"`
+	// Address is the host:port of the Redis server of the "redis" store.
+	Address string `json:"address"`
+	// Password optionally authenticates to the Redis server of the "redis" store.
+	Password string `json:"password"`
+	// KeyPrefix is the prefix of the keys of the "redis" store, "bbr:token-budget:" by default.
+	KeyPrefix string `json:"key_prefix"`
+}
+
+// PersistentTokenBudgetPluginFactory defines the factory function for NewPersistentTokenBudgetPlugin.
+func PersistentTokenBudgetPluginFactory(name string, rawParameters json.RawMessage, handle framework.Handle) (framework.BBRPlugin, error) {
+	var config PersistentTokenBudgetConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", PersistentTokenBudgetPluginType, err)
+		}
+	}
+
+	store, err := newBudgetStore(config.Store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", PersistentTokenBudgetPluginType, err)
+	}
+
+	plugin, err := NewPersistentTokenBudgetPlugin(config.DailyTokenBudget, config.UserHeader, store)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", PersistentTokenBudgetPluginType, err)
+	}
+
+	if handle != nil {
+		plugin.resetAtMidnight(handle.Context())
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// newBudgetStore creates the budget store described by the configuration.
+func newBudgetStore(config StoreConfig) (BudgetStore, error) {
+	switch config.Type {
+	case SQLiteStoreType:
+		return NewSQLiteBudgetStore(config.Path)
+	case RedisStoreType:
+		if config.Address == "" {
+			return nil, errors.New("address is required in the redis budget store")
+		}
+		client := redis.NewClient(&redis.Options{Addr: config.Address, Password: config.Password})
+		return NewRedisBudgetStore(client, config.KeyPrefix), nil
+	default:
+		return nil, fmt.Errorf("unknown store type %q, must be %q or %q", config.Type, SQLiteStoreType, RedisStoreType)
+	}
+}
+
+// NewPersistentTokenBudgetPlugin initializes a new PersistentTokenBudgetPlugin and returns its pointer.
+func NewPersistentTokenBudgetPlugin(dailyTokenBudget int64, userHeader string, store BudgetStore) (*PersistentTokenBudgetPlugin, error) {
+	if dailyTokenBudget <= 0 {
+		return nil, errors.New("daily_token_budget must be positive in PersistentTokenBudget plugin")
+	}
+	if userHeader == "" {
+		return nil, errors.New("user_header is required in PersistentTokenBudget plugin")
+	}
+	if store == nil {
+		return nil, errors.New("store is required in PersistentTokenBudget plugin")
+	}
+
+	return &PersistentTokenBudgetPlugin{
+		typedName: plugin.TypedName{
+			Type: PersistentTokenBudgetPluginType,
+			Name: PersistentTokenBudgetPluginType,
+		},
+		budget:      dailyTokenBudget,
+		userHeader:  userHeader,
+		store:       store,
+		userBuckets: map[string]string{},
+		bucketUsers: map[string]int{},
+		now:         time.Now,
+	}, nil
+}
+
+// PersistentTokenBudgetPlugin limits the number of tokens each user (identified by the configured user
+// header, which must carry a validated identity) may consume per day (UTC). Each request atomically reserves
+// its estimated tokens in a BudgetStore, and is rejected with HTTP 429 if the reservation exceeds the budget,
+// until midnight UTC. The reservation is then reconciled with the usage of the response, released if the
+// upstream failed, and kept as the consumption if the response reported no usage. The consumption is
+// persisted in the store, so budgets survive restarts and, with a shared store, are enforced across BBR
+// replicas. Users are stored by the SHA-256 hash of their identity. Requests without the user header are
+// rejected with HTTP 401. When the store is unavailable, requests are let through rather than rejected.
+type PersistentTokenBudgetPlugin struct {
+	typedName  plugin.TypedName
+	budget     int64
+	userHeader string
+	store      BudgetStore
+	// stopReset stops resetting the budgets at midnight, nil if they are not reset
+	stopReset context.CancelFunc
+
+	lock sync.Mutex
+	// userBuckets maps the users (hashed) who consumed tokens today to their remaining budget bucket
+	userBuckets map[string]string
+	// bucketUsers counts the users in each remaining budget bucket
+	bucketUsers map[string]int
+	now         func() time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *PersistentTokenBudgetPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *PersistentTokenBudgetPlugin) WithName(name string) *PersistentTokenBudgetPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// reservation is the state kept between the request and the response: the tokens reserved for the request
+// in the consumption of the user on the day, and whether a response without usage was received.
+type reservation struct {
+	day       string
+	user      string
+	tokens    int64
+	responded bool
+}
+
+// ProcessRequest reserves the estimated tokens of the request in the daily budget of its user, and rejects
+// the request if the reservation exceeds the budget.
+func (p *PersistentTokenBudgetPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	logger := log.FromContext(ctx)
+	user := request.GetHeader(p.userHeader)
+	if user == "" {
+		logger.V(logutil.VERBOSE).Info("request without a user, rejecting it", "header", p.userHeader)
+		return errcommon.Error{Code: errcommon.Unauthorized, Msg: fmt.Sprintf("missing %s header", p.userHeader)}
+	}
+
+	reserved := reservation{day: p.today(), user: hashUser(user), tokens: estimatedTokens(request)}
+	// reserving atomically in the store keeps concurrent requests, also of other replicas, from overshooting
+	consumed, err := p.store.Add(ctx, reserved.day, reserved.user, reserved.tokens)
+	if err != nil {
+		logger.Error(err, "Failed to reserve the tokens of the request, letting the request through")
+		return nil
+	}
+	if consumed > p.budget {
+		if _, err := p.store.Add(ctx, reserved.day, reserved.user, -reserved.tokens); err != nil {
+			logger.Error(err, "Failed to release the tokens of the rejected request", "tokens", reserved.tokens)
+		}
+		logger.V(logutil.VERBOSE).Info("daily token budget exhausted", "consumed", consumed-reserved.tokens,
+			"reserved", reserved.tokens, "budget", p.budget)
+		return errcommon.Error{Code: errcommon.ResourceExhausted, Msg: fmt.Sprintf("daily token budget of %d exhausted", p.budget)}
+	}
+
+	cycleState.Write(p.typedName.String(), reserved)
+	return nil
+}
+
+// ProcessResponse replaces the tokens reserved for the request with the tokens used by the response, in the
+// budget of the user of the request. The reservation is released if the response is an upstream error.
+func (p *PersistentTokenBudgetPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || cycleState == nil {
+		return nil
+	}
+
+	reserved, err := framework.ReadCycleStateKey[reservation](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // the request was not processed by this plugin, or its reservation was already reconciled
+	}
+
+	if _, isError := response.Body["error"]; isError {
+		cycleState.Delete(p.typedName.String())
+		p.release(ctx, reserved)
+		return nil
+	}
+
+	tokens := usedTokens(response.Body)
+	if tokens == 0 {
+		// e.g. a streamed chunk without usage, the reservation stands until a later chunk reports the usage
+		reserved.responded = true
+		cycleState.Write(p.typedName.String(), reserved)
+		return nil
+	}
+	cycleState.Delete(p.typedName.String())
+
+	consumed, err := p.store.Add(ctx, reserved.day, reserved.user, tokens-reserved.tokens)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to record the token consumption", "tokens", tokens, "reserved", reserved.tokens)
+		return nil
+	}
+	p.recordRemaining(reserved.user, consumed)
+	return nil
+}
+
+// CompleteRequest settles a reservation left unreconciled when the request ended: it is released if no response
+// was received, e.g. when the upstream failed or the client disconnected, and otherwise kept as the consumption
+// of the request, since the response reported no usage.
+func (p *PersistentTokenBudgetPlugin) CompleteRequest(ctx context.Context, cycleState *framework.CycleState) {
+	if cycleState == nil {
+		return
+	}
+	reserved, err := framework.ReadCycleStateKey[reservation](cycleState, p.typedName.String())
+	if err != nil {
+		return // the request was not processed by this plugin, or its reservation was already reconciled
+	}
+	cycleState.Delete(p.typedName.String())
+
+	if !reserved.responded {
+		p.release(ctx, reserved)
+		return
+	}
+	consumed, err := p.store.Get(ctx, reserved.day, reserved.user)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to read the token consumption")
+		return
+	}
+	p.recordRemaining(reserved.user, consumed)
+}
+
+// release returns the tokens reserved for a request that consumed none to the budget of its user.
+func (p *PersistentTokenBudgetPlugin) release(ctx context.Context, reserved reservation) {
+	if _, err := p.store.Add(ctx, reserved.day, reserved.user, -reserved.tokens); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to release the tokens of the request", "tokens", reserved.tokens)
+	}
+}
+
+// hashUser returns the hex SHA-256 hash of the user identity, which identifies the user in the store, so that
+// the identities, e.g. API keys, are not persisted.
+func hashUser(user string) string {
+	sum := sha256.Sum256([]byte(user))
+	return hex.EncodeToString(sum[:])
+}
+
+// estimatedTokens returns the tokens to reserve for the request: the prompt tokens of the estimated tokens
+// header plus the requested max_completion_tokens or max_tokens, and at least one token.
+func estimatedTokens(request *framework.InferenceRequest) int64 {
+	promptTokens, _ := strconv.ParseInt(request.GetHeader(estimatedTokensHeader), 10, 64)
+	maxTokens, ok := request.Body[maxCompletionTokensField].(float64)
+	if !ok {
+		maxTokens, _ = request.Body[maxTokensField].(float64)
+	}
+	return max(promptTokens+int64(maxTokens), 1)
+}
+
+// usedTokens returns the total tokens of the usage in the response body.
+func usedTokens(body map[string]any) int64 {
+	usage, ok := body["usage"].(map[string]any)
+	if !ok {
+		return 0
+	}
+	if total, ok := usage["total_tokens"].(float64); ok {
+		return int64(total)
+	}
+	promptTokens, _ := usage["prompt_tokens"].(float64)
+	completionTokens, _ := usage["completion_tokens"].(float64)
+	return int64(promptTokens + completionTokens)
+}
+
+// recordRemaining moves the user to the bucket of its remaining budget and updates the gauge.
+func (p *PersistentTokenBudgetPlugin) recordRemaining(user string, consumed int64) {
+	bucket := remainingBucket(float64(p.budget-consumed) / float64(p.budget) * 100)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	previous, ok := p.userBuckets[user]
+	if ok && previous == bucket {
+		return
+	}
+	if ok {
+		p.bucketUsers[previous]--
+		metrics.RecordTokenBudgetRemainingUsers(previous, p.bucketUsers[previous])
+	}
+	p.userBuckets[user] = bucket
+	p.bucketUsers[bucket]++
+	metrics.RecordTokenBudgetRemainingUsers(bucket, p.bucketUsers[bucket])
+}
+
+// remainingBucket returns the bucket of the remaining budget percentage.
+func remainingBucket(remainingPercent float64) string {
+	for _, bucket := range remainingBuckets {
+		if remainingPercent > bucket.above {
+			return bucket.label
+		}
+	}
+	return exhaustedBucket
+}
+
+// Close stops resetting the budgets at midnight. The store is kept open for the requests in flight.
+func (p *PersistentTokenBudgetPlugin) Close() error {
+	if p.stopReset != nil {
+		p.stopReset()
+	}
+	return nil
+}
+
+// resetAtMidnight resets the budgets at every midnight UTC until the context is done or the plugin is closed.
+func (p *PersistentTokenBudgetPlugin) resetAtMidnight(ctx context.Context) {
+	ctx, p.stopReset = context.WithCancel(ctx)
+	go func() {
+		logger := log.FromContext(ctx)
+		for {
+			now := p.now().UTC()
+			midnight := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+			timer := time.NewTimer(midnight.Sub(now))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+				if err := p.reset(ctx); err != nil {
+					logger.Error(err, "Failed to prune the token consumption of past days")
+				}
+			}
+		}
+	}()
+}
+
+// reset starts a new day: the consumption of past days is pruned from the store and the gauge is cleared.
+// Budgets are keyed by day, so the consumption of the new day starts from zero regardless.
+func (p *PersistentTokenBudgetPlugin) reset(ctx context.Context) error {
+	p.lock.Lock()
+	p.userBuckets = map[string]string{}
+	p.bucketUsers = map[string]int{}
+	for _, bucket := range remainingBuckets {
+		metrics.RecordTokenBudgetRemainingUsers(bucket.label, 0)
+	}
+	metrics.RecordTokenBudgetRemainingUsers(exhaustedBucket, 0)
+	p.lock.Unlock()
+
+	return p.store.Prune(ctx, p.today())
+}
+
+func (p *PersistentTokenBudgetPlugin) today() string {
+	return p.now().UTC().Format(dayLayout)
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenbudget
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestPersistentTokenBudgetPluginFactory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "budgets.db")
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "sqlite store", rawParams: json.RawMessage(fmt.Sprintf(`{"daily_token_budget":1000,"user_header":"X-API-Key","store":{"type":"sqlite","path":%q}}`, path))},
+		{name: "redis store", rawParams: json.RawMessage(`{"daily_token_budget":1000,"user_header":"X-API-Key","store":{"type":"redis","address":"localhost:6379"}}`)},
+		{name: "missing budget", rawParams: json.RawMessage(fmt.Sprintf(`{"user_header":"X-API-Key","store":{"type":"sqlite","path":%q}}`, path)), wantErr: true},
+		{name: "missing user header", rawParams: json.RawMessage(fmt.Sprintf(`{"daily_token_budget":1000,"store":{"type":"sqlite","path":%q}}`, path)), wantErr: true},
+		{name: "missing store", rawParams: json.RawMessage(`{"daily_token_budget":1000,"user_header":"X-API-Key"}`), wantErr: true},
+		{name: "unknown store type", rawParams: json.RawMessage(`{"daily_token_budget":1000,"user_header":"X-API-Key","store":{"type":"file"}}`), wantErr: true},
+		{name: "sqlite store without path", rawParams: json.RawMessage(`{"daily_token_budget":1000,"user_header":"X-API-Key","store":{"type":"sqlite"}}`), wantErr: true},
+		{name: "redis store without address", rawParams: json.RawMessage(`{"daily_token_budget":1000,"user_header":"X-API-Key","store":{"type":"redis"}}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := PersistentTokenBudgetPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestPersistentTokenBudgetPlugin(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "budgets.db")
+	store, err := NewSQLiteBudgetStore(path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	p, err := NewPersistentTokenBudgetPlugin(100, "X-API-Key", store)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Date(2026, 1, 31, 23, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+
+	send := func(apiKey string, usage map[string]any) error {
+		cycleState := framework.NewCycleState()
+		request := framework.NewInferenceRequest()
+		request.SetHeader("x-api-key", apiKey)
+		if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+			return err
+		}
+		response := framework.NewInferenceResponse()
+		response.Body["usage"] = usage
+		if err := p.ProcessResponse(context.Background(), cycleState, response); err != nil {
+			t.Fatalf("ProcessResponse() unexpected error: %v", err)
+		}
+		return nil
+	}
+	wantRejected := func(step string, err error) {
+		t.Helper()
+		if got := errcommon.CanonicalCode(err); got != errcommon.ResourceExhausted {
+			t.Errorf("%s: CanonicalCode = %q, want %q", step, got, errcommon.ResourceExhausted)
+		}
+	}
+
+	if err := send("alice", map[string]any{"total_tokens": float64(60)}); err != nil {
+		t.Fatalf("first request: unexpected error: %v", err)
+	}
+	if err := send("alice", map[string]any{"prompt_tokens": float64(30), "completion_tokens": float64(20)}); err != nil {
+		t.Fatalf("second request: unexpected error: %v", err)
+	}
+	wantRejected("request over budget", send("alice", nil))
+	if err := send("bob", nil); err != nil {
+		t.Errorf("request of another user: unexpected error: %v", err)
+	}
+
+	// the consumption survives a restart
+	if err := store.Close(); err != nil {
+		t.Fatalf("failed to close store: %v", err)
+	}
+	store, err = NewSQLiteBudgetStore(path)
+	if err != nil {
+		t.Fatalf("failed to reopen store: %v", err)
+	}
+	defer store.Close()
+	p.store = store
+	wantRejected("request over budget after restart", send("alice", nil))
+
+	now = now.Add(2 * time.Hour)
+	if err := p.reset(context.Background()); err != nil {
+		t.Fatalf("reset() unexpected error: %v", err)
+	}
+	if err := send("alice", nil); err != nil {
+		t.Errorf("request on the next day: unexpected error: %v", err)
+	}
+}
+
+func TestPersistentTokenBudgetPluginReservations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "budgets.db")
+	store, err := NewSQLiteBudgetStore(path)
+	if err != nil {
+		t.Fatalf("failed to open store: %v", err)
+	}
+	p, err := NewPersistentTokenBudgetPlugin(100, "X-API-Key", store)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	ctx := context.Background()
+
+	newRequest := func(apiKey string) *framework.InferenceRequest {
+		request := framework.NewInferenceRequest()
+		if apiKey != "" {
+			request.SetHeader("x-api-key", apiKey)
+		}
+		request.SetHeader(estimatedTokensHeader, "10")
+		request.Body["max_tokens"] = float64(20)
+		return request
+	}
+
+	// concurrent requests reserve their estimated tokens atomically, so only 3 of them fit in the budget
+	var allowed atomic.Int32
+	cycleStates := make(chan *framework.CycleState, 10)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cycleState := framework.NewCycleState()
+			if err := p.ProcessRequest(ctx, cycleState, newRequest("alice")); err == nil {
+				allowed.Add(1)
+				cycleStates <- cycleState
+			}
+		}()
+	}
+	wg.Wait()
+	close(cycleStates)
+	if got := allowed.Load(); got != 3 {
+		t.Fatalf("allowed %d concurrent requests, want 3", got)
+	}
+
+	// the reservations are replaced with the usage of the responses
+	for cycleState := range cycleStates {
+		response := framework.NewInferenceResponse()
+		response.Body["usage"] = map[string]any{"total_tokens": float64(5)}
+		if err := p.ProcessResponse(ctx, cycleState, response); err != nil {
+			t.Fatalf("ProcessResponse() unexpected error: %v", err)
+		}
+	}
+	wantConsumption := func(step string, want int64) {
+		t.Helper()
+		if got, _ := store.Get(ctx, p.today(), hashUser("alice")); got != want {
+			t.Errorf("%s: consumption = %d, want %d", step, got, want)
+		}
+	}
+	wantConsumption("after the responses", 15)
+
+	// the API keys are not persisted
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read the store file: %v", err)
+	}
+	if strings.Contains(string(raw), "alice") {
+		t.Errorf("the store file contains the API key")
+	}
+
+	// requests without a user are rejected and not charged
+	err = p.ProcessRequest(ctx, framework.NewCycleState(), newRequest(""))
+	if got := errcommon.CanonicalCode(err); got != errcommon.Unauthorized {
+		t.Errorf("request without a user: CanonicalCode = %q, want %q", got, errcommon.Unauthorized)
+	}
+	if got, _ := store.Get(ctx, p.today(), hashUser("")); got != 0 {
+		t.Errorf("consumption without a user = %d, want 0", got)
+	}
+
+	// the reservation of a request failed upstream is released
+	cycleState := framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, cycleState, newRequest("alice")); err != nil {
+		t.Fatalf("ProcessRequest() unexpected error: %v", err)
+	}
+	response := framework.NewInferenceResponse()
+	response.Body["error"] = map[string]any{"message": "upstream failure"}
+	if err := p.ProcessResponse(ctx, cycleState, response); err != nil {
+		t.Fatalf("ProcessResponse() unexpected error: %v", err)
+	}
+	p.CompleteRequest(ctx, cycleState)
+	wantConsumption("after an upstream error", 15)
+
+	// the reservation of a request ended without a response is released
+	cycleState = framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, cycleState, newRequest("alice")); err != nil {
+		t.Fatalf("ProcessRequest() unexpected error: %v", err)
+	}
+	p.CompleteRequest(ctx, cycleState)
+	wantConsumption("after a request without a response", 15)
+
+	// the reservation of a response without usage is kept as the consumption
+	cycleState = framework.NewCycleState()
+	if err := p.ProcessRequest(ctx, cycleState, newRequest("alice")); err != nil {
+		t.Fatalf("ProcessRequest() unexpected error: %v", err)
+	}
+	if err := p.ProcessResponse(ctx, cycleState, framework.NewInferenceResponse()); err != nil {
+		t.Fatalf("ProcessResponse() unexpected error: %v", err)
+	}
+	p.CompleteRequest(ctx, cycleState)
+	p.CompleteRequest(ctx, cycleState) // completing twice settles the reservation once
+	wantConsumption("after a response without usage", 45)
+}
+
+func TestRemainingBucket(t *testing.T) {
+	tests := []struct {
+		remainingPercent float64
+		want             string
+	}{
+		{remainingPercent: 100, want: "75-100"},
+		{remainingPercent: 75, want: "50-75"},
+		{remainingPercent: 30, want: "25-50"},
+		{remainingPercent: 10, want: "0-10"},
+		{remainingPercent: 5, want: "0-10"},
+		{remainingPercent: 0, want: "0"},
+		{remainingPercent: -20, want: "0"},
+	}
+	for _, tt := range tests {
+		if got := remainingBucket(tt.remainingPercent); got != tt.want {
+			t.Errorf("remainingBucket(%v) = %q, want %q", tt.remainingPercent, got, tt.want)
+		}
+	}
+}
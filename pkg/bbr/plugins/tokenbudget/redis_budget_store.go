@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenbudget
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultRedisKeyPrefix = "bbr:token-budget:"
+
+	// redisKeyTTL keeps the consumption of a day a while past its end, after which Redis expires it.
+	redisKeyTTL = 48 * time.Hour
+)
+
+// incrementScript increments the consumption and sets its expiry in a single atomic step.
+var incrementScript = redis.NewScript(`local v = redis.call('INCRBY', KEYS[1], ARGV[1]) redis.call('EXPIRE', KEYS[1], ARGV[2]) return v`)
+
+// compile-time type validation
+var _ BudgetStore = &RedisBudgetStore{}
+
+// RedisBudgetStore is a BudgetStore backed by Redis, shared by all the BBR replicas.
+type RedisBudgetStore struct {
+	client    redis.UniversalClient
+	keyPrefix string
+}
+
+// NewRedisBudgetStore creates a Redis budget store storing the consumption with the given client, under keys
+// with the given prefix, "bbr:token-budget:" if empty.
+func NewRedisBudgetStore(client redis.UniversalClient, keyPrefix string) *RedisBudgetStore {
+	if keyPrefix == "" {
+		keyPrefix = defaultRedisKeyPrefix
+	}
+
+	return &RedisBudgetStore{
+		client:    client,
+		keyPrefix: keyPrefix,
+	}
+}
+
+// Add atomically adds tokens to the consumption of the user on the day, and returns the new consumption.
+func (s *RedisBudgetStore) Add(ctx context.Context, day, user string, tokens int64) (int64, error) {
+	return incrementScript.Run(ctx, s.client, []string{s.key(day, user)}, tokens, int(redisKeyTTL.Seconds())).Int64()
+}
+
+// Get returns the consumption of the user on the day.
+func (s *RedisBudgetStore) Get(ctx context.Context, day, user string) (int64, error) {
+	consumption, err := s.client.Get(ctx, s.key(day, user)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil // the user consumed no tokens on the day
+	}
+	return consumption, err
+}
+
+// Prune is a no-op, since Redis expires the consumption of past days by itself.
+func (s *RedisBudgetStore) Prune(context.Context, string) error {
+	return nil
+}
+
+func (s *RedisBudgetStore) key(day, user string) string {
+	return s.keyPrefix + day + ":" + user
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenbudget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestRedisBudgetStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	store := NewRedisBudgetStore(redis.NewClient(&redis.Options{Addr: server.Addr()}), "")
+	ctx := context.Background()
+
+	if got, err := store.Get(ctx, "2026-01-31", "alice"); err != nil || got != 0 {
+		t.Errorf("Get() = %d, %v, want 0", got, err)
+	}
+	if _, err := store.Add(ctx, "2026-01-31", "alice", 20); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+	if got, err := store.Add(ctx, "2026-01-31", "alice", 5); err != nil || got != 25 {
+		t.Errorf("Add() = %d, %v, want 25", got, err)
+	}
+	if got, err := store.Add(ctx, "2026-01-31", "alice", -5); err != nil || got != 20 {
+		t.Errorf("Add() of a release = %d, %v, want 20", got, err)
+	}
+	if got, err := store.Get(ctx, "2026-01-31", "alice"); err != nil || got != 20 {
+		t.Errorf("Get() = %d, %v, want 20", got, err)
+	}
+	if got, err := server.Get("bbr:token-budget:2026-01-31:alice"); err != nil || got != "20" {
+		t.Errorf("stored value = %q, %v, want 20", got, err)
+	}
+
+	// Redis expires the consumption of past days
+	server.FastForward(redisKeyTTL + time.Second)
+	if got, err := store.Get(ctx, "2026-01-31", "alice"); err != nil || got != 0 {
+		t.Errorf("Get() after the TTL = %d, %v, want 0", got, err)
+	}
+
+	server.Close()
+	if _, err := store.Add(ctx, "2026-01-31", "alice", 5); err == nil {
+		t.Error("Add() on an unavailable server should fail")
+	}
+}
+
+func TestRedisBudgetStoreKeyPrefix(t *testing.T) {
+	server := miniredis.RunT(t)
+	store := NewRedisBudgetStore(redis.NewClient(&redis.Options{Addr: server.Addr()}), "tenant:")
+
+	if _, err := store.Add(context.Background(), "2026-01-31", "alice", 7); err != nil {
+		t.Fatalf("Add() unexpected error: %v", err)
+	}
+	if got, err := server.Get("tenant:2026-01-31:alice"); err != nil || got != "7" {
+		t.Errorf("stored value = %q, %v, want 7", got, err)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenestimator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/internal/messagetext"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	TiktokenEstimatorPluginType = "tiktoken-estimator"
+	EstimatedTokensHeader       = "X-Gateway-Estimated-Tokens"
+
+	// BlockAction rejects the requests exceeding the threshold.
+	BlockAction = "block"
+	// RewriteAction lowers the max_tokens of the requests exceeding the threshold to fit in it.
+	RewriteAction = "rewrite"
+
+	maxTokensField = "max_tokens"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &TiktokenEstimatorPlugin{}
+
+// TiktokenEstimatorConfig defines the JSON configuration structure for the plugin.
+type TiktokenEstimatorConfig struct {
+	// MaxTokens is the threshold of the estimated prompt tokens plus the requested max_tokens of a request.
+	MaxTokens int `json:"max_tokens"`
+	// Action is the action on the requests exceeding the threshold, "block" (default) or "rewrite".
+	Action string `json:"action"`
+}
+
+// TiktokenEstimatorPluginFactory defines the factory function for NewTiktokenEstimatorPlugin.
+func TiktokenEstimatorPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := TiktokenEstimatorConfig{
+		Action: BlockAction,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", TiktokenEstimatorPluginType, err)
+		}
+	}
+
+	plugin, err := NewTiktokenEstimatorPlugin(config.MaxTokens, config.Action)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", TiktokenEstimatorPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewTiktokenEstimatorPlugin initializes a new TiktokenEstimatorPlugin and returns its pointer.
+func NewTiktokenEstimatorPlugin(maxTokens int, action string) (*TiktokenEstimatorPlugin, error) {
+	if maxTokens <= 0 {
+		return nil, errors.New("max_tokens must be positive in TiktokenEstimator plugin")
+	}
+	if action != BlockAction && action != RewriteAction {
+		return nil, fmt.Errorf("action must be '%s' or '%s' in TiktokenEstimator plugin, got '%s'", BlockAction, RewriteAction, action)
+	}
+
+	return &TiktokenEstimatorPlugin{
+		typedName: plugin.TypedName{
+			Type: TiktokenEstimatorPluginType,
+			Name: TiktokenEstimatorPluginType,
+		},
+		estimator: &TiktokenEstimator{},
+		maxTokens: maxTokens,
+		action:    action,
+	}, nil
+}
+
+// TiktokenEstimatorPlugin estimates the number of tokens of the prompt before the routing decision, and
+// exposes it in the X-Gateway-Estimated-Tokens header. When the estimated prompt tokens plus the requested
+// max_tokens exceed the threshold, the request is either rejected, or its max_tokens is lowered to the
+// tokens left by the prompt. Requests whose prompt alone exceeds the threshold are always rejected.
+type TiktokenEstimatorPlugin struct {
+	typedName plugin.TypedName
+	estimator TokenEstimator
+	maxTokens int
+	action    string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *TiktokenEstimatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *TiktokenEstimatorPlugin) WithName(name string) *TiktokenEstimatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest estimates the prompt tokens of the request, and enforces the threshold.
+func (p *TiktokenEstimatorPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	promptTokens, err := p.estimator.EstimateTokens(ctx, PromptText(request.Body))
+	if err != nil {
+		return fmt.Errorf("failed to estimate the prompt tokens - %w", err)
+	}
+	request.SetHeader(EstimatedTokensHeader, strconv.Itoa(promptTokens))
+
+	if promptTokens > p.maxTokens {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("the estimated prompt tokens %d exceed the limit of %d tokens", promptTokens, p.maxTokens)}
+	}
+	requestedTokens, ok := request.Body[maxTokensField].(float64)
+	if !ok || promptTokens+int(requestedTokens) <= p.maxTokens {
+		return nil
+	}
+	if p.action == BlockAction || promptTokens == p.maxTokens {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("the estimated prompt tokens %d plus max_tokens %d exceed the limit of %d tokens", promptTokens, int(requestedTokens), p.maxTokens)}
+	}
+
+	allowedTokens := p.maxTokens - promptTokens
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("lowering max_tokens to the token limit", "promptTokens", promptTokens, "maxTokens", int(requestedTokens), "allowedTokens", allowedTokens)
+	request.SetBodyField(maxTokensField, allowedTokens)
+	return nil
+}
+
+// PromptText returns the text of the messages of chat completion requests, or the prompt of completion
+// requests.
+func PromptText(body map[string]any) string {
+	return strings.Join(messagetext.Request(body), "\n")
+}
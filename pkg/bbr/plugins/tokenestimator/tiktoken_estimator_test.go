@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenestimator
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+// wordEstimator estimates a token per word, to make the expected counts obvious.
+type wordEstimator struct{}
+
+func (e *wordEstimator) EstimateTokens(_ context.Context, promptText string) (int, error) {
+	return len(strings.Fields(promptText)), nil
+}
+
+func TestTiktokenEstimatorPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"max_tokens":4096}`),
+		},
+		{
+			name:      "valid config with rewrite action",
+			rawParams: json.RawMessage(`{"max_tokens":4096,"action":"rewrite"}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "missing max tokens",
+			rawParams: json.RawMessage(`{}`),
+			wantErr:   true,
+		},
+		{
+			name:      "unknown action",
+			rawParams: json.RawMessage(`{"max_tokens":4096,"action":"truncate"}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := TiktokenEstimatorPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestTiktokenEstimatorPlugin(t *testing.T) {
+	tests := []struct {
+		name          string
+		action        string
+		body          map[string]any
+		wantCode      string
+		wantHeaders   map[string]string
+		wantMaxTokens any
+	}{
+		{
+			name:   "chat completion within the limit",
+			action: BlockAction,
+			body: map[string]any{
+				"messages": []any{
+					map[string]any{"role": "system", "content": "be brief"},
+					map[string]any{"role": "user", "content": []any{map[string]any{"type": "text", "text": "hello there"}}},
+				},
+				"max_tokens": float64(6),
+			},
+			wantHeaders:   map[string]string{EstimatedTokensHeader: "4"},
+			wantMaxTokens: float64(6),
+		},
+		{
+			name:     "prompt exceeds the limit",
+			action:   RewriteAction,
+			body:     map[string]any{"prompt": "one two three four five six seven eight nine ten eleven"},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "max tokens exceed the limit and the request is blocked",
+			action:   BlockAction,
+			body:     map[string]any{"prompt": "one two three", "max_tokens": float64(100)},
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:          "max tokens exceed the limit and are rewritten",
+			action:        RewriteAction,
+			body:          map[string]any{"prompt": "one two three", "max_tokens": float64(100)},
+			wantHeaders:   map[string]string{EstimatedTokensHeader: "3"},
+			wantMaxTokens: 7,
+		},
+		{
+			name:     "prompt uses the whole limit",
+			action:   RewriteAction,
+			body:     map[string]any{"prompt": "one two three four five six seven eight nine ten", "max_tokens": float64(1)},
+			wantCode: errcommon.BadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewTiktokenEstimatorPlugin(10, tt.action)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			p.estimator = &wordEstimator{}
+
+			request := framework.NewInferenceRequest()
+			request.Body = tt.body
+			err = p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if errcommon.CanonicalCode(err) != tt.wantCode {
+					t.Fatalf("expected %s, got %v", tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantHeaders, request.MutatedHeaders()); diff != "" {
+				t.Errorf("unexpected headers (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantMaxTokens, request.Body["max_tokens"]); diff != "" {
+				t.Errorf("unexpected max_tokens (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
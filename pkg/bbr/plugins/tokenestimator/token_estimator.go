@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenestimator
+
+import (
+	"context"
+	"regexp"
+)
+
+// bytesPerToken is the number of bytes of a pre-tokenized piece of text that BPE typically merges into a
+// single token.
+const bytesPerToken = 6
+
+// preTokenizer splits text into the pieces that tiktoken's cl100k_base encoding tokenizes separately:
+// contractions, words with their leading space or punctuation, numbers of up to three digits, runs of
+// punctuation and runs of whitespace. Go regexps don't support the negative lookahead of the original
+// pattern, so trailing whitespace is not split from the next word.
+var preTokenizer = regexp.MustCompile(`(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+`)
+
+// TokenEstimator estimates the number of tokens of a prompt.
+type TokenEstimator interface {
+	EstimateTokens(ctx context.Context, promptText string) (int, error)
+}
+
+// compile-time type validation
+var _ TokenEstimator = &TiktokenEstimator{}
+
+// TiktokenEstimator estimates the number of tokens of a prompt for the cl100k_base encoding, without the
+// BPE ranks of the encoding: it splits the prompt with the pre-tokenization pattern of the encoding, and
+// counts a token per bytesPerToken bytes of each piece. Common words are a single token, as in cl100k_base.
+type TiktokenEstimator struct{}
+
+// EstimateTokens returns the estimated number of tokens of the prompt.
+func (e *TiktokenEstimator) EstimateTokens(_ context.Context, promptText string) (int, error) {
+	tokens := 0
+	for _, piece := range preTokenizer.FindAllString(promptText, -1) {
+		tokens += (len(piece) + bytesPerToken - 1) / bytesPerToken
+	}
+	return tokens, nil
+}
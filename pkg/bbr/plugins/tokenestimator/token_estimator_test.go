@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenestimator
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTiktokenEstimator(t *testing.T) {
+	tests := []struct {
+		name   string
+		prompt string
+		want   int
+	}{
+		{
+			name:   "empty prompt",
+			prompt: "",
+			want:   0,
+		},
+		{
+			name:   "words and punctuation",
+			prompt: "Hello, world!",
+			want:   4,
+		},
+		{
+			name:   "numbers are split in groups of three digits",
+			prompt: "12345",
+			want:   2,
+		},
+		{
+			name:   "contractions",
+			prompt: "I'm",
+			want:   2,
+		},
+		{
+			name:   "long words span several tokens",
+			prompt: " internationalization",
+			want:   4,
+		},
+	}
+	estimator := &TiktokenEstimator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := estimator.EstimateTokens(context.Background(), tt.prompt)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("EstimateTokens(%q) = %d, want %d", tt.prompt, got, tt.want)
+			}
+		})
+	}
+}
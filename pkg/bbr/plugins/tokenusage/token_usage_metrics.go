@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenusage
+
+import (
+	"context"
+	"encoding/json"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	TokenUsageMetricsPluginType = "token-usage-metrics"
+)
+
+// compile-time type validation
+var _ framework.ResponseProcessor = &TokenUsageMetricsPlugin{}
+
+// TokenUsageMetricsPluginFactory defines the factory function for NewTokenUsageMetricsPlugin.
+func TokenUsageMetricsPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewTokenUsageMetricsPlugin().WithName(name), nil
+}
+
+// NewTokenUsageMetricsPlugin initializes a new TokenUsageMetricsPlugin and returns its pointer.
+func NewTokenUsageMetricsPlugin() *TokenUsageMetricsPlugin {
+	return &TokenUsageMetricsPlugin{
+		typedName: plugin.TypedName{
+			Type: TokenUsageMetricsPluginType,
+			Name: TokenUsageMetricsPluginType,
+		},
+	}
+}
+
+// TokenUsageMetricsPlugin records the prompt and completion token counts reported in the "usage"
+// field of the responses, and the ratio between them, as per-model Prometheus histograms.
+type TokenUsageMetricsPlugin struct {
+	typedName plugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *TokenUsageMetricsPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *TokenUsageMetricsPlugin) WithName(name string) *TokenUsageMetricsPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessResponse records the token usage of the response.
+func (p *TokenUsageMetricsPlugin) ProcessResponse(ctx context.Context, _ *framework.CycleState, response *framework.InferenceResponse) error {
+	if response == nil || response.Body == nil {
+		return nil
+	}
+
+	usage, ok := response.Body["usage"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	promptTokens, _ := usage["prompt_tokens"].(float64)
+	completionTokens, _ := usage["completion_tokens"].(float64)
+	model, _ := response.Body["model"].(string)
+
+	metrics.RecordTokenUsage(model, int(promptTokens), int(completionTokens))
+	log.FromContext(ctx).V(logutil.TRACE).Info("recorded token usage", "model", model, "promptTokens", promptTokens, "completionTokens", completionTokens)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tokenusage
+
+import (
+	"context"
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+)
+
+// histogram returns the histogram of the given metric for the given model, or nil if there is none.
+func histogram(t *testing.T, name, model string) *dto.Histogram {
+	t.Helper()
+	families, err := crmetrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "model" && label.GetValue() == model {
+					return metric.GetHistogram()
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func TestTokenUsageMetricsPlugin_ProcessResponse(t *testing.T) {
+	metrics.Register()
+	p := NewTokenUsageMetricsPlugin()
+
+	response := framework.NewInferenceResponse()
+	response.Body["model"] = "token-usage-test-model"
+	response.Body["usage"] = map[string]any{"prompt_tokens": 200.0, "completion_tokens": 50.0, "total_tokens": 250.0}
+	if err := p.ProcessResponse(context.Background(), framework.NewCycleState(), response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		metric  string
+		wantSum float64
+	}{
+		{metric: "bbr_prompt_tokens", wantSum: 200},
+		{metric: "bbr_completion_tokens", wantSum: 50},
+		{metric: "bbr_completion_to_prompt_tokens_ratio", wantSum: 0.25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.metric, func(t *testing.T) {
+			h := histogram(t, tt.metric, "token-usage-test-model")
+			if h == nil {
+				t.Fatalf("metric %q was not recorded", tt.metric)
+			}
+			if got := h.GetSampleCount(); got != 1 {
+				t.Errorf("sample count = %d, want 1", got)
+			}
+			if got := h.GetSampleSum(); got != tt.wantSum {
+				t.Errorf("sample sum = %v, want %v", got, tt.wantSum)
+			}
+		})
+	}
+}
+
+func TestTokenUsageMetricsPlugin_NoUsage(t *testing.T) {
+	metrics.Register()
+	p := NewTokenUsageMetricsPlugin()
+
+	response := framework.NewInferenceResponse()
+	response.Body["model"] = "token-usage-no-usage-model"
+	if err := p.ProcessResponse(context.Background(), framework.NewCycleState(), response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h := histogram(t, "bbr_prompt_tokens", "token-usage-no-usage-model"); h != nil {
+		t.Errorf("expected no prompt tokens to be recorded, got %v", h)
+	}
+}
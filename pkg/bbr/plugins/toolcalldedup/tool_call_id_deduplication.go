@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolcalldedup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ToolCallIDDeduplicationPluginType = "tool-call-id-deduplication"
+	messagesField                     = "messages"
+	assistantRole                     = "assistant"
+	toolRole                          = "tool"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ToolCallIDDeduplicationPlugin{}
+
+// ToolCallIDDeduplicationPluginFactory defines the factory function for NewToolCallIDDeduplicationPlugin.
+func ToolCallIDDeduplicationPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewToolCallIDDeduplicationPlugin().WithName(name), nil
+}
+
+// NewToolCallIDDeduplicationPlugin initializes a new ToolCallIDDeduplicationPlugin and returns its pointer.
+func NewToolCallIDDeduplicationPlugin() *ToolCallIDDeduplicationPlugin {
+	return &ToolCallIDDeduplicationPlugin{
+		typedName: plugin.TypedName{
+			Type: ToolCallIDDeduplicationPluginType,
+			Name: ToolCallIDDeduplicationPluginType,
+		},
+	}
+}
+
+// ToolCallIDDeduplicationPlugin rejects conversations in which the same tool_call_id is answered by more
+// than one tool message following the same assistant message, with HTTP 400 listing the duplicate IDs.
+// Backends otherwise fail to parse such conversations and report the failure as HTTP 500.
+type ToolCallIDDeduplicationPlugin struct {
+	typedName plugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ToolCallIDDeduplicationPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ToolCallIDDeduplicationPlugin) WithName(name string) *ToolCallIDDeduplicationPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest rejects the request if a tool_call_id is repeated between two assistant messages.
+func (p *ToolCallIDDeduplicationPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	messages, ok := request.Body[messagesField].([]any)
+	if !ok {
+		return nil
+	}
+
+	duplicates := duplicateToolCallIDs(messages)
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	log.FromContext(ctx).V(logutil.VERBOSE).Info("rejected duplicate tool_call_id values", "duplicates", duplicates)
+	return errcommon.Error{Code: errcommon.BadRequest, Msg: fmt.Sprintf("duplicate tool_call_id values: %s", strings.Join(duplicates, ", "))}
+}
+
+// duplicateToolCallIDs returns the tool_call_id values repeated by the tool messages answering the same
+// assistant message, in the order they were first repeated.
+func duplicateToolCallIDs(messages []any) []string {
+	var duplicates []string
+	seen := map[string]bool{}
+	for _, raw := range messages {
+		message, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		switch message["role"] {
+		case assistantRole:
+			clear(seen) // a new response window
+		case toolRole:
+			id, _ := message["tool_call_id"].(string)
+			if id == "" {
+				continue
+			}
+			if seen[id] && !slices.Contains(duplicates, id) {
+				duplicates = append(duplicates, id)
+			}
+			seen[id] = true
+		}
+	}
+	return duplicates
+}
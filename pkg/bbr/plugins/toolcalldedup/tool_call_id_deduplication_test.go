@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolcalldedup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestToolCallIDDeduplicationPlugin(t *testing.T) {
+	assistant := map[string]any{"role": "assistant", "tool_calls": []any{}}
+	tool := func(id string) map[string]any {
+		return map[string]any{"role": "tool", "tool_call_id": id, "content": "result"}
+	}
+	tests := []struct {
+		name     string
+		messages []any
+		wantCode string
+		wantMsg  string
+	}{
+		{
+			name:     "distinct ids",
+			messages: []any{map[string]any{"role": "user", "content": "hi"}, assistant, tool("call_1"), tool("call_2")},
+		},
+		{
+			name:     "same id in different windows",
+			messages: []any{assistant, tool("call_1"), assistant, tool("call_1")},
+		},
+		{
+			name:     "duplicate ids",
+			messages: []any{assistant, tool("call_1"), tool("call_2"), tool("call_1"), tool("call_2"), tool("call_1")},
+			wantCode: errcommon.BadRequest,
+			wantMsg:  "duplicate tool_call_id values: call_1, call_2",
+		},
+	}
+	p := NewToolCallIDDeduplicationPlugin()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			request.Body["messages"] = tt.messages
+
+			err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if tt.wantCode != "" {
+				if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+					t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+				}
+				var e errcommon.Error
+				if !errors.As(err, &e) {
+					t.Fatalf("error = %v, want an errcommon.Error", err)
+				}
+				if diff := cmp.Diff(tt.wantMsg, e.Msg); diff != "" {
+					t.Errorf("unexpected message (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolcallratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ToolCallRateLimitPluginType = "tool-call-rate-limit"
+	APIKeyHeader                = "X-API-Key"
+
+	window = time.Minute
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ToolCallRateLimitPlugin{}
+
+// ToolCallRateLimitConfig defines the JSON configuration structure for the plugin.
+type ToolCallRateLimitConfig struct {
+	// MaxToolCallsPerMinute is the number of tool definitions a single client may send per minute.
+	MaxToolCallsPerMinute int `json:"max_tool_calls_per_minute"`
+}
+
+// ToolCallRateLimitPluginFactory defines the factory function for NewToolCallRateLimitPlugin.
+func ToolCallRateLimitPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	var config ToolCallRateLimitConfig
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", ToolCallRateLimitPluginType, err)
+		}
+	}
+
+	plugin, err := NewToolCallRateLimitPlugin(config.MaxToolCallsPerMinute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", ToolCallRateLimitPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewToolCallRateLimitPlugin initializes a new ToolCallRateLimitPlugin and returns its pointer.
+func NewToolCallRateLimitPlugin(maxToolCallsPerMinute int) (*ToolCallRateLimitPlugin, error) {
+	if maxToolCallsPerMinute <= 0 {
+		return nil, errors.New("max_tool_calls_per_minute must be positive in ToolCallRateLimit plugin")
+	}
+
+	return &ToolCallRateLimitPlugin{
+		typedName: plugin.TypedName{
+			Type: ToolCallRateLimitPluginType,
+			Name: ToolCallRateLimitPluginType,
+		},
+		budget:  maxToolCallsPerMinute,
+		clients: map[string][]toolCallEvent{},
+		now:     time.Now,
+	}, nil
+}
+
+// toolCallEvent records the number of tool definitions sent by a client in a single request.
+type toolCallEvent struct {
+	timestamp time.Time
+	count     int
+}
+
+// ToolCallRateLimitPlugin limits the number of tool definitions each client (identified by
+// its X-API-Key header) may send in a sliding window of one minute.
+// Requests exceeding the budget are rejected with HTTP 429.
+type ToolCallRateLimitPlugin struct {
+	typedName plugin.TypedName
+	budget    int
+
+	lock      sync.Mutex
+	clients   map[string][]toolCallEvent
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ToolCallRateLimitPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ToolCallRateLimitPlugin) WithName(name string) *ToolCallRateLimitPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest counts the tools in the request and rejects it if the client exceeded its budget.
+func (p *ToolCallRateLimitPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	count := countTools(request.Body)
+	if count == 0 {
+		return nil
+	}
+
+	apiKey := request.GetHeader(APIKeyHeader)
+	if !p.allow(apiKey, count) {
+		log.FromContext(ctx).V(logutil.VERBOSE).Info("tool call budget exceeded", "toolCalls", count, "budget", p.budget)
+		return errcommon.Error{Code: errcommon.ResourceExhausted, Msg: fmt.Sprintf("tool call budget of %d per minute exceeded", p.budget)}
+	}
+
+	return nil
+}
+
+// allow records the given number of tool calls for the client if they fit in the remaining budget.
+func (p *ToolCallRateLimitPlugin) allow(apiKey string, count int) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := p.now()
+	p.sweep(now)
+
+	events := p.clients[apiKey]
+	// drop the events that left the sliding window
+	first := 0
+	used := 0
+	for i, event := range events {
+		if now.Sub(event.timestamp) >= window {
+			first = i + 1
+			continue
+		}
+		used += event.count
+	}
+	events = events[first:]
+
+	if used+count > p.budget {
+		p.clients[apiKey] = events
+		return false
+	}
+
+	p.clients[apiKey] = append(events, toolCallEvent{timestamp: now, count: count})
+	return true
+}
+
+// sweep forgets the clients that sent no tools during the last window, at most once per window.
+func (p *ToolCallRateLimitPlugin) sweep(now time.Time) {
+	if now.Sub(p.lastSweep) < window {
+		return
+	}
+	p.lastSweep = now
+	for apiKey, events := range p.clients {
+		if len(events) == 0 || now.Sub(events[len(events)-1].timestamp) >= window {
+			delete(p.clients, apiKey)
+		}
+	}
+}
+
+// countTools returns the number of tools and (deprecated) functions defined in the request body.
+func countTools(body map[string]any) int {
+	count := 0
+	if tools, ok := body["tools"].([]any); ok {
+		count += len(tools)
+	}
+	if functions, ok := body["functions"].([]any); ok {
+		count += len(functions)
+	}
+	return count
+}
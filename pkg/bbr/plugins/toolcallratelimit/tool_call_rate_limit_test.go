@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolcallratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func requestWithTools(apiKey string, tools int) *framework.InferenceRequest {
+	r := framework.NewInferenceRequest()
+	r.Headers["x-api-key"] = apiKey
+	r.Body["model"] = "gpt-4"
+	toolList := make([]any, 0, tools)
+	for range tools {
+		toolList = append(toolList, map[string]any{"type": "function"})
+	}
+	r.Body["tools"] = toolList
+	return r
+}
+
+func TestToolCallRateLimitPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "valid config",
+			rawParams: json.RawMessage(`{"max_tool_calls_per_minute":100}`),
+		},
+		{
+			name:      "invalid JSON",
+			rawParams: json.RawMessage(`{invalid`),
+			wantErr:   true,
+		},
+		{
+			name:      "missing budget",
+			rawParams: json.RawMessage(`{}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ToolCallRateLimitPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestToolCallRateLimitPlugin_ProcessRequest(t *testing.T) {
+	p, err := NewToolCallRateLimitPlugin(5)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	steps := []struct {
+		name    string
+		apiKey  string
+		tools   int
+		advance time.Duration
+		wantErr bool
+	}{
+		{name: "first request within budget", apiKey: "a", tools: 3},
+		{name: "request without tools is not counted", apiKey: "a", tools: 0},
+		{name: "second request exhausts budget", apiKey: "a", tools: 2},
+		{name: "third request is rejected", apiKey: "a", tools: 1, wantErr: true},
+		{name: "other client has its own budget", apiKey: "b", tools: 5},
+		{name: "budget is still exhausted within the window", apiKey: "a", tools: 1, advance: 30 * time.Second, wantErr: true},
+		{name: "budget is restored after the window", apiKey: "a", tools: 5, advance: 31 * time.Second},
+	}
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		err := p.ProcessRequest(context.Background(), nil, requestWithTools(step.apiKey, step.tools))
+		if step.wantErr {
+			if err == nil {
+				t.Fatalf("%s: expected error, got nil", step.name)
+			}
+			if got := errcommon.CanonicalCode(err); got != errcommon.ResourceExhausted {
+				t.Errorf("%s: CanonicalCode = %q, want %q", step.name, got, errcommon.ResourceExhausted)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", step.name, err)
+		}
+	}
+}
+
+func TestCountTools(t *testing.T) {
+	body := map[string]any{
+		"tools":     []any{map[string]any{}, map[string]any{}},
+		"functions": []any{map[string]any{}},
+	}
+	if got := countTools(body); got != 3 {
+		t.Errorf("countTools() = %d, want 3", got)
+	}
+	if got := countTools(map[string]any{"tools": "invalid"}); got != 0 {
+		t.Errorf("countTools() = %d, want 0", got)
+	}
+}
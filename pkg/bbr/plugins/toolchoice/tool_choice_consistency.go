@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolchoice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	ToolChoiceConsistencyPluginType = "tool-choice-consistency"
+	toolsField                      = "tools"
+	toolChoiceField                 = "tool_choice"
+
+	noneChoice     = "none"
+	autoChoice     = "auto"
+	requiredChoice = "required"
+	functionType   = "function"
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &ToolChoiceConsistencyPlugin{}
+
+// ToolChoiceConsistencyPluginFactory defines the factory function for NewToolChoiceConsistencyPlugin.
+func ToolChoiceConsistencyPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewToolChoiceConsistencyPlugin().WithName(name), nil
+}
+
+// NewToolChoiceConsistencyPlugin initializes a new ToolChoiceConsistencyPlugin and returns its pointer.
+func NewToolChoiceConsistencyPlugin() *ToolChoiceConsistencyPlugin {
+	return &ToolChoiceConsistencyPlugin{
+		typedName: plugin.TypedName{
+			Type: ToolChoiceConsistencyPluginType,
+			Name: ToolChoiceConsistencyPluginType,
+		},
+	}
+}
+
+// ToolChoiceConsistencyPlugin rejects requests with HTTP 400 when their tool_choice is inconsistent with
+// their tools, i.e. when it names a function that is not defined in tools, or requires a tool call while
+// no tools are defined, instead of forwarding them to backends that fail with confusing errors.
+type ToolChoiceConsistencyPlugin struct {
+	typedName plugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *ToolChoiceConsistencyPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *ToolChoiceConsistencyPlugin) WithName(name string) *ToolChoiceConsistencyPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest checks that the tool_choice of the request is consistent with its tools.
+func (p *ToolChoiceConsistencyPlugin) ProcessRequest(_ context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	toolChoice, ok := request.Body[toolChoiceField]
+	if !ok || toolChoice == nil {
+		return nil
+	}
+	tools, _ := request.Body[toolsField].([]any)
+
+	if msg := inconsistency(toolChoice, tools); msg != "" {
+		return errcommon.Error{Code: errcommon.BadRequest, Msg: msg}
+	}
+	return nil
+}
+
+// inconsistency returns a description of the inconsistency between the tool choice and the tools, if any.
+func inconsistency(toolChoice any, tools []any) string {
+	switch choice := toolChoice.(type) {
+	case string:
+		switch choice {
+		case noneChoice, autoChoice:
+			return ""
+		case requiredChoice:
+			if len(tools) == 0 {
+				return `tool_choice "required" requires at least one tool`
+			}
+			return ""
+		default:
+			return fmt.Sprintf("tool_choice %q must be one of %q, %q or %q", choice, noneChoice, autoChoice, requiredChoice)
+		}
+	case map[string]any:
+		if choice["type"] != functionType {
+			return fmt.Sprintf("tool_choice.type must be %q", functionType)
+		}
+		function, _ := choice["function"].(map[string]any)
+		name, _ := function["name"].(string)
+		if name == "" {
+			return "tool_choice.function.name is required"
+		}
+		if !hasFunction(tools, name) {
+			return fmt.Sprintf("tool_choice references function %q, which is not defined in tools", name)
+		}
+		return ""
+	default:
+		return "tool_choice must be a string or an object"
+	}
+}
+
+// hasFunction returns whether the tools define a function with the given name.
+func hasFunction(tools []any, name string) bool {
+	for _, raw := range tools {
+		tool, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if function, ok := tool["function"].(map[string]any); ok && function["name"] == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolchoice
+
+import (
+	"context"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestToolChoiceConsistencyPluginFactory(t *testing.T) {
+	p, err := ToolChoiceConsistencyPluginFactory("my-plugin", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.TypedName().Name; got != "my-plugin" {
+		t.Errorf("Name = %q, want %q", got, "my-plugin")
+	}
+}
+
+func TestToolChoiceConsistencyPlugin(t *testing.T) {
+	tools := []any{
+		map[string]any{"type": "function", "function": map[string]any{"name": "get_weather"}},
+		map[string]any{"type": "function", "function": map[string]any{"name": "get_time"}},
+	}
+	functionChoice := func(name string) map[string]any {
+		return map[string]any{"type": "function", "function": map[string]any{"name": name}}
+	}
+
+	tests := []struct {
+		name       string
+		tools      []any
+		toolChoice any
+		wantErr    bool
+	}{
+		{name: "no tool choice", tools: tools},
+		{name: "auto", tools: tools, toolChoice: "auto"},
+		{name: "none without tools", toolChoice: "none"},
+		{name: "required with tools", tools: tools, toolChoice: "required"},
+		{name: "required without tools", toolChoice: "required", wantErr: true},
+		{name: "unknown string", tools: tools, toolChoice: "always", wantErr: true},
+		{name: "defined function", tools: tools, toolChoice: functionChoice("get_time")},
+		{name: "undefined function", tools: tools, toolChoice: functionChoice("foo"), wantErr: true},
+		{name: "function without tools", toolChoice: functionChoice("get_time"), wantErr: true},
+		{name: "function without name", tools: tools, toolChoice: map[string]any{"type": "function"}, wantErr: true},
+		{name: "unknown type", tools: tools, toolChoice: map[string]any{"type": "retrieval"}, wantErr: true},
+		{name: "invalid tool choice", tools: tools, toolChoice: 1.0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			if tt.tools != nil {
+				request.Body["tools"] = tt.tools
+			}
+			if tt.toolChoice != nil {
+				request.Body["tool_choice"] = tt.toolChoice
+			}
+
+			err := NewToolChoiceConsistencyPlugin().ProcessRequest(context.Background(), framework.NewCycleState(), request)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != errcommon.BadRequest {
+				t.Errorf("CanonicalCode = %q, want %q", got, errcommon.BadRequest)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttft
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/responselatency"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	TTFTMeasurementPluginType = "ttft-measurement"
+	modelField                = "model"
+	streamField               = "stream"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor  = &TTFTMeasurementPlugin{}
+	_ framework.ResponseProcessor = &TTFTMeasurementPlugin{}
+)
+
+// TTFTMeasurementPluginFactory defines the factory function for NewTTFTMeasurementPlugin.
+func TTFTMeasurementPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewTTFTMeasurementPlugin().WithName(name), nil
+}
+
+// NewTTFTMeasurementPlugin initializes a new TTFTMeasurementPlugin and returns its pointer.
+func NewTTFTMeasurementPlugin() *TTFTMeasurementPlugin {
+	return &TTFTMeasurementPlugin{
+		typedName: plugin.TypedName{
+			Type: TTFTMeasurementPluginType,
+			Name: TTFTMeasurementPluginType,
+		},
+		now: time.Now,
+	}
+}
+
+// TTFTMeasurementPlugin measures the time to first token of streamed responses, from the request start
+// to the arrival of the first response body chunk, and records it in the bbr_time_to_first_token_seconds
+// histogram. The request start is taken from the X-BBR-Request-Start header when it was set by a
+// preceding plugin, and is otherwise the time the plugin processed the request.
+type TTFTMeasurementPlugin struct {
+	typedName plugin.TypedName
+	now       func() time.Time
+}
+
+// requestState is the state passed from the request to the response processing.
+type requestState struct {
+	model string
+	start time.Time
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *TTFTMeasurementPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *TTFTMeasurementPlugin) WithName(name string) *TTFTMeasurementPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest keeps the start time of streaming requests for the response.
+func (p *TTFTMeasurementPlugin) ProcessRequest(_ context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil || cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	if stream, _ := request.Body[streamField].(bool); !stream {
+		return nil
+	}
+
+	start := p.now()
+	if millis, err := strconv.ParseInt(request.GetHeader(responselatency.RequestStartHeader), 10, 64); err == nil {
+		start = time.UnixMilli(millis)
+	}
+	model, _ := request.Body[modelField].(string)
+	cycleState.Write(p.typedName.String(), requestState{model: model, start: start})
+	return nil
+}
+
+// ProcessResponse records the time to first token of streamed responses.
+func (p *TTFTMeasurementPlugin) ProcessResponse(ctx context.Context, cycleState *framework.CycleState, _ *framework.InferenceResponse) error {
+	if cycleState == nil {
+		return nil
+	}
+
+	state, err := framework.ReadCycleStateKey[requestState](cycleState, p.typedName.String())
+	if err != nil {
+		return nil // not a streaming request
+	}
+	firstChunk, err := framework.ReadCycleStateKey[time.Time](cycleState, framework.FirstResponseChunkTimeKey)
+	if err != nil {
+		return nil // the response has no body
+	}
+
+	ttft := firstChunk.Sub(state.start)
+	if ttft < 0 {
+		return nil // the request start header is not reliable
+	}
+	metrics.RecordTimeToFirstToken(state.model, ttft)
+	log.FromContext(ctx).V(logutil.TRACE).Info("recorded time to first token", "model", state.model, "ttft", ttft)
+	return nil
+}
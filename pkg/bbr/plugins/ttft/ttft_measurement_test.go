@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ttft
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/metrics"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/plugins/responselatency"
+)
+
+// histogram returns the TTFT histogram of the given model, or nil if there is none.
+func histogram(t *testing.T, model string) *dto.Histogram {
+	t.Helper()
+	families, err := crmetrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("failed to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != "bbr_time_to_first_token_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "model" && label.GetValue() == model {
+					return metric.GetHistogram()
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func TestTTFTMeasurementPluginFactory(t *testing.T) {
+	p, err := TTFTMeasurementPluginFactory("my-plugin", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.TypedName().Name; got != "my-plugin" {
+		t.Errorf("Name = %q, want %q", got, "my-plugin")
+	}
+}
+
+func TestTTFTMeasurementPlugin(t *testing.T) {
+	metrics.Register()
+	start := time.Now()
+
+	tests := []struct {
+		name        string
+		model       string
+		stream      bool
+		startHeader string
+		firstChunk  time.Time
+		wantSum     float64 // zero when no sample is expected
+	}{
+		{
+			name:       "streaming request",
+			model:      "ttft-streaming-model",
+			stream:     true,
+			firstChunk: start.Add(250 * time.Millisecond),
+			wantSum:    0.25,
+		},
+		{
+			name:        "request start from header",
+			model:       "ttft-header-model",
+			stream:      true,
+			startHeader: strconv.FormatInt(start.Add(-time.Second).UnixMilli(), 10),
+			firstChunk:  start.Add(500 * time.Millisecond),
+			wantSum:     1.5,
+		},
+		{
+			name:       "non-streaming request",
+			model:      "ttft-non-streaming-model",
+			firstChunk: start.Add(time.Second),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewTTFTMeasurementPlugin()
+			p.now = func() time.Time { return start }
+
+			cycleState := framework.NewCycleState()
+			request := framework.NewInferenceRequest()
+			request.Body["model"] = tt.model
+			request.Body["stream"] = tt.stream
+			if tt.startHeader != "" {
+				request.Headers[responselatency.RequestStartHeader] = tt.startHeader
+			}
+			if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+				t.Fatalf("unexpected request error: %v", err)
+			}
+
+			cycleState.Write(framework.FirstResponseChunkTimeKey, tt.firstChunk)
+			if err := p.ProcessResponse(context.Background(), cycleState, framework.NewInferenceResponse()); err != nil {
+				t.Fatalf("unexpected response error: %v", err)
+			}
+
+			h := histogram(t, tt.model)
+			if tt.wantSum == 0 {
+				if h != nil {
+					t.Errorf("expected no TTFT to be recorded, got %v", h)
+				}
+				return
+			}
+			if h == nil {
+				t.Fatal("TTFT was not recorded")
+			}
+			// the header has millisecond precision
+			if got := h.GetSampleSum(); got < tt.wantSum-0.001 || got > tt.wantSum+0.001 {
+				t.Errorf("sample sum = %v, want %v", got, tt.wantSum)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package useragent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	UserAgentNormalizerPluginType = "user-agent-normalizer"
+
+	// ClientSDKHeader is set to the SDK the request was sent with, e.g. openai-python, or "unknown".
+	ClientSDKHeader = "X-BBR-Client-SDK"
+	// ClientVersionHeader is set to the version of the SDK the request was sent with, when known.
+	ClientVersionHeader = "X-BBR-Client-Version"
+
+	userAgentHeader = "user-agent"
+	unknownSDK      = "unknown"
+)
+
+// DefaultSDKPatterns are the patterns of the user agents of known SDKs, whose first capture group is
+// the SDK version. Frameworks built on the OpenAI SDKs come first, since their user agents may also
+// contain the user agent of the OpenAI SDK.
+var DefaultSDKPatterns = []SDKPattern{
+	{SDK: "langchain", Pattern: `(?i)\blangchain(?:[-_](?:core|openai|js))?[/ ]v?(\d+(?:\.\d+)*)`},
+	{SDK: "llama-index", Pattern: `(?i)\bllama[-_]?index(?:[-_]core)?[/ ]v?(\d+(?:\.\d+)*)`},
+	{SDK: "openai-python", Pattern: `\bOpenAI/Python (\d+(?:\.\d+)*)`},
+	{SDK: "openai-node", Pattern: `\bOpenAI/JS (\d+(?:\.\d+)*)`},
+}
+
+// compile-time type validation
+var _ framework.RequestProcessor = &UserAgentNormalizerPlugin{}
+
+// SDKPattern is the pattern of the user agent of an SDK.
+type SDKPattern struct {
+	// SDK is the normalized name of the SDK, e.g. openai-python.
+	SDK string `json:"sdk"`
+	// Pattern is the regular expression matching the user agent of the SDK, whose first capture group,
+	// if any, is the SDK version.
+	Pattern string `json:"pattern"`
+}
+
+// UserAgentNormalizerConfig defines the JSON configuration structure for the plugin.
+type UserAgentNormalizerConfig struct {
+	// Patterns are the patterns of additional SDKs, which are matched before the patterns of the known SDKs.
+	Patterns []SDKPattern `json:"patterns"`
+}
+
+// UserAgentNormalizerPluginFactory defines the factory function for NewUserAgentNormalizerPlugin.
+func UserAgentNormalizerPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := UserAgentNormalizerConfig{}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", UserAgentNormalizerPluginType, err)
+		}
+	}
+
+	plugin, err := NewUserAgentNormalizerPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", UserAgentNormalizerPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewUserAgentNormalizerPlugin initializes a new UserAgentNormalizerPlugin and returns its pointer.
+func NewUserAgentNormalizerPlugin(config UserAgentNormalizerConfig) (*UserAgentNormalizerPlugin, error) {
+	patterns := append(append([]SDKPattern{}, config.Patterns...), DefaultSDKPatterns...)
+	matchers := make([]sdkMatcher, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern.SDK == "" {
+			return nil, errors.New("sdk is required in every pattern of UserAgentNormalizer plugin")
+		}
+		re, err := regexp.Compile(pattern.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern of SDK %q in UserAgentNormalizer plugin - %w", pattern.SDK, err)
+		}
+		matchers = append(matchers, sdkMatcher{sdk: pattern.SDK, pattern: re})
+	}
+
+	return &UserAgentNormalizerPlugin{
+		typedName: plugin.TypedName{
+			Type: UserAgentNormalizerPluginType,
+			Name: UserAgentNormalizerPluginType,
+		},
+		matchers: matchers,
+	}, nil
+}
+
+// sdkMatcher is a compiled SDKPattern.
+type sdkMatcher struct {
+	sdk     string
+	pattern *regexp.Regexp
+}
+
+// UserAgentNormalizerPlugin normalizes the varied User-Agent strings of client SDKs for backend analytics.
+// The SDK the request was sent with, e.g. openai-python, openai-node, langchain or llama-index, is set in
+// the X-BBR-Client-SDK header, and its version in the X-BBR-Client-Version header. Requests from unknown
+// user agents are classified as "unknown".
+type UserAgentNormalizerPlugin struct {
+	typedName plugin.TypedName
+	matchers  []sdkMatcher
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *UserAgentNormalizerPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *UserAgentNormalizerPlugin) WithName(name string) *UserAgentNormalizerPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest sets the SDK and version headers of the request from its user agent.
+func (p *UserAgentNormalizerPlugin) ProcessRequest(ctx context.Context, _ *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil {
+		return nil // this shouldn't happen
+	}
+
+	userAgent := request.GetHeader(userAgentHeader)
+	sdk, version := p.classify(userAgent)
+	request.SetHeader(ClientSDKHeader, sdk)
+	if version != "" {
+		request.SetHeader(ClientVersionHeader, version)
+	}
+	log.FromContext(ctx).V(logutil.DEBUG).Info("classified the client user agent", "userAgent", userAgent, "sdk", sdk, "version", version)
+	return nil
+}
+
+// classify returns the SDK and version of the user agent, or "unknown" and an empty version.
+func (p *UserAgentNormalizerPlugin) classify(userAgent string) (string, string) {
+	if userAgent == "" {
+		return unknownSDK, ""
+	}
+	for _, matcher := range p.matchers {
+		match := matcher.pattern.FindStringSubmatch(userAgent)
+		if match == nil {
+			continue
+		}
+		if len(match) > 1 {
+			return matcher.sdk, match[1]
+		}
+		return matcher.sdk, ""
+	}
+	return unknownSDK, ""
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package useragent
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestUserAgentNormalizerPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "additional pattern", rawParams: json.RawMessage(`{"patterns":[{"sdk":"my-sdk","pattern":"MySDK/(\\d+)"}]}`)},
+		{name: "missing SDK", rawParams: json.RawMessage(`{"patterns":[{"pattern":"MySDK"}]}`), wantErr: true},
+		{name: "invalid pattern", rawParams: json.RawMessage(`{"patterns":[{"sdk":"my-sdk","pattern":"("}]}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := UserAgentNormalizerPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func TestUserAgentNormalizerPlugin(t *testing.T) {
+	p, err := NewUserAgentNormalizerPlugin(UserAgentNormalizerConfig{
+		Patterns: []SDKPattern{{SDK: "internal-cli", Pattern: `^acme-cli/(\S+)`}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	tests := []struct {
+		userAgent   string
+		wantSDK     string
+		wantVersion string
+	}{
+		{userAgent: "OpenAI/Python 1.35.3", wantSDK: "openai-python", wantVersion: "1.35.3"},
+		{userAgent: "OpenAI/JS 4.52.0", wantSDK: "openai-node", wantVersion: "4.52.0"},
+		{userAgent: "langchain-core/0.2.10 OpenAI/Python 1.35.3", wantSDK: "langchain", wantVersion: "0.2.10"},
+		{userAgent: "LangChain/0.1.0", wantSDK: "langchain", wantVersion: "0.1.0"},
+		{userAgent: "llama-index/0.10.50 OpenAI/Python 1.30.1", wantSDK: "llama-index", wantVersion: "0.10.50"},
+		{userAgent: "acme-cli/2.0-beta", wantSDK: "internal-cli", wantVersion: "2.0-beta"},
+		{userAgent: "curl/8.4.0", wantSDK: "unknown"},
+		{userAgent: "", wantSDK: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.userAgent, func(t *testing.T) {
+			request := framework.NewInferenceRequest()
+			if tt.userAgent != "" {
+				request.Headers["user-agent"] = tt.userAgent
+			}
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := request.GetHeader(ClientSDKHeader); got != tt.wantSDK {
+				t.Errorf("%s = %q, want %q", ClientSDKHeader, got, tt.wantSDK)
+			}
+			if got := request.GetHeader(ClientVersionHeader); got != tt.wantVersion {
+				t.Errorf("%s = %q, want %q", ClientVersionHeader, got, tt.wantVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utf8validator
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	UTF8ValidatorPluginType = "utf8-validator"
+)
+
+// compile-time type validation
+var (
+	_ framework.RequestProcessor = &UTF8ValidatorPlugin{}
+	_ framework.RequestPlanner   = &UTF8ValidatorPlugin{}
+)
+
+// UTF8ValidatorPluginFactory defines the factory function for NewUTF8ValidatorPlugin.
+func UTF8ValidatorPluginFactory(name string, _ json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	return NewUTF8ValidatorPlugin().WithName(name), nil
+}
+
+// NewUTF8ValidatorPlugin initializes a new UTF8ValidatorPlugin and returns its pointer.
+func NewUTF8ValidatorPlugin() *UTF8ValidatorPlugin {
+	return &UTF8ValidatorPlugin{
+		typedName: plugin.TypedName{
+			Type: UTF8ValidatorPluginType,
+			Name: UTF8ValidatorPluginType,
+		},
+	}
+}
+
+// UTF8ValidatorPlugin rejects requests whose raw body is not valid UTF-8 with HTTP 400.
+// The check is done on the raw body bytes, since parsing the body silently replaces invalid
+// UTF-8 sequences in strings with the Unicode replacement character.
+type UTF8ValidatorPlugin struct {
+	typedName plugin.TypedName
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *UTF8ValidatorPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *UTF8ValidatorPlugin) WithName(name string) *UTF8ValidatorPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest validates that the raw request body is valid UTF-8.
+func (p *UTF8ValidatorPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, _ *framework.InferenceRequest) error {
+	if cycleState == nil {
+		return nil // this shouldn't happen
+	}
+
+	body, err := framework.ReadCycleStateKey[[]byte](cycleState, framework.RequestBodyBytesKey)
+	if err != nil {
+		log.FromContext(ctx).V(logutil.DEFAULT).Info("raw request body is not available, skipping UTF-8 validation")
+		return nil
+	}
+
+	if !utf8.Valid(body) {
+		return invalidUTF8()
+	}
+	return nil
+}
+
+// PlanRequest predicts that the plugin rejects the request if its body is not valid UTF-8. The raw body is not
+// available to plans, and parsing replaces the invalid sequences of strings with U+FFFD, so the plugin is
+// predicted to reject the bodies with replacement characters.
+func (p *UTF8ValidatorPlugin) PlanRequest(_ context.Context, request *framework.InferenceRequest) framework.RequestPlan {
+	plan := framework.RequestPlan{RequiresBody: true}
+	if request == nil || request.Body == nil {
+		return plan
+	}
+	plan.Fires = hasReplacementChar(request.Body)
+	return plan
+}
+
+// hasReplacementChar returns whether a string, key or value of the parsed JSON value contains U+FFFD.
+func hasReplacementChar(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return strings.ContainsRune(v, utf8.RuneError)
+	case map[string]any:
+		for key, field := range v {
+			if hasReplacementChar(key) || hasReplacementChar(field) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if hasReplacementChar(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// invalidUTF8 returns the HTTP 400 response rejecting a request body that is not valid UTF-8.
+func invalidUTF8() error {
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message": "request body must be valid UTF-8",
+		},
+	})
+	return errcommon.ImmediateResponse{
+		Code:    errcommon.BadRequest,
+		Headers: map[string]string{"content-type": "application/json"},
+		Body:    body,
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utf8validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	errcommon "sigs.k8s.io/gateway-api-inference-extension/pkg/common/error"
+)
+
+func TestUTF8ValidatorPluginFactory(t *testing.T) {
+	p, err := UTF8ValidatorPluginFactory("my-plugin", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := p.TypedName().Name; got != "my-plugin" {
+		t.Errorf("Name = %q, want %q", got, "my-plugin")
+	}
+}
+
+func TestUTF8ValidatorPlugin_ProcessRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     []byte
+		wantCode string
+	}{
+		{
+			name: "ASCII",
+			body: []byte(`{"model":"llama","prompt":"hello"}`),
+		},
+		{
+			name: "multi-byte UTF-8",
+			body: []byte(`{"model":"llama","prompt":"héllo 世界 🙂"}`),
+		},
+		{
+			name: "BOM prefixed",
+			body: append([]byte{0xEF, 0xBB, 0xBF}, []byte(`{"model":"llama","prompt":"hello"}`)...),
+		},
+		{
+			name:     "truncated multi-byte sequence",
+			body:     []byte("{\"model\":\"llama\",\"prompt\":\"\xe4\xb8\"}"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "invalid continuation byte",
+			body:     []byte("{\"model\":\"llama\",\"prompt\":\"\xc3\x28\"}"),
+			wantCode: errcommon.BadRequest,
+		},
+		{
+			name:     "overlong encoding",
+			body:     []byte("{\"model\":\"llama\",\"prompt\":\"\xc0\xaf\"}"),
+			wantCode: errcommon.BadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cycleState := framework.NewCycleState()
+			cycleState.Write(framework.RequestBodyBytesKey, tt.body)
+
+			err := NewUTF8ValidatorPlugin().ProcessRequest(context.Background(), cycleState, framework.NewInferenceRequest())
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if got := errcommon.CanonicalCode(err); got != tt.wantCode {
+				t.Errorf("CanonicalCode = %q, want %q", got, tt.wantCode)
+			}
+			var immediate errcommon.ImmediateResponse
+			if !errors.As(err, &immediate) {
+				t.Fatalf("expected an immediate response, got %v", err)
+			}
+			if got, want := string(immediate.Body), `{"error":{"message":"request body must be valid UTF-8"}}`; got != want {
+				t.Errorf("body = %s, want %s", got, want)
+			}
+		})
+	}
+}
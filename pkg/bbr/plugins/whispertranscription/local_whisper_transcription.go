@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whispertranscription
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+	logutil "sigs.k8s.io/gateway-api-inference-extension/pkg/common/observability/logging"
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/epp/framework/interface/plugin"
+)
+
+const (
+	LocalWhisperTranscriptionPluginType = "local-whisper-transcription"
+
+	// TranscriptionPreviewHeader is set to the first characters of the transcription of the request's audio.
+	TranscriptionPreviewHeader = "X-BBR-Transcription-Preview"
+	// TranscriptionLanguageHeader is set to the language of the request's audio, as detected by Whisper.
+	TranscriptionLanguageHeader = "X-BBR-Transcription-Language"
+
+	// TranscriptionKey is the CycleState key of the full transcription of the request's audio, for
+	// downstream plugins, e.g. for prompt-aware routing.
+	TranscriptionKey = "bbr.transcription"
+
+	messagesField = "messages"
+	modelField    = "model"
+
+	previewChars = 100
+
+	defaultEndpoint       = "http://127.0.0.1:8080/inference"
+	defaultTimeoutSeconds = 10
+	defaultMaxAudioBytes  = 25 << 20
+)
+
+// compile-time type validation
+var _ framework.RequestProcessor = &LocalWhisperTranscriptionPlugin{}
+
+// LocalWhisperTranscriptionConfig defines the JSON configuration structure for the plugin.
+type LocalWhisperTranscriptionConfig struct {
+	// Endpoint is the inference endpoint of the local whisper.cpp server. Defaults to "http://127.0.0.1:8080/inference".
+	Endpoint string `json:"endpoint"`
+	// TimeoutSeconds bounds the transcription. Defaults to 10.
+	TimeoutSeconds int `json:"timeout_seconds"`
+	// MaxAudioBytes is the size of the largest decoded audio that is transcribed. Defaults to 25MiB.
+	MaxAudioBytes int `json:"max_audio_bytes"`
+	// LanguageAdapters maps a detected language to the LoRA adapter the request is routed to, e.g. {"de": "llama-3-8b-german"}.
+	LanguageAdapters map[string]string `json:"language_adapters"`
+}
+
+// LocalWhisperTranscriptionPluginFactory defines the factory function for NewLocalWhisperTranscriptionPlugin.
+func LocalWhisperTranscriptionPluginFactory(name string, rawParameters json.RawMessage, _ framework.Handle) (framework.BBRPlugin, error) {
+	config := LocalWhisperTranscriptionConfig{
+		Endpoint:       defaultEndpoint,
+		TimeoutSeconds: defaultTimeoutSeconds,
+		MaxAudioBytes:  defaultMaxAudioBytes,
+	}
+
+	if len(rawParameters) > 0 {
+		if err := json.Unmarshal(rawParameters, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse the parameters of the '%s' plugin - %w", LocalWhisperTranscriptionPluginType, err)
+		}
+	}
+
+	plugin, err := NewLocalWhisperTranscriptionPlugin(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create '%s' plugin - %w", LocalWhisperTranscriptionPluginType, err)
+	}
+
+	return plugin.WithName(name), nil
+}
+
+// NewLocalWhisperTranscriptionPlugin initializes a new LocalWhisperTranscriptionPlugin and returns its pointer.
+func NewLocalWhisperTranscriptionPlugin(config LocalWhisperTranscriptionConfig) (*LocalWhisperTranscriptionPlugin, error) {
+	if _, err := url.ParseRequestURI(config.Endpoint); err != nil {
+		return nil, fmt.Errorf("invalid endpoint in LocalWhisperTranscription plugin - %w", err)
+	}
+	if config.TimeoutSeconds <= 0 {
+		return nil, errors.New("timeout_seconds must be positive in LocalWhisperTranscription plugin")
+	}
+	if config.MaxAudioBytes <= 0 {
+		return nil, errors.New("max_audio_bytes must be positive in LocalWhisperTranscription plugin")
+	}
+
+	timeout := time.Duration(config.TimeoutSeconds) * time.Second
+	return &LocalWhisperTranscriptionPlugin{
+		typedName: plugin.TypedName{
+			Type: LocalWhisperTranscriptionPluginType,
+			Name: LocalWhisperTranscriptionPluginType,
+		},
+		transcriber:      &whisperServer{endpoint: config.Endpoint, client: &http.Client{Timeout: timeout}},
+		timeout:          timeout,
+		maxAudioBytes:    config.MaxAudioBytes,
+		languageAdapters: config.LanguageAdapters,
+	}, nil
+}
+
+// LocalWhisperTranscriptionPlugin transcribes the audio input of chat completion requests locally, to
+// enable prompt-aware routing of spoken prompts. The first input_audio content part of the last user
+// message is transcribed by a whisper.cpp server running next to the BBR, e.g. as a sidecar. The first
+// 100 characters of the transcription are set in the X-BBR-Transcription-Preview header, the full
+// transcription is written to the CycleState for downstream plugins, and the detected language is set in
+// the X-BBR-Transcription-Language header. When a LoRA adapter is configured for the detected language,
+// the model of the request is replaced with the adapter. Requests whose audio can not be transcribed are
+// forwarded unchanged.
+type LocalWhisperTranscriptionPlugin struct {
+	typedName        plugin.TypedName
+	transcriber      Transcriber
+	timeout          time.Duration
+	maxAudioBytes    int
+	languageAdapters map[string]string
+}
+
+// TypedName returns the type and name tuple of this plugin instance.
+func (p *LocalWhisperTranscriptionPlugin) TypedName() plugin.TypedName {
+	return p.typedName
+}
+
+// WithName sets the name of the plugin instance.
+func (p *LocalWhisperTranscriptionPlugin) WithName(name string) *LocalWhisperTranscriptionPlugin {
+	p.typedName.Name = name
+	return p
+}
+
+// ProcessRequest transcribes the audio input of the request and sets the transcription metadata.
+func (p *LocalWhisperTranscriptionPlugin) ProcessRequest(ctx context.Context, cycleState *framework.CycleState, request *framework.InferenceRequest) error {
+	if request == nil || request.Headers == nil || request.Body == nil {
+		return nil // this shouldn't happen
+	}
+
+	data, format := audioInput(request.Body)
+	if data == "" {
+		return nil
+	}
+	logger := log.FromContext(ctx).V(logutil.VERBOSE)
+	if base64.StdEncoding.DecodedLen(len(data)) > p.maxAudioBytes {
+		logger.Info("audio input is too large to transcribe", "maxAudioBytes", p.maxAudioBytes)
+		return nil
+	}
+	audio, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		logger.Info("audio input is not valid base64", "error", err.Error())
+		return nil
+	}
+
+	transcribeCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	text, language, err := p.transcriber.Transcribe(transcribeCtx, audio, format)
+	if err != nil {
+		logger.Info("failed to transcribe the audio input", "error", err.Error())
+		return nil
+	}
+
+	text = strings.Join(strings.Fields(text), " ")
+	if cycleState != nil {
+		cycleState.Write(TranscriptionKey, text)
+	}
+	request.SetHeader(TranscriptionPreviewHeader, preview(text))
+	if language != "" {
+		request.SetHeader(TranscriptionLanguageHeader, language)
+		if adapter, ok := p.languageAdapters[language]; ok {
+			request.SetBodyField(modelField, adapter)
+		}
+	}
+	logger.Info("transcribed the audio input", "language", language, "chars", len(text))
+	return nil
+}
+
+// preview returns the first characters of the text.
+func preview(text string) string {
+	runes := []rune(text)
+	if len(runes) <= previewChars {
+		return text
+	}
+	return string(runes[:previewChars])
+}
+
+// audioInput returns the base64 data and the format of the first input_audio content part of the last user message.
+func audioInput(body map[string]any) (string, string) {
+	messages, _ := body[messagesField].([]any)
+	for i := len(messages) - 1; i >= 0; i-- {
+		message, ok := messages[i].(map[string]any)
+		if !ok || message["role"] != "user" {
+			continue
+		}
+		parts, _ := message["content"].([]any)
+		for _, p := range parts {
+			part, ok := p.(map[string]any)
+			if !ok || part["type"] != "input_audio" {
+				continue
+			}
+			input, _ := part["input_audio"].(map[string]any)
+			data, _ := input["data"].(string)
+			format, _ := input["format"].(string)
+			return data, format
+		}
+		return "", ""
+	}
+	return "", ""
+}
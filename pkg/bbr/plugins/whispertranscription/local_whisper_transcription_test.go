@@ -0,0 +1,198 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whispertranscription
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/gateway-api-inference-extension/pkg/bbr/framework"
+)
+
+func TestLocalWhisperTranscriptionPluginFactory(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawParams json.RawMessage
+		wantErr   bool
+	}{
+		{name: "defaults", rawParams: nil},
+		{name: "full config", rawParams: json.RawMessage(`{"endpoint":"http://localhost:9000/inference","timeout_seconds":5,"max_audio_bytes":1024,"language_adapters":{"de":"llama-german"}}`)},
+		{name: "invalid endpoint", rawParams: json.RawMessage(`{"endpoint":"not a url"}`), wantErr: true},
+		{name: "non-positive timeout", rawParams: json.RawMessage(`{"timeout_seconds":0}`), wantErr: true},
+		{name: "non-positive max audio bytes", rawParams: json.RawMessage(`{"max_audio_bytes":0}`), wantErr: true},
+		{name: "invalid JSON", rawParams: json.RawMessage(`{invalid`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := LocalWhisperTranscriptionPluginFactory("my-plugin", tt.rawParams, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.TypedName().Name; got != "my-plugin" {
+				t.Errorf("Name = %q, want %q", got, "my-plugin")
+			}
+		})
+	}
+}
+
+func audioRequest(audio string) *framework.InferenceRequest {
+	request := framework.NewInferenceRequest()
+	request.Body["model"] = "llama-3-8b"
+	request.Body["messages"] = []any{
+		map[string]any{"role": "user", "content": []any{
+			map[string]any{"type": "text", "text": "Answer the question in the recording."},
+			map[string]any{"type": "input_audio", "input_audio": map[string]any{"data": audio, "format": "wav"}},
+		}},
+	}
+	return request
+}
+
+func TestLocalWhisperTranscriptionPlugin(t *testing.T) {
+	audio := []byte("RIFF....WAVEfmt ")
+	var gotAudio []byte
+	var gotFilename string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("response_format") != "verbose_json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		gotAudio, _ = io.ReadAll(file)
+		gotFilename = header.Filename
+		_, _ = w.Write([]byte(`{"task":"transcribe","language":"de","text":" Wie wird das Wetter\nmorgen in Berlin? ` + strings.Repeat("Bitte ausführlich. ", 10) + `"}`))
+	}))
+	defer server.Close()
+
+	p, err := NewLocalWhisperTranscriptionPlugin(LocalWhisperTranscriptionConfig{
+		Endpoint:         server.URL,
+		TimeoutSeconds:   5,
+		MaxAudioBytes:    1024,
+		LanguageAdapters: map[string]string{"de": "llama-3-8b-german"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	request := audioRequest(base64.StdEncoding.EncodeToString(audio))
+	cycleState := framework.NewCycleState()
+	if err := p.ProcessRequest(context.Background(), cycleState, request); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(gotAudio) != string(audio) || gotFilename != "audio.wav" {
+		t.Errorf("transcribed audio = %q in %q, want %q in %q", gotAudio, gotFilename, audio, "audio.wav")
+	}
+	transcription, err := framework.ReadCycleStateKey[string](cycleState, TranscriptionKey)
+	if err != nil {
+		t.Fatalf("transcription was not written to the CycleState: %v", err)
+	}
+	if want := "Wie wird das Wetter morgen in Berlin? Bitte ausführlich."; !strings.HasPrefix(transcription, want) {
+		t.Errorf("transcription = %q, want prefix %q", transcription, want)
+	}
+	preview := request.GetHeader(TranscriptionPreviewHeader)
+	if len([]rune(preview)) != 100 || !strings.HasPrefix(transcription, preview) {
+		t.Errorf("%s = %q, want the first 100 characters of the transcription", TranscriptionPreviewHeader, preview)
+	}
+	if got := request.GetHeader(TranscriptionLanguageHeader); got != "de" {
+		t.Errorf("%s = %q, want %q", TranscriptionLanguageHeader, got, "de")
+	}
+	if got := request.Body["model"]; got != "llama-3-8b-german" {
+		t.Errorf("model = %v, want %q", got, "llama-3-8b-german")
+	}
+}
+
+// fakeTranscriber returns a fixed transcription, or an error.
+type fakeTranscriber struct {
+	text, language string
+	err            error
+	calls          int
+}
+
+func (f *fakeTranscriber) Transcribe(_ context.Context, _ []byte, _ string) (string, string, error) {
+	f.calls++
+	return f.text, f.language, f.err
+}
+
+func TestLocalWhisperTranscriptionPluginUnchanged(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     *framework.InferenceRequest
+		transcriber *fakeTranscriber
+		wantCalls   int
+	}{
+		{
+			name:        "request without audio",
+			request:     framework.NewInferenceRequest(),
+			transcriber: &fakeTranscriber{text: "hello"},
+		},
+		{
+			name:        "invalid base64 audio",
+			request:     audioRequest("not base64!"),
+			transcriber: &fakeTranscriber{text: "hello"},
+		},
+		{
+			name:        "too large audio",
+			request:     audioRequest(base64.StdEncoding.EncodeToString(make([]byte, 2048))),
+			transcriber: &fakeTranscriber{text: "hello"},
+		},
+		{
+			name:        "failed transcription",
+			request:     audioRequest(base64.StdEncoding.EncodeToString([]byte("audio"))),
+			transcriber: &fakeTranscriber{err: errors.New("whisper is down")},
+			wantCalls:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewLocalWhisperTranscriptionPlugin(LocalWhisperTranscriptionConfig{Endpoint: defaultEndpoint, TimeoutSeconds: 5, MaxAudioBytes: 1024})
+			if err != nil {
+				t.Fatalf("failed to create plugin: %v", err)
+			}
+			p.transcriber = tt.transcriber
+
+			if err := p.ProcessRequest(context.Background(), framework.NewCycleState(), tt.request); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.transcriber.calls != tt.wantCalls {
+				t.Errorf("transcriber calls = %d, want %d", tt.transcriber.calls, tt.wantCalls)
+			}
+			if got := tt.request.GetHeader(TranscriptionPreviewHeader); got != "" {
+				t.Errorf("%s = %q, want it unset", TranscriptionPreviewHeader, got)
+			}
+			if tt.request.BodyMutated() {
+				t.Error("request body was mutated")
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package whispertranscription
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// Transcriber transcribes audio locally, next to the BBR.
+type Transcriber interface {
+	// Transcribe returns the transcription of the audio in the given format, e.g. "wav", and the
+	// ISO 639-1 code of its detected language, which may be empty.
+	Transcribe(ctx context.Context, audio []byte, format string) (string, string, error)
+}
+
+// whisperServer transcribes audio with a whisper.cpp server, run as a sidecar of the BBR.
+// See https://github.com/ggerganov/whisper.cpp/tree/master/examples/server.
+type whisperServer struct {
+	endpoint string
+	client   *http.Client
+}
+
+// Transcribe posts the audio to the inference endpoint of the whisper.cpp server.
+func (s *whisperServer) Transcribe(ctx context.Context, audio []byte, format string) (string, string, error) {
+	var form bytes.Buffer
+	writer := multipart.NewWriter(&form)
+	if err := writer.WriteField("response_format", "verbose_json"); err != nil {
+		return "", "", err
+	}
+	file, err := writer.CreateFormFile("file", "audio."+format)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := file.Write(audio); err != nil {
+		return "", "", err
+	}
+	if err := writer.Close(); err != nil {
+		return "", "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, &form)
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	var transcription struct {
+		Text     string `json:"text"`
+		Language string `json:"language"`
+	}
+	if err := json.Unmarshal(raw, &transcription); err != nil {
+		return "", "", fmt.Errorf("failed to parse the transcription - %w", err)
+	}
+	return transcription.Text, transcription.Language, nil
+}
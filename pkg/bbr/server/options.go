@@ -28,6 +28,7 @@ import (
 const (
 	DefaultGrpcPort       = 9004
 	DefaultGrpcHealthPort = 9005
+	DefaultAdminPort      = 9099
 )
 
 // Options contains the command-line configuration for the BBR server.
@@ -45,12 +46,16 @@ type Options struct {
 	MetricsPort            int  // The metrics port exposed by BBR.
 	GRPCHealthPort         int  // The port for gRPC liveness and readiness probes.
 	EnablePprof            bool // Enables pprof handlers.
+	EnableAdminServer      bool // Enables the HTTP admin server modifying the plugin chains at runtime.
+	AdminPort              int  // The port of the HTTP admin server.
+	AdminBindAll           bool // Exposes the HTTP admin server on all interfaces instead of the loopback interface only.
 	SecureServing          bool // Enables secure serving.
 	MetricsEndpointAuth    bool // Enables authentication and authorization of the metrics endpoint.
 	//
 	// Plugins.
 	//
-	PluginSpecs config.BBRPluginSpecs // Repeatable --plugin <type>:<name>[:<json>] flag values.
+	PluginSpecs           config.BBRPluginSpecs // Repeatable --plugin <type>:<name>[:<json>] flag values.
+	InjectGatewayMetadata bool                  // Enables the response-metadata-injector plugins.
 
 	// internal
 	fs *pflag.FlagSet // FlagSet used in AddFlags()
@@ -59,14 +64,16 @@ type Options struct {
 // NewOptions returns a new Options struct initialized with default values.
 func NewOptions() *Options {
 	return &Options{
-		GRPCPort:            DefaultGrpcPort,
-		GRPCHealthPort:      DefaultGrpcHealthPort,
-		LoggingOptions:      *logging.NewOptions(),
-		Tracing:             true,
-		MetricsPort:         9090,
-		EnablePprof:         true,
-		SecureServing:       true,
-		MetricsEndpointAuth: true,
+		GRPCPort:              DefaultGrpcPort,
+		GRPCHealthPort:        DefaultGrpcHealthPort,
+		LoggingOptions:        *logging.NewOptions(),
+		Tracing:               true,
+		MetricsPort:           9090,
+		EnablePprof:           true,
+		AdminPort:             DefaultAdminPort,
+		SecureServing:         true,
+		MetricsEndpointAuth:   true,
+		InjectGatewayMetadata: true,
 	}
 }
 
@@ -93,8 +100,16 @@ func (opts *Options) AddFlags(fs *pflag.FlagSet) {
 		"Enables secure serving.")
 	fs.BoolVar(&opts.EnablePprof, "enable-pprof", opts.EnablePprof,
 		"Enables pprof handlers. Defaults to true. Set to false to disable pprof handlers.")
+	fs.BoolVar(&opts.EnableAdminServer, "enable-admin-server", opts.EnableAdminServer,
+		"Enables the HTTP admin server, used to list, append and remove plugins of the plugin chains at runtime.")
+	fs.IntVar(&opts.AdminPort, "admin-port", opts.AdminPort,
+		"The port of the HTTP admin server.")
+	fs.BoolVar(&opts.AdminBindAll, "admin-bind-all", opts.AdminBindAll,
+		"Exposes the HTTP admin server on all interfaces. By default it only listens on 127.0.0.1, since it is not authenticated.")
 
-	fs.Var(&opts.PluginSpecs, "plugin", `Repeatable. --plugin <type>:<name>[:<json>]`)
+	fs.Var(&opts.PluginSpecs, "plugin", `Repeatable. --plugin <type>[,timeout=<duration>[,on_timeout=abort|skip]]:<name>[:<json>]`)
+	fs.BoolVar(&opts.InjectGatewayMetadata, "inject-gateway-metadata", opts.InjectGatewayMetadata,
+		"Enables injecting gateway_metadata into responses by the response-metadata-injector plugins.")
 
 	opts.LoggingOptions.AddFlags(fs) // Add logging flags.
 }
@@ -115,6 +130,7 @@ func (opts *Options) Validate() error {
 		{"grpc-port", opts.GRPCPort},
 		{"grpc-health-port", opts.GRPCHealthPort},
 		{"metrics-port", opts.MetricsPort},
+		{"admin-port", opts.AdminPort},
 	} {
 		if pc.port < 1 || pc.port > 65535 {
 			return fmt.Errorf("invalid value %d for flag %q: must be between 1 and 65535", pc.port, pc.name)
@@ -131,6 +147,9 @@ func (opts *Options) Validate() error {
 		return fmt.Errorf("port conflict: grpc-port (%d), grpc-health-port (%d), and metrics-port (%d) must all be different",
 			opts.GRPCPort, opts.GRPCHealthPort, opts.MetricsPort)
 	}
+	if _, ok := ports[opts.AdminPort]; ok && opts.EnableAdminServer {
+		return fmt.Errorf("port conflict: admin-port (%d) collides with %s", opts.AdminPort, ports[opts.AdminPort])
+	}
 
 	// Validate logging options.
 	if err := opts.LoggingOptions.Validate(); err != nil {
@@ -39,6 +39,10 @@ func TestNewOptionsDefaults(t *testing.T) {
 		{"Streaming", opts.Streaming, false},
 		{"SecureServing", opts.SecureServing, true},
 		{"EnablePprof", opts.EnablePprof, true},
+		{"EnableAdminServer", opts.EnableAdminServer, false},
+		{"AdminPort", opts.AdminPort, DefaultAdminPort},
+		{"AdminBindAll", opts.AdminBindAll, false},
+		{"InjectGatewayMetadata", opts.InjectGatewayMetadata, true},
 		{"LogVerbosity", opts.LogVerbosity, 2}, // logging.DEFAULT
 	}
 	for _, c := range checks {
@@ -61,6 +65,10 @@ func TestAddFlagsOverridesDefaults(t *testing.T) {
 		"--secure-serving=false",
 		"--metrics-endpoint-auth=false",
 		"--enable-pprof=false",
+		"--enable-admin-server",
+		"--admin-port", "5003",
+		"--admin-bind-all",
+		"--inject-gateway-metadata=false",
 		"-v", "3",
 	}
 	if err := fs.Parse(args); err != nil {
@@ -79,6 +87,10 @@ func TestAddFlagsOverridesDefaults(t *testing.T) {
 		{"SecureServing", opts.SecureServing, false},
 		{"MetricsEndpointAuth", opts.MetricsEndpointAuth, false},
 		{"EnablePprof", opts.EnablePprof, false},
+		{"EnableAdminServer", opts.EnableAdminServer, true},
+		{"AdminPort", opts.AdminPort, 5003},
+		{"AdminBindAll", opts.AdminBindAll, true},
+		{"InjectGatewayMetadata", opts.InjectGatewayMetadata, false},
 		{"LogVerbosity", opts.LogVerbosity, 3},
 	}
 	for _, c := range checks {
@@ -161,6 +173,24 @@ func TestValidate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "admin-port collides with metrics-port",
+			mutate: func(o *Options) {
+				o.EnableAdminServer = true
+				o.AdminPort = 9090
+			},
+			expectError: true,
+		},
+		{
+			name:        "admin-port collision ignored when the admin server is disabled",
+			mutate:      func(o *Options) { o.AdminPort = 9090 },
+			expectError: false,
+		},
+		{
+			name:        "admin-port out of range",
+			mutate:      func(o *Options) { o.AdminPort = 0 },
+			expectError: true,
+		},
 		// Log verbosity validation.
 		{
 			name:        "negative log verbosity corrected to default",
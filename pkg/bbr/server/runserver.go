@@ -19,10 +19,15 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"sync"
+	"time"
 
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -40,6 +45,19 @@ type ExtProcServerRunner struct {
 	Streaming       bool
 	RequestPlugins  []framework.RequestProcessor
 	ResponsePlugins []framework.ResponseProcessor
+	// RequestPluginOptions are the execution options of the request plugins, keyed by the plugin instance names.
+	RequestPluginOptions map[string]framework.PluginOptions
+	// RequestPluginParameters are the parameters the request plugins were created with, keyed by the plugin
+	// instance names, recorded in the snapshots of the request chain.
+	RequestPluginParameters map[string]json.RawMessage
+	// AdminPort is the port of the HTTP admin server, started by AdminAsRunnable.
+	AdminPort int
+	// AdminBindAll exposes the HTTP admin server on all interfaces. Otherwise, it only listens on the loopback
+	// interface, since the admin API is not authenticated.
+	AdminBindAll bool
+
+	serverOnce sync.Once
+	server     *handlers.Server
 }
 
 func NewDefaultExtProcServerRunner(port int, streaming bool) *ExtProcServerRunner {
@@ -70,9 +88,41 @@ func (r *ExtProcServerRunner) AsRunnable(logger logr.Logger) manager.Runnable {
 			srv = grpc.NewServer()
 		}
 
-		extProcPb.RegisterExternalProcessorServer(srv, handlers.NewServer(r.Streaming, r.RequestPlugins, r.ResponsePlugins))
+		extProcPb.RegisterExternalProcessorServer(srv, r.extProcServer())
 
 		// Forward to the gRPC runnable.
 		return runnable.GRPCServer("ext-proc", srv, r.GrpcPort).Start(ctx)
 	}))
 }
+
+// AdminAsRunnable returns a Runnable that can be used to start the HTTP admin server, which modifies the
+// plugin chains of the ext-proc server at runtime. Appended plugins are created with the given handle.
+func (r *ExtProcServerRunner) AdminAsRunnable(handle framework.Handle) manager.Runnable {
+	return &manager.Server{
+		Name: "admin",
+		Server: &http.Server{
+			Addr:              r.adminAddr(),
+			Handler:           r.extProcServer().AdminHandler(handle),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// adminAddr returns the listen address of the HTTP admin server, on the loopback interface unless AdminBindAll
+// is set.
+func (r *ExtProcServerRunner) adminAddr() string {
+	if r.AdminBindAll {
+		return fmt.Sprintf(":%d", r.AdminPort)
+	}
+	return fmt.Sprintf("127.0.0.1:%d", r.AdminPort)
+}
+
+// extProcServer returns the ext-proc server, shared by the gRPC and admin servers.
+func (r *ExtProcServerRunner) extProcServer() *handlers.Server {
+	r.serverOnce.Do(func() {
+		r.server = handlers.NewServerWithExecutor(r.Streaming,
+			handlers.NewPluginsChainWithParameters(r.RequestPlugins, r.RequestPluginOptions, r.RequestPluginParameters), r.ResponsePlugins).
+			WithTracer(otel.Tracer("gateway-api-inference-extension/bbr/extproc"))
+	})
+	return r.server
+}
@@ -19,6 +19,7 @@ package error
 import (
 	"fmt"
 
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	extProcPb "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	envoyTypePb "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/grpc/status"
@@ -31,15 +32,22 @@ type Error struct {
 }
 
 const (
-	Unknown            = "Unknown"
-	BadRequest         = "BadRequest"
-	Unauthorized       = "Unauthorized"
-	Forbidden          = "Forbidden"
-	NotFound           = "NotFound"
-	Internal           = "Internal"
-	ServiceUnavailable = "ServiceUnavailable"
-	ModelServerError   = "ModelServerError"
-	ResourceExhausted  = "ResourceExhausted"
+	Unknown             = "Unknown"
+	OK                  = "OK"
+	NotModified         = "NotModified"
+	BadRequest          = "BadRequest"
+	Unauthorized        = "Unauthorized"
+	PaymentRequired     = "PaymentRequired"
+	Forbidden           = "Forbidden"
+	NotFound            = "NotFound"
+	NotAcceptable       = "NotAcceptable"
+	Conflict            = "Conflict"
+	PayloadTooLarge     = "PayloadTooLarge"
+	UnprocessableEntity = "UnprocessableEntity"
+	Internal            = "Internal"
+	ServiceUnavailable  = "ServiceUnavailable"
+	ModelServerError    = "ModelServerError"
+	ResourceExhausted   = "ResourceExhausted"
 )
 
 // Error returns a string version of the error.
@@ -47,11 +55,46 @@ func (e Error) Error() string {
 	return fmt.Sprintf("inference error: %s - %s", e.Code, e.Msg)
 }
 
+// ErrorWithHeaders is an Error that also carries headers to set on the immediate response,
+// e.g. WWW-Authenticate for Unauthorized or Retry-After for ResourceExhausted.
+type ErrorWithHeaders struct {
+	Err     Error
+	Headers map[string]string
+}
+
+// Error returns a string version of the error.
+func (e ErrorWithHeaders) Error() string {
+	return e.Err.Error()
+}
+
+// ImmediateResponse is returned to answer a request directly with the given body, without forwarding
+// it upstream, e.g. with a response that was already received for an identical request. It is returned
+// as an error so it short-circuits the processing. It is not a failure unless Code is set, e.g. to
+// answer with a JSON error body rather than the plain text message of an Error.
+type ImmediateResponse struct {
+	// Code is the ErrorCode of the response. Defaults to OK, answering with HTTP 200.
+	Code    string
+	Headers map[string]string
+	Body    []byte
+}
+
+// Error returns a string version of the immediate response.
+func (e ImmediateResponse) Error() string {
+	return "immediate response"
+}
+
 // CanonicalCode returns the error's ErrorCode.
 func CanonicalCode(err error) string {
-	e, ok := err.(Error)
-	if ok {
+	switch e := err.(type) {
+	case Error:
 		return e.Code
+	case ErrorWithHeaders:
+		return e.Err.Code
+	case ImmediateResponse:
+		if e.Code != "" {
+			return e.Code
+		}
+		return OK
 	}
 	return Unknown
 }
@@ -63,14 +106,28 @@ func BuildErrResponse(err error) (*extProcPb.ProcessingResponse, error) {
 	var httpCode envoyTypePb.StatusCode
 
 	switch CanonicalCode(err) {
+	case OK:
+		httpCode = envoyTypePb.StatusCode_OK
+	case NotModified:
+		httpCode = envoyTypePb.StatusCode_NotModified
 	case BadRequest:
 		httpCode = envoyTypePb.StatusCode_BadRequest
 	case Unauthorized:
 		httpCode = envoyTypePb.StatusCode_Unauthorized
+	case PaymentRequired:
+		httpCode = envoyTypePb.StatusCode_PaymentRequired
 	case Forbidden:
 		httpCode = envoyTypePb.StatusCode_Forbidden
 	case NotFound:
 		httpCode = envoyTypePb.StatusCode_NotFound
+	case NotAcceptable:
+		httpCode = envoyTypePb.StatusCode_NotAcceptable
+	case Conflict:
+		httpCode = envoyTypePb.StatusCode_Conflict
+	case PayloadTooLarge:
+		httpCode = envoyTypePb.StatusCode_PayloadTooLarge
+	case UnprocessableEntity:
+		httpCode = envoyTypePb.StatusCode_UnprocessableEntity
 	case ResourceExhausted:
 		httpCode = envoyTypePb.StatusCode_TooManyRequests
 	case Internal:
@@ -91,8 +148,31 @@ func BuildErrResponse(err error) (*extProcPb.ProcessingResponse, error) {
 		},
 	}
 
-	if err.Error() != "" {
-		resp.Response.(*extProcPb.ProcessingResponse_ImmediateResponse).ImmediateResponse.Body = []byte(err.Error())
+	immediateResponse := resp.Response.(*extProcPb.ProcessingResponse_ImmediateResponse).ImmediateResponse
+	var headers map[string]string
+	switch e := err.(type) {
+	case ImmediateResponse:
+		immediateResponse.Body = e.Body
+		headers = e.Headers
+	case ErrorWithHeaders:
+		immediateResponse.Body = []byte(err.Error())
+		headers = e.Headers
+	default:
+		if err.Error() != "" {
+			immediateResponse.Body = []byte(err.Error())
+		}
+	}
+
+	if len(headers) > 0 {
+		setHeaders := make([]*corev3.HeaderValueOption, 0, len(headers))
+		for key, value := range headers {
+			setHeaders = append(setHeaders, &corev3.HeaderValueOption{
+				Header: &corev3.HeaderValue{Key: key, RawValue: []byte(value)},
+			})
+		}
+		immediateResponse.Headers = &extProcPb.HeaderMutation{
+			SetHeaders: setHeaders,
+		}
 	}
 
 	return resp, nil
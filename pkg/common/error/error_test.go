@@ -167,6 +167,24 @@ func TestCanonicalCode(t *testing.T) {
 			},
 			want: "",
 		},
+		{
+			name: "ErrorWithHeaders type with Unauthorized code",
+			err: ErrorWithHeaders{
+				Err:     Error{Code: Unauthorized, Msg: "invalid token"},
+				Headers: map[string]string{"WWW-Authenticate": "Bearer"},
+			},
+			want: Unauthorized,
+		},
+		{
+			name: "ImmediateResponse type",
+			err:  ImmediateResponse{Body: []byte(`{"choices":[]}`)},
+			want: OK,
+		},
+		{
+			name: "ImmediateResponse type with BadRequest code",
+			err:  ImmediateResponse{Code: BadRequest, Body: []byte(`{"error":{}}`)},
+			want: BadRequest,
+		},
 		{
 			name: "Non-Error type",
 			err:  errors.New("standard go error"),
@@ -226,8 +244,14 @@ func TestBuildErrResponse(t *testing.T) {
 		err              error
 		wantHTTPStatus   envoyTypePb.StatusCode
 		wantBodyContains string
+		wantHeaders      map[string]string
 		wantGRPCErr      bool
 	}{
+		{
+			name:           "NotModified returns 304",
+			err:            Error{Code: NotModified, Msg: "cached response is up to date"},
+			wantHTTPStatus: envoyTypePb.StatusCode_NotModified,
+		},
 		{
 			name:             "BadRequest returns 400",
 			err:              Error{Code: BadRequest, Msg: "invalid model name"},
@@ -240,6 +264,12 @@ func TestBuildErrResponse(t *testing.T) {
 			wantHTTPStatus:   envoyTypePb.StatusCode_Unauthorized,
 			wantBodyContains: "missing token",
 		},
+		{
+			name:             "PaymentRequired returns 402",
+			err:              Error{Code: PaymentRequired, Msg: "budget exceeded"},
+			wantHTTPStatus:   envoyTypePb.StatusCode_PaymentRequired,
+			wantBodyContains: "budget exceeded",
+		},
 		{
 			name:             "Forbidden returns 403",
 			err:              Error{Code: Forbidden, Msg: "unsafe content blocked"},
@@ -252,6 +282,30 @@ func TestBuildErrResponse(t *testing.T) {
 			wantHTTPStatus:   envoyTypePb.StatusCode_NotFound,
 			wantBodyContains: "model not found",
 		},
+		{
+			name:             "NotAcceptable returns 406",
+			err:              Error{Code: NotAcceptable, Msg: "no acceptable content type"},
+			wantHTTPStatus:   envoyTypePb.StatusCode_NotAcceptable,
+			wantBodyContains: "no acceptable content type",
+		},
+		{
+			name:             "Conflict returns 409",
+			err:              Error{Code: Conflict, Msg: "request in progress"},
+			wantHTTPStatus:   envoyTypePb.StatusCode_Conflict,
+			wantBodyContains: "request in progress",
+		},
+		{
+			name:             "PayloadTooLarge returns 413",
+			err:              Error{Code: PayloadTooLarge, Msg: "too many batch items"},
+			wantHTTPStatus:   envoyTypePb.StatusCode_PayloadTooLarge,
+			wantBodyContains: "too many batch items",
+		},
+		{
+			name:             "UnprocessableEntity returns 422",
+			err:              Error{Code: UnprocessableEntity, Msg: "key reused"},
+			wantHTTPStatus:   envoyTypePb.StatusCode_UnprocessableEntity,
+			wantBodyContains: "key reused",
+		},
 		{
 			name:             "ResourceExhausted returns 429",
 			err:              Error{Code: ResourceExhausted, Msg: "no capacity"},
@@ -270,6 +324,37 @@ func TestBuildErrResponse(t *testing.T) {
 			wantHTTPStatus:   envoyTypePb.StatusCode_ServiceUnavailable,
 			wantBodyContains: "no endpoints",
 		},
+		{
+			name: "ErrorWithHeaders returns status and headers",
+			err: ErrorWithHeaders{
+				Err:     Error{Code: Unauthorized, Msg: "invalid token"},
+				Headers: map[string]string{"WWW-Authenticate": `Bearer error="invalid_token"`},
+			},
+			wantHTTPStatus:   envoyTypePb.StatusCode_Unauthorized,
+			wantBodyContains: "invalid token",
+			wantHeaders:      map[string]string{"WWW-Authenticate": `Bearer error="invalid_token"`},
+		},
+		{
+			name: "ImmediateResponse returns OK with its body and headers",
+			err: ImmediateResponse{
+				Headers: map[string]string{"content-type": "application/json"},
+				Body:    []byte(`{"choices":[]}`),
+			},
+			wantHTTPStatus:   envoyTypePb.StatusCode_OK,
+			wantBodyContains: `{"choices":[]}`,
+			wantHeaders:      map[string]string{"content-type": "application/json"},
+		},
+		{
+			name: "ImmediateResponse with a code returns its status with its body",
+			err: ImmediateResponse{
+				Code:    BadRequest,
+				Headers: map[string]string{"content-type": "application/json"},
+				Body:    []byte(`{"error":{"message":"invalid request"}}`),
+			},
+			wantHTTPStatus:   envoyTypePb.StatusCode_BadRequest,
+			wantBodyContains: `{"error":{"message":"invalid request"}}`,
+			wantHeaders:      map[string]string{"content-type": "application/json"},
+		},
 		{
 			name:        "plain error returns gRPC error",
 			err:         errors.New("unknown problem"),
@@ -308,6 +393,15 @@ func TestBuildErrResponse(t *testing.T) {
 			if tt.wantBodyContains != "" && !strings.Contains(string(ir.GetBody()), tt.wantBodyContains) {
 				t.Errorf("body %q should contain %q", string(ir.GetBody()), tt.wantBodyContains)
 			}
+			gotHeaders := map[string]string{}
+			for _, h := range ir.GetHeaders().GetSetHeaders() {
+				gotHeaders[h.GetHeader().GetKey()] = string(h.GetHeader().GetRawValue())
+			}
+			for key, want := range tt.wantHeaders {
+				if got := gotHeaders[key]; got != want {
+					t.Errorf("header %q = %q, want %q", key, got, want)
+				}
+			}
 		})
 	}
 }